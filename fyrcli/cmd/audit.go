@@ -0,0 +1,140 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
+)
+
+// The layout of the ISO8601 times of the audit log
+const auditTimeLayout = "2006-01-02T15:04:05"
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Displays the audit trail of the orchestrator.",
+	Long: `Displays the audit trail of the mutating actions performed on the ORCH server, newest first.
+Each entry shows the time, action, caller, peer address and result of the call, along with its request.
+Requires the admin role.
+
+The since and until flags accept either a duration before now such as '24h' or '30m', 
+or a UTC time such as '2021-05-01T10:00:00'. The actions that are audited are:
+- 'connection' 'ping' 'command' 'scheduler' 'simulate' 'outbox' 'replay'`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		since, _ := cmd.Flags().GetString("since")
+		until, _ := cmd.Flags().GetString("until")
+		actions, _ := cmd.Flags().GetStringSlice("action")
+		caller, _ := cmd.Flags().GetString("caller")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		// Parse the times of the query
+		query := &pb.AuditQuery{Actions: actions, Caller: caller, Limit: int64(limit)}
+		var err error
+		if query.Since, err = parseAuditTime(since); err != nil {
+			fmt.Printf("[error] invalid since value - %v\n", err)
+			return
+		}
+		if query.Until, err = parseAuditTime(until); err != nil {
+			fmt.Printf("[error] invalid until value - %v\n", err)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the QueryAudit method with the query
+		entries, err := orch.Call_ORCH_QueryAudit(*client, query)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		if len(entries) == 0 {
+			fmt.Println("[info] no audit entries match the filters.")
+			return
+		}
+
+		// Print each entry
+		for _, entry := range entries {
+			fmt.Printf("%v [%v] %v by %v (%v, %v) from %v\n", entry.GetTime(), entry.GetResult(), entry.GetAction(),
+				entry.GetCaller(), entry.GetRole(), entry.GetAuthMethod(), entry.GetPeer())
+			fmt.Printf("\t%v %v\n", entry.GetMethod(), entry.GetRequest())
+			if entry.GetError() != "" {
+				fmt.Printf("\terror - %v\n", entry.GetError())
+			}
+		}
+	},
+}
+
+// A function that parses the value of a time flag of the audit command into an ISO8601 UTC time.
+// The value is either a duration before now or an ISO8601 time. An empty value is not parsed.
+func parseAuditTime(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	// Parse the value as a duration before now
+	if duration, err := time.ParseDuration(value); err == nil {
+		return time.Now().UTC().Add(-duration).Format(auditTimeLayout), nil
+	}
+
+	// Parse the value as a time
+	parsed, err := time.Parse(auditTimeLayout, value)
+	if err != nil {
+		return "", fmt.Errorf("'%v' is neither a duration nor a time such as '2021-05-01T10:00:00'", value)
+	}
+	return parsed.Format(auditTimeLayout), nil
+}
+
+func init() {
+	// Add the command 'audit' to root CLI command.
+	rootCmd.AddCommand(auditCmd)
+
+	// Define the flags of the 'audit' command
+	auditCmd.Flags().StringP("since", "s", "", "show entries after a duration before now or a UTC time")
+	auditCmd.Flags().StringP("until", "u", "", "show entries before a duration before now or a UTC time")
+	auditCmd.Flags().StringSliceP("action", "a", []string{}, "actions to filter the entries by")
+	auditCmd.Flags().StringP("caller", "c", "", "caller to filter the entries by")
+	auditCmd.Flags().IntP("limit", "l", 50, "maximum number of entries to show (0 for all)")
+	auditCmd.RegisterFlagCompletionFunc("action", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"connection", "ping", "command", "scheduler", "simulate", "outbox", "replay", "registry"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
@@ -0,0 +1,243 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// authCmd represents the auth command
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Manages the authentication of the ORCH server.",
+	Long: `Manages the API tokens accepted by the ORCH server and the API token used by the FyrCLI.
+
+Every caller of the ORCH server has one of the following roles:
+- viewer   -> can view the status, logs, nodelist and commands of the mesh.
+- operator -> can also ping the mesh, send commands, toggle the connection and scheduler and edit the node registry.
+- admin    -> can also start simulations and view the mesh password when authenticated.
+
+Callers are authenticated with an API token or with a client certificate when mutual TLS is enabled.
+Certificates of a mesh controller are admins and certificates of a mesh observer are operators.
+The API tokens are stored in 'tokens.json' beside the config file, which is read by the ORCH server.
+The FyrCLI uses the token from the 'FYRMESHTOKEN' env variable or the 'token' file beside the config file.`,
+}
+
+// authCreateCmd represents the 'auth create' command
+var authCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Creates a new API token.",
+	Long: `Creates a new API token with a name and a role. The token is only displayed once.
+This command must be run on the mesh controller.`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		rolename, _ := cmd.Flags().GetString("role")
+		use, _ := cmd.Flags().GetBool("use")
+
+		// Parse the role
+		role, err := tools.ParseRole(rolename)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Create the token in the token store
+		store, err := tools.NewTokenStore()
+		if err != nil {
+			fmt.Printf("[error] token store could not be read - %v\n", err)
+			return
+		}
+		token, err := store.Create(args[0], role)
+		if err != nil {
+			fmt.Printf("[failure] token could not be created - %v\n", err)
+			return
+		}
+
+		fmt.Printf("[success] token '%v' created with the role '%v'. it will not be displayed again.\n", args[0], role)
+		fmt.Println(token)
+
+		// Use the token for the FyrCLI on this device
+		if use {
+			if err := tools.WriteClientToken(token); err != nil {
+				fmt.Printf("[failure] token could not be saved for the FyrCLI - %v\n", err)
+				return
+			}
+			fmt.Println("[success] token saved for the FyrCLI on this device.")
+		}
+
+		// Print out some other suggested methods for the CLI tool.
+		fmt.Println("\n[suggestion] -- use 'fyrcli auth use [token]' on another device to use the token.")
+	},
+}
+
+// authListCmd represents the 'auth list' command
+var authListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the API tokens.",
+	Long:  `Lists the names, roles and creation times of the API tokens accepted by the ORCH server.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Read the token store
+		store, err := tools.NewTokenStore()
+		if err != nil {
+			fmt.Printf("[error] token store could not be read - %v\n", err)
+			return
+		}
+		tokens, err := store.List()
+		if err != nil {
+			fmt.Printf("[error] tokens could not be listed - %v\n", err)
+			return
+		}
+
+		if len(tokens) == 0 {
+			fmt.Println("[info] no tokens have been created.")
+			fmt.Println("\n[suggestion] -- use 'fyrcli auth create [name]' to create a token.")
+			return
+		}
+
+		// Print the tokens
+		fmt.Printf("%-24s %-10s %v\n", "NAME", "ROLE", "CREATED")
+		for _, token := range tokens {
+			fmt.Printf("%-24s %-10s %v\n", token.Name, token.Role, token.Created)
+		}
+	},
+}
+
+// authRevokeCmd represents the 'auth revoke' command
+var authRevokeCmd = &cobra.Command{
+	Use:   "revoke [name]",
+	Short: "Revokes an API token.",
+	Long:  `Revokes an API token by its name. The ORCH server rejects the token from its next call.`,
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Revoke the token from the token store
+		store, err := tools.NewTokenStore()
+		if err != nil {
+			fmt.Printf("[error] token store could not be read - %v\n", err)
+			return
+		}
+		if err := store.Revoke(args[0]); err != nil {
+			fmt.Printf("[failure] token could not be revoked - %v\n", err)
+			return
+		}
+
+		fmt.Printf("[success] token '%v' revoked.\n", args[0])
+	},
+}
+
+// authUseCmd represents the 'auth use' command
+var authUseCmd = &cobra.Command{
+	Use:   "use [token]",
+	Short: "Sets the API token used by the FyrCLI.",
+	Long:  `Sets the API token that the FyrCLI sends to the ORCH server by saving it in the 'token' file beside the config file.`,
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Save the token for the FyrCLI
+		if err := tools.WriteClientToken(args[0]); err != nil {
+			fmt.Printf("[failure] token could not be saved - %v\n", err)
+			return
+		}
+
+		fmt.Println("[success] token saved for the FyrCLI on this device.")
+	},
+}
+
+// authEnableCmd represents the 'auth enable' command
+var authEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Requires authentication for the ORCH server.",
+	Long: `Requires every caller of the ORCH server to authenticate with an API token or a client certificate.
+Create an admin token with 'fyrcli auth create [name] --role admin --use' before enabling the authentication.
+The ORCH server must be restarted for the change to take effect.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		setauth(true)
+	},
+}
+
+// authDisableCmd represents the 'auth disable' command
+var authDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Stops requiring authentication for the ORCH server.",
+	Long: `Stops requiring authentication for the ORCH server. Callers that do not authenticate are treated as admins, 
+but the mesh password is only shown to admins that authenticate with a token or a certificate.
+The ORCH server must be restarted for the change to take effect.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		setauth(false)
+	},
+}
+
+// A function that sets whether authentication is required for the ORCH service in the config file.
+func setauth(enabled bool) {
+	// Read the config file.
+	config, err := tools.ReadConfig()
+	if err != nil {
+		fmt.Printf("[error] config file could not be read - %v\n", err)
+		return
+	}
+
+	// Set the auth value of the ORCH service and write the config file
+	serviceconfig := config.Services["ORCH"]
+	serviceconfig.Auth = enabled
+	config.Services["ORCH"] = serviceconfig
+	if err := tools.WriteConfig(config); err != nil {
+		fmt.Printf("[failure] config file could not be updated - %v\n", err)
+		return
+	}
+
+	fmt.Printf("[success] authentication for the ORCH server set to '%v'.\n", enabled)
+	fmt.Println("[info] restart the ORCH server for the change to take effect.")
+}
+
+func init() {
+	// Add the command 'auth' to root CLI command.
+	rootCmd.AddCommand(authCmd)
+
+	authCmd.AddCommand(authCreateCmd)
+	authCmd.AddCommand(authListCmd)
+	authCmd.AddCommand(authRevokeCmd)
+	authCmd.AddCommand(authUseCmd)
+	authCmd.AddCommand(authEnableCmd)
+	authCmd.AddCommand(authDisableCmd)
+
+	// Define the flags of the 'auth create' command
+	authCreateCmd.Flags().StringP("role", "r", "viewer", "role of the token (viewer, operator or admin)")
+	authCreateCmd.Flags().BoolP("use", "u", false, "save the token for the FyrCLI on this device")
+	authCreateCmd.RegisterFlagCompletionFunc("role", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"viewer", "operator", "admin"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
@@ -50,6 +50,8 @@ The server booting is done by spawning a new 'lxterminal' window and starting th
 The valid values of for the server name flag are below:
 - values such as 'ORCH', 'orch' and 'orchestrator' -> boot the ORCH server. 
 - values such as 'LINK', 'link' and 'interface' -> boot the LINK server.
+- values such as 'FAKE' and 'fake' -> boot the fake LINK server that emulates a control node and
+  a mesh of virtual sensor nodes, for developing without the mesh hardware.
 
 NOTE: The 'FYRMESHSRC' and 'FYRMESHCONFIG' env variables must be set for boot systems to work.
 NOTE: The LINK server should be booted up before the ORCH server to avoid an error.`,
@@ -90,6 +92,10 @@ NOTE: The LINK server should be booted up before the ORCH server to avoid an err
 			// Boot the LINK server
 			bootLINK(srcdir)
 
+		case "FAKE", "fake":
+			// Boot the fake LINK server
+			bootFAKE()
+
 		default:
 			fmt.Println("[error] unsupported server name -", server)
 		}
@@ -114,6 +120,13 @@ func bootLINK(srcdir string) {
 	cmd.Run()
 }
 
+func bootFAKE() {
+	// Define the command to start the fake LINK server in an lxterminal window.
+	cmd := exec.Command("lxterminal", "--geometry=250x30", "-t", "LINK", "-e", "fyrfake")
+	// Run the command.
+	cmd.Run()
+}
+
 func init() {
 	// Add the command 'boot' to root CLI command.
 	rootCmd.AddCommand(bootCmd)
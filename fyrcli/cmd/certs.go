@@ -0,0 +1,193 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// certsCmd represents the certs command
+var certsCmd = &cobra.Command{
+	Use:   "certs",
+	Short: "Manages the TLS certificates of the mesh.",
+	Long: `Manages the TLS certificates used by the ORCH and LINK gRPC connections of the mesh.
+TLS is enabled for a service with the 'tls' and 'mtls' values of the service in the config file, 
+while the CA and the certificate of the device are set in the 'tls' section of the config file.`,
+}
+
+// certsGenerateCmd represents the 'certs generate' command
+var certsGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generates a CA and device certificates for the mesh.",
+	Long: `Generates a self-signed CA and the device certificates for a mesh controller and its observers.
+The CA is reused if one already exists in the certificate directory, so observers can be added later.
+Every device certificate is valid for both server and client authentication.
+
+The certificate of the mesh controller is valid for 'localhost', the loopback addresses, the hostname 
+of this system and every host provided with the hosts flag. Add the address that the observers use to 
+reach the ORCH server to the hosts flag. The apply flag configures this device to use the certificate 
+of the mesh controller and enables mutual TLS for the ORCH and LINK services.
+
+Copy 'ca.crt' and the certificate and key of an observer to the observer and set them in its config file.
+The CA key 'ca.key' must never leave the mesh controller.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		dir, _ := cmd.Flags().GetString("dir")
+		controller, _ := cmd.Flags().GetString("controller")
+		observers, _ := cmd.Flags().GetStringSlice("observers")
+		hosts, _ := cmd.Flags().GetStringSlice("hosts")
+		days, _ := cmd.Flags().GetInt("days")
+		apply, _ := cmd.Flags().GetBool("apply")
+
+		// Default the certificate directory to the 'certs' directory beside the config file
+		configdir := os.Getenv("FYRMESHCONFIG")
+		if dir == "" {
+			if configdir == "" {
+				fmt.Println("[error] environment variable 'FYRMESHCONFIG' has not been set. use the dir flag to set the certificate directory.")
+				return
+			}
+			dir = filepath.Join(configdir, "certs")
+		}
+
+		// Create the certificate directory
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			fmt.Printf("[error] certificate directory could not be created - %v\n", err)
+			return
+		}
+
+		// Generate or reuse the CA
+		cacert, cakey, created, err := tools.GenerateCA(dir, days*4)
+		if err != nil {
+			fmt.Printf("[error] CA could not be generated - %v\n", err)
+			return
+		}
+		if created {
+			fmt.Printf("[success] CA generated at '%v'\n", filepath.Join(dir, tools.CACertFile))
+		} else {
+			fmt.Printf("[info] existing CA at '%v' will be used\n", filepath.Join(dir, tools.CACertFile))
+		}
+
+		// Generate the certificate of the mesh controller
+		if controller != "" {
+			controllerhosts := []string{"localhost", "127.0.0.1", "::1"}
+			if hostname, err := os.Hostname(); err == nil {
+				controllerhosts = append(controllerhosts, hostname)
+			}
+			controllerhosts = append(controllerhosts, hosts...)
+
+			if err := tools.GenerateDeviceCert(dir, cacert, cakey, controller, "mesh-controller", controllerhosts, days); err != nil {
+				fmt.Printf("[failure] certificate of the mesh controller could not be generated - %v\n", err)
+				return
+			}
+			fmt.Printf("[success] certificate of the mesh controller '%v' generated\n", controller)
+		}
+
+		// Generate the certificates of the observers
+		for _, observer := range observers {
+			if err := tools.GenerateDeviceCert(dir, cacert, cakey, observer, "mesh-observer", nil, days); err != nil {
+				fmt.Printf("[failure] certificate of the observer '%v' could not be generated - %v\n", observer, err)
+				return
+			}
+			fmt.Printf("[success] certificate of the observer '%v' generated\n", observer)
+		}
+
+		// Configure this device to use the certificate of the mesh controller
+		if apply {
+			if controller == "" {
+				fmt.Println("[error] the apply flag requires the certificate of a mesh controller.")
+				return
+			}
+
+			if err := applycerts(dir, configdir, controller); err != nil {
+				fmt.Printf("[failure] config file could not be updated - %v\n", err)
+				return
+			}
+			fmt.Println("[success] config file updated to use mutual TLS for the ORCH and LINK services.")
+			fmt.Println("[info] restart the ORCH and LINK servers for the change to take effect.")
+		}
+
+		// Print out some other suggested methods for the CLI tool.
+		fmt.Println("\n[suggestion] -- use 'fyrcli config show' to view the TLS configuration values.")
+	},
+}
+
+// A function that updates the config file to use the CA and a device certificate from the certificate
+// directory and enables mutual TLS for the ORCH and LINK services. The paths are stored relative to the
+// config directory if the certificate directory is within it.
+func applycerts(dir string, configdir string, device string) error {
+	// Read the config file
+	config, err := tools.ReadConfig()
+	if err != nil {
+		return err
+	}
+
+	// Make the certificate directory relative to the config directory if possible
+	if relative, err := filepath.Rel(configdir, dir); err == nil && !strings.HasPrefix(relative, "..") {
+		dir = relative
+	}
+
+	// Set the TLS identity of the device
+	config.TLS = tools.TLSConfig{
+		CA:   filepath.Join(dir, tools.CACertFile),
+		Cert: filepath.Join(dir, device+".crt"),
+		Key:  filepath.Join(dir, device+".key"),
+	}
+
+	// Enable mutual TLS for the services
+	for _, service := range []string{"ORCH", "LINK"} {
+		serviceconfig := config.Services[service]
+		serviceconfig.TLS = true
+		serviceconfig.MutualTLS = true
+		config.Services[service] = serviceconfig
+	}
+
+	// Write the config file
+	return tools.WriteConfig(config)
+}
+
+func init() {
+	// Add the command 'certs' to root CLI command.
+	rootCmd.AddCommand(certsCmd)
+	certsCmd.AddCommand(certsGenerateCmd)
+
+	// Define the flags of the 'certs generate' command
+	certsGenerateCmd.Flags().StringP("dir", "d", "", "directory to write the certificates to (default is $FYRMESHCONFIG/certs)")
+	certsGenerateCmd.Flags().StringP("controller", "c", "mesh-controller", "name of the mesh controller certificate (empty to skip)")
+	certsGenerateCmd.Flags().StringSliceP("observers", "o", []string{}, "names of the observer certificates to generate")
+	certsGenerateCmd.Flags().StringSlice("hosts", []string{}, "additional hostnames and IP addresses of the mesh controller")
+	certsGenerateCmd.Flags().Int("days", 825, "number of days the device certificates are valid for (the CA is valid for 4 times longer)")
+	certsGenerateCmd.Flags().BoolP("apply", "a", false, "configure this device to use the mesh controller certificate with mutual TLS")
+}
@@ -29,10 +29,12 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
 )
 
 // commandCmd represents the command command
@@ -42,7 +44,10 @@ var commandCmd = &cobra.Command{
 	Long: `Sends a control command to the mesh control node. 
 The message flag is mandatory and is used to set the control command phrase.
 All other arguments are collected as key value pairs for the command metadata. 
-Metadata collection is only done if an even number of args are provided.`,
+Metadata collection is only done if an even number of args are provided.
+
+The command and its metadata are validated by the ORCH server against its registry of 
+control node commands. Run 'fyrcli command list' to view the supported commands.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		// Retrieve the message value from the command flags.
@@ -72,33 +77,338 @@ Metadata collection is only done if an even number of args are provided.`,
 		}
 
 		// Call the Command method with the commandmap
-		success, err := orch.Call_ORCH_Command(*client, commandmap)
-		// Check the success value and print output
-		if success {
-			fmt.Println("[success] command was sent successfully")
+		commandid, err := orch.Call_ORCH_Command(*client, commandmap)
+		// Check the error and print output
+		if err == nil {
+			fmt.Printf("[success] command was accepted successfully | cmdid - %v\n", commandid)
+			fmt.Printf("[suggestion] run 'fyrcli command status %v' to track the command.\n", commandid)
 		} else {
 			fmt.Println("[failure] command failed to be sent")
 			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
+		}
+	},
+}
+
+// commandListCmd represents the command list command
+var commandListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the control commands supported by the mesh.",
+	Long: `Lists the control commands supported by the mesh control node along with 
+the metadata keys accepted by each command and the type of their values.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the DescribeCommands method.
+		specs, err := orch.Call_ORCH_DescribeCommands(*client)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Print each command and its metadata keys
+		fmt.Println("Supported Control Commands:")
+		for index, spec := range specs {
+			fmt.Printf("%v] %v\t%v\n", index+1, spec.GetCommand(), spec.GetDescription())
+			for _, key := range spec.GetKeys() {
+				requirement := "optional"
+				if key.GetRequired() {
+					requirement = "required"
+				}
+				fmt.Printf("\t- %v (%v, %v) %v\n", key.GetKey(), key.GetType(), requirement, key.GetDescription())
+			}
+		}
+	},
+}
+
+// commandStatusCmd represents the command status command
+var commandStatusCmd = &cobra.Command{
+	Use:   "status [cmdid]",
+	Short: "Displays the state of the commands sent to the mesh.",
+	Long: `Displays the state of a command sent to the mesh, given its command ID.
+If no command ID is given, the most recent commands are listed, newest first.
+
+The states of a command are:
+- 'queued'   the command is waiting in the command queue of the ORCH server.
+- 'sent'     the command is being written to the LINK server.
+- 'acked'    the LINK server wrote the command to the control node.
+- 'failed'   the LINK server could not be reached or could not write the command.
+- 'retrying' the command is held in the outbox until the LINK server can be reached.
+- 'expired'  the command was dropped from the outbox after its time-to-live.
+- 'observed' a response to the command was observed from the mesh.
+
+A command that stays 'acked' has reached the control node but the mesh has not responded,
+while a 'failed' command never reached the control node. Commands without a response, 
+such as 'connection-on', remain 'acked'.
+
+The 'state(s)' flag filters the listed commands by their state.
+The 'limit(l)' flag sets the maximum number of commands to list.`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Display a single command if a command ID is given
+		if len(args) == 1 {
+			record, err := orch.Call_ORCH_CommandStatus(*client, args[0])
+			if err != nil {
+				fmt.Printf("[error] %v\n", err)
+				return
+			}
+
+			fmt.Printf("Command ID: %v\n", record.GetCommandID())
+			fmt.Printf("Command: %v\n", record.GetCommand())
+			fmt.Printf("Metadata: %v\n", record.GetMetadata())
+			fmt.Printf("State: %v\n", commandStateName(record.GetState()))
+			if record.GetError() != "" {
+				fmt.Printf("Error: %v\n", record.GetError())
+			}
+			fmt.Printf("Responses: %v\n", record.GetResponses())
+			fmt.Printf("Created: %v\n", record.GetCreated())
+			fmt.Printf("Updated: %v\n", record.GetUpdated())
+			return
+		}
+
+		// Retrieve the flags
+		statefilter, _ := cmd.Flags().GetString("state")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		// Parse the state filter
+		state := pb.CommandState_COMMAND_STATE_UNSPECIFIED
+		if statefilter != "" {
+			value, ok := pb.CommandState_value["COMMAND_STATE_"+strings.ToUpper(statefilter)]
+			if !ok {
+				fmt.Printf("[error] an invalid command state was provided - %v\n", statefilter)
+				return
+			}
+			state = pb.CommandState(value)
+		}
+
+		// List the recent commands
+		records, err := orch.Call_ORCH_ListCommands(*client, state, limit)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		fmt.Println("Recent Commands:")
+		for index, record := range records {
+			fmt.Printf("%v] %v\t%-8v\t%v\t%v\n", index+1, record.GetCommandID(), commandStateName(record.GetState()), record.GetUpdated(), record.GetCommand())
+		}
+	},
+}
+
+// commandQueueCmd represents the command queue command
+var commandQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Displays the control commands pending in the outbox.",
+	Long: `Displays the control commands held in the outbox of the ORCH server, oldest first.
+Commands are held in the outbox while the LINK server cannot be reached and are written
+to the mesh in order once it can. Commands that are not written before their time-to-live
+are dropped and marked as 'expired'. Requires the outbox to be enabled in the configuration.
+
+Run 'fyrcli command queue purge' to drop commands from the outbox.`,
+	Args: cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the ListOutbox method.
+		entries, err := orch.Call_ORCH_ListOutbox(*client)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		if len(entries) == 0 {
+			fmt.Println("[info] no commands are pending in the outbox.")
+			return
+		}
+
+		// Print each pending command
+		fmt.Println("Pending Commands:")
+		for index, entry := range entries {
+			fmt.Printf("%v] %v\t%v\t%v\n", index+1, entry.GetCommandID(), entry.GetCommand(), entry.GetMetadata())
+			fmt.Printf("\tqueued - %v | expires - %v | attempts - %v\n", entry.GetQueued(), entry.GetExpires(), entry.GetAttempts())
+			if entry.GetLastError() != "" {
+				fmt.Printf("\tlast error - %v\n", entry.GetLastError())
+			}
+		}
+	},
+}
+
+// commandQueuePurgeCmd represents the command queue purge command
+var commandQueuePurgeCmd = &cobra.Command{
+	Use:   "purge [cmdid...]",
+	Short: "Drops control commands from the outbox.",
+	Long: `Drops the control commands with the given command IDs from the outbox of the ORCH server.
+The dropped commands are marked as 'failed' and are never written to the mesh.
+
+The 'all(a)' flag drops every command in the outbox.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag value
+		all, _ := cmd.Flags().GetBool("all")
+		if !all && len(args) == 0 {
+			fmt.Println("[error] no command IDs were provided and the 'all' flag is not set")
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the PurgeOutbox method.
+		purged, err := orch.Call_ORCH_PurgeOutbox(*client, args, all)
+		if err != nil {
+			fmt.Println("[failure] commands could not be purged from the outbox")
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		if len(purged) == 0 {
+			fmt.Println("[info] no matching commands were pending in the outbox.")
+			return
+		}
+
+		fmt.Printf("[success] %v command(s) purged from the outbox\n", len(purged))
+		for _, cmdid := range purged {
+			fmt.Printf("\t- %v\n", cmdid)
 		}
 	},
 }
 
+// A function that prints when to retry a call that was rejected by the rate limiter of the ORCH server.
+// Does nothing if the error is not a rejection of the rate limiter.
+func printRetryHint(err error) {
+	if delay, ok := orch.RetryAfter(err); ok {
+		fmt.Printf("[suggestion] the ORCH server is rate limiting commands. retry after %v.\n", delay)
+	}
+}
+
+// A function that returns the short lowercase name of a CommandState, such as 'acked'.
+func commandStateName(state pb.CommandState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "COMMAND_STATE_"))
+}
+
+// A function that retrieves the control commands supported by the mesh for shell completion.
+// Returns an empty slice if the ORCH server cannot be reached.
+func completionCommandSpecs() []*pb.CommandSpec {
+	// Connect to the ORCH gRPC server.
+	client, conn, err := orch.GRPCconnect_ORCH()
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	// Call the DescribeCommands method.
+	specs, err := orch.Call_ORCH_DescribeCommands(*client)
+	if err != nil {
+		return nil
+	}
+	return specs
+}
+
+// A function that completes the value of the 'message' flag with the supported control commands.
+func completeCommandMessage(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	// Collect the commands along with their descriptions
+	completions := []string{}
+	for _, spec := range completionCommandSpecs() {
+		completions = append(completions, fmt.Sprintf("%v\t%v", spec.GetCommand(), spec.GetDescription()))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// A function that completes the metadata keys of the control command set with the 'message' flag.
+// Keys are only completed in the key positions of the arguments and keys already set are skipped.
+func completeCommandMetadata(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	// Values of the metadata keys cannot be completed
+	if len(args)%2 != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// Collect the keys that have already been set
+	setkeys := make(map[string]bool)
+	for i := 0; i < len(args); i += 2 {
+		setkeys[args[i]] = true
+	}
+
+	// Find the spec of the command and collect the keys that are not set
+	message, _ := cmd.Flags().GetString("message")
+	completions := []string{}
+	for _, spec := range completionCommandSpecs() {
+		if spec.GetCommand() != message {
+			continue
+		}
+		for _, key := range spec.GetKeys() {
+			if !setkeys[key.GetKey()] {
+				completions = append(completions, fmt.Sprintf("%v\t%v %v", key.GetKey(), key.GetType(), key.GetDescription()))
+			}
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func init() {
 	// Add the command 'command' to root CLI command.
 	rootCmd.AddCommand(commandCmd)
+	// Add the 'list', 'status' and 'queue' subcommands to the 'command' command.
+	commandCmd.AddCommand(commandListCmd)
+	commandCmd.AddCommand(commandStatusCmd)
+	commandCmd.AddCommand(commandQueueCmd)
+	// Add the 'purge' subcommand to the 'queue' command.
+	commandQueueCmd.AddCommand(commandQueuePurgeCmd)
+
+	// Add the flags 'state' and 'limit' to the 'status' subcommand.
+	commandStatusCmd.Flags().StringP("state", "s", "", "state to filter the listed commands by")
+	commandStatusCmd.Flags().IntP("limit", "l", 20, "maximum number of commands to list")
+
+	// Add the flag 'all' to the 'purge' subcommand.
+	commandQueuePurgeCmd.Flags().BoolP("all", "a", false, "purge every command in the outbox")
 
 	// Add the flag 'command' and mark as required.
 	commandCmd.Flags().StringP("message", "m", "", "command message to send")
 	commandCmd.MarkFlagRequired("message")
 
+	// Register the completion functions for the command message and metadata
+	commandCmd.RegisterFlagCompletionFunc("message", completeCommandMessage)
+	commandCmd.ValidArgsFunction = completeCommandMetadata
+
 	// Define the usage template
 	usage := `Usage:
 fyrcli command -m [message] metadataKey1 metadataVal1...
+fyrcli command list
+fyrcli command status [cmdid]
 
 Flags:
 -h, --help             help for command
 -m, --message string   command message to send
 `
-	// Set the custom usage template
+	// Set the custom usage template and restore the default for the subcommands
 	commandCmd.SetUsageTemplate(usage)
+	commandListCmd.SetUsageTemplate(rootCmd.UsageTemplate())
+	commandStatusCmd.SetUsageTemplate(rootCmd.UsageTemplate())
 }
@@ -0,0 +1,79 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// completionCmd represents the completion command
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generates the shell completion script for the FyrCLI.",
+	Long: `Generates the shell completion script for the FyrCLI and prints it to the console.
+The completions of some commands, such as the control commands of 'fyrcli command', 
+are retrieved from the ORCH server as they are typed.
+
+To load the completions for the current bash session, run:
+	source <(fyrcli completion bash)
+
+To load the completions for every zsh session, run:
+	fyrcli completion zsh > "${fpath[1]}/_fyrcli"`,
+
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+	Args:      cobra.ExactValidArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Declare an error
+		var err error
+
+		// Generate the completion script for the shell
+		switch args[0] {
+		case "bash":
+			err = rootCmd.GenBashCompletion(os.Stdout)
+		case "zsh":
+			err = rootCmd.GenZshCompletion(os.Stdout)
+		case "fish":
+			err = rootCmd.GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			err = rootCmd.GenPowerShellCompletion(os.Stdout)
+		}
+
+		if err != nil {
+			fmt.Printf("[error] completion script could not be generated - %v\n", err)
+		}
+	},
+}
+
+func init() {
+	// Add the command 'completion' to root CLI command.
+	rootCmd.AddCommand(completionCmd)
+}
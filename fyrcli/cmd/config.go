@@ -31,6 +31,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -192,6 +194,7 @@ var configModifyCmd = &cobra.Command{
 			fmt.Println("1. Device ID")
 			fmt.Println("2. Device Type")
 			fmt.Println("3. Scheduler Ping Rate")
+			fmt.Println("4. Shutdown Timeout")
 			fmt.Println("--------------------------------------------------------------")
 			fmt.Scanln(&menunumber)
 
@@ -211,6 +214,15 @@ var configModifyCmd = &cobra.Command{
 					newconfig.SchedulerPingRate = pingrate
 					tools.WriteConfig(newconfig)
 				}
+			case 4:
+				var timeout int
+				fmt.Printf("[prompt] the current value of Shutdown Timeout is '%v'. Enter the new value in seconds (0 to not make a change)\n", currentconfig.GetShutdownTimeout())
+				fmt.Scanln(&timeout)
+
+				if timeout != 0 {
+					newconfig.ShutdownTimeout = timeout
+					tools.WriteConfig(newconfig)
+				}
 
 			default:
 				fmt.Println("[error] invalid choice. start over!")
@@ -222,6 +234,7 @@ var configModifyCmd = &cobra.Command{
 			fmt.Println("Choose the ORCH Configuration Value that needs to be changed")
 			fmt.Println("1. Host URL")
 			fmt.Println("2. Host Port")
+			fmt.Println("3. Metrics Address")
 			fmt.Println("--------------------------------------------------------------")
 			fmt.Scanln(&menunumber)
 
@@ -232,7 +245,9 @@ var configModifyCmd = &cobra.Command{
 				fmt.Scanln(&hosturl)
 
 				if hosturl != "0" {
-					newconfig.Services["ORCH"] = tools.ServiceConfig{Host: hosturl, Port: currentconfig.Services["ORCH"].Port}
+					serviceconfig := currentconfig.Services["ORCH"]
+					serviceconfig.Host = hosturl
+					newconfig.Services["ORCH"] = serviceconfig
 					tools.WriteConfig(newconfig)
 				}
 				return
@@ -243,7 +258,25 @@ var configModifyCmd = &cobra.Command{
 				fmt.Scanln(&hostport)
 
 				if hostport != 0 {
-					newconfig.Services["ORCH"] = tools.ServiceConfig{Host: currentconfig.Services["ORCH"].Host, Port: hostport}
+					serviceconfig := currentconfig.Services["ORCH"]
+					serviceconfig.Port = hostport
+					newconfig.Services["ORCH"] = serviceconfig
+					tools.WriteConfig(newconfig)
+				}
+				return
+
+			case 3:
+				var address string
+				fmt.Printf("[prompt] the current value of 'ORCH Metrics Address' is '%v'. enter the new value such as ':9100' (0 to not make a change, - to disable the metrics)\n", currentconfig.Metrics.Address)
+				fmt.Scanln(&address)
+
+				switch address {
+				case "0":
+				case "-":
+					newconfig.Metrics.Address = ""
+					tools.WriteConfig(newconfig)
+				default:
+					newconfig.Metrics.Address = address
 					tools.WriteConfig(newconfig)
 				}
 				return
@@ -268,7 +301,9 @@ var configModifyCmd = &cobra.Command{
 				fmt.Scanln(&hosturl)
 
 				if hosturl != "0" {
-					newconfig.Services["LINK"] = tools.ServiceConfig{Host: hosturl, Port: currentconfig.Services["LINK"].Port}
+					serviceconfig := currentconfig.Services["LINK"]
+					serviceconfig.Host = hosturl
+					newconfig.Services["LINK"] = serviceconfig
 					tools.WriteConfig(newconfig)
 				}
 				return
@@ -279,7 +314,9 @@ var configModifyCmd = &cobra.Command{
 				fmt.Scanln(&hostport)
 
 				if hostport != 0 {
-					newconfig.Services["LINK"] = tools.ServiceConfig{Host: currentconfig.Services["LINK"].Host, Port: hostport}
+					serviceconfig := currentconfig.Services["LINK"]
+					serviceconfig.Port = hostport
+					newconfig.Services["LINK"] = serviceconfig
 					tools.WriteConfig(newconfig)
 				}
 				return
@@ -311,16 +348,82 @@ func showconfig() {
 	fmt.Printf("Device ID: %v\n", config.DeviceID)
 	fmt.Printf("Device Type: %v\n", config.DeviceType)
 	fmt.Printf("Scheduler Ping Rate: %v\n", config.SchedulerPingRate)
+	fmt.Printf("Shutdown Timeout: %v\n", config.GetShutdownTimeout())
 	fmt.Println()
 
 	fmt.Println("-- ORCH Configuration --")
 	fmt.Printf("Host: %v\n", config.Services["ORCH"].Host)
 	fmt.Printf("Port: %v\n", config.Services["ORCH"].Port)
+	fmt.Printf("TLS: %v (mutual: %v)\n", config.Services["ORCH"].TLS, config.Services["ORCH"].MutualTLS)
+	fmt.Printf("Auth: %v\n", config.Services["ORCH"].Auth)
+	if config.Metrics.Address != "" {
+		fmt.Printf("Metrics: http://%v/metrics\n", config.Metrics.Address)
+	} else {
+		fmt.Println("Metrics: disabled")
+	}
 	fmt.Println()
 
 	fmt.Println("-- LINK Configuration --")
 	fmt.Printf("Host: %v\n", config.Services["LINK"].Host)
 	fmt.Printf("Port: %v\n", config.Services["LINK"].Port)
+	fmt.Printf("TLS: %v (mutual: %v)\n", config.Services["LINK"].TLS, config.Services["LINK"].MutualTLS)
+	fmt.Println()
+
+	fmt.Println("-- TLS Configuration --")
+	fmt.Printf("CA Certificate: %v\n", config.TLS.CA)
+	fmt.Printf("Device Certificate: %v\n", config.TLS.Cert)
+	fmt.Printf("Device Key: %v\n", config.TLS.Key)
+	fmt.Println()
+
+	fmt.Println("-- Queue Configuration --")
+	for _, queue := range []string{tools.QueueLog, tools.QueueCommand, tools.QueueAccumulator, tools.QueueObserver} {
+		queueconfig := config.GetQueueConfig(queue)
+		fmt.Printf("%v: capacity %v | policy %v\n", queue, queueconfig.Capacity, queueconfig.Policy)
+	}
+	fmt.Println()
+
+	fmt.Println("-- Outbox Configuration --")
+	fmt.Printf("Outbox Enabled: %v\n", config.Outbox.Enabled)
+	fmt.Printf("Outbox Capacity: %v\n", config.Outbox.GetCapacity())
+	fmt.Printf("Outbox TTL: %v\n", config.Outbox.GetTTL(""))
+	commands := make([]string, 0, len(config.Outbox.TTLs))
+	for command := range config.Outbox.TTLs {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	for _, command := range commands {
+		fmt.Printf("Outbox TTL (%v): %v\n", command, config.Outbox.GetTTL(command))
+	}
+	fmt.Println()
+
+	fmt.Println("-- Node Liveness Configuration --")
+	fmt.Printf("Stale After: %v\n", config.Liveness.GetStale())
+	fmt.Printf("Offline After: %v\n", config.Liveness.GetOffline())
+	fmt.Println()
+
+	fmt.Println("-- Rate Limit Configuration --")
+	fmt.Printf("Rate Limit Enabled: %v\n", config.RateLimit.Enabled)
+	rate, burst := config.RateLimit.GetGlobal()
+	fmt.Printf("Global Limit: %v calls/s | burst %v\n", rate, burst)
+	rate, burst = config.RateLimit.GetCaller()
+	fmt.Printf("Caller Limit: %v calls/s | burst %v\n", rate, burst)
+	if len(config.RateLimit.Exempt) > 0 {
+		fmt.Printf("Exempt Callers: %v\n", strings.Join(config.RateLimit.Exempt, ", "))
+	}
+	fmt.Println()
+
+	fmt.Println("-- Tracing Configuration --")
+	switch config.Tracing.Exporter {
+	case "":
+		fmt.Println("Tracing: disabled")
+	case tools.TraceExporterFile:
+		fmt.Printf("Tracing: %v (%v)\n", config.Tracing.Exporter, config.Tracing.GetTraceFile("<service>"))
+	default:
+		fmt.Printf("Tracing: %v (endpoint: %v | insecure: %v)\n", config.Tracing.Exporter, config.Tracing.GetEndpoint(), config.Tracing.Insecure)
+	}
+	if config.Tracing.Exporter != "" && config.Tracing.SampleRatio > 0 {
+		fmt.Printf("Sample Ratio: %v\n", config.Tracing.SampleRatio)
+	}
 	fmt.Println()
 
 	fmt.Println("---- end of file ----")
@@ -70,6 +70,7 @@ while also serving as a way to indicate that the CLI is currently communicating
 				fmt.Printf("[success] connection status successfully set to 'true'\n")
 			} else {
 				fmt.Printf("[failure] connection status failed to be set - %v\n", err)
+				printRetryHint(err)
 			}
 
 		case "off", "false", "disconnect", "no":
@@ -80,6 +81,7 @@ while also serving as a way to indicate that the CLI is currently communicating
 				fmt.Printf("[success] connection status successfully set to 'off'\n")
 			} else {
 				fmt.Printf("[failure] connection status failed to be set - %v\n", err)
+				printRetryHint(err)
 			}
 
 		default:
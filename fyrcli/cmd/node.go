@@ -0,0 +1,481 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// nodeCmd represents the node command
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "Manages the individual nodes of the mesh.",
+	Long: `Manages the individual nodes of the mesh and their records in the node registry.
+
+The node registry of the ORCH server holds a record for each node ID with a human name, 
+free-form labels, GPS coordinates and a zone, which are attached to the sensor data of 
+the node and written into the mesh and ping documents on the cloud.`,
+}
+
+// nodeDescribeCmd represents the 'node describe' command
+var nodeDescribeCmd = &cobra.Command{
+	Use:   "describe [node]",
+	Short: "Displays the details of a node of the mesh.",
+	Long: `Displays the details of a node of the mesh by its node ID. 
+The details include the config of the node with the types and pins of its sensors, 
+the sensor types it reports, its liveness and its latest sensor data.
+
+The 'format(f)' flag sets the output format and accepts the following values.
+- 'text': prints the details of the node as text (default)
+- 'json': prints the details of the node as a JSON object`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		format, _ := cmd.Flags().GetString("format")
+
+		// Check the flag values
+		if format != "text" && format != "json" {
+			fmt.Printf("[error] invalid format - %v. must be one of 'text' or 'json'\n", format)
+			return
+		}
+
+		// Parse the node ID
+		nodeid, err := strconv.ParseInt(args[0], 0, 64)
+		if err != nil || nodeid <= 0 {
+			fmt.Printf("[error] invalid node ID - %v\n", args[0])
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the GetNode method.
+		nodeinfo, err := orch.Call_ORCH_GetNode(*client, nodeid)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Print the node in the requested format.
+		if format == "json" {
+			jsonnode, _ := protojson.Marshal(nodeinfo)
+			fmt.Println(string(jsonnode))
+			return
+		}
+		printNodeInfo(nodeinfo)
+	},
+}
+
+// A function that prints the details of a node from a NodeInfo.
+func printNodeInfo(nodeinfo *pb.NodeInfo) {
+	fmt.Printf("node: %v\n", nodeinfo.GetNode())
+
+	// Print the config of the node
+	fmt.Println("-- Node Configuration --")
+	if nodeinfo.GetSerialBaud() == 0 {
+		fmt.Println("[info] the config of the node has not been read yet.")
+	} else {
+		fmt.Printf("Sensor Config - %v\n", nodeinfo.GetConfig())
+		fmt.Printf("Capabilities - %v\n", strings.Join(nodeinfo.GetCapabilities(), ", "))
+		fmt.Printf("Serial Baud - %v\n", nodeinfo.GetSerialBaud())
+		fmt.Printf("DHT Sensor - type %v | pin %v\n", nodeinfo.GetDhtType(), nodeinfo.GetDhtPin())
+		fmt.Printf("FLM Sensor - type %v | pin %v\n", nodeinfo.GetFlmType(), nodeinfo.GetFlmPin())
+		fmt.Printf("GAS Sensor - type %v | pin %v\n", nodeinfo.GetGasType(), nodeinfo.GetGasPin())
+		fmt.Printf("Pinger - %v | pin %v\n", nodeinfo.GetPinger(), nodeinfo.GetPingerPin())
+		fmt.Printf("Connect LED - pin %v\n", nodeinfo.GetConnectPin())
+	}
+
+	// Print the record of the node
+	fmt.Println("-- Node Record --")
+	if record := nodeinfo.GetRecord(); record == nil {
+		fmt.Println("[info] the node is not in the registry.")
+	} else {
+		printNodeRecord(record)
+	}
+
+	// Print the liveness of the node
+	fmt.Println("-- Node Liveness --")
+	if liveness := nodeinfo.GetLiveness(); liveness == nil {
+		fmt.Println("[info] the liveness of the node is not tracked.")
+	} else {
+		fmt.Printf("State - %v (since %v)\n", liveness.GetState(), liveness.GetSince())
+		fmt.Printf("Last Seen - %v\n", liveness.GetLastSeen())
+		fmt.Printf("Missed Pings - %v\n", liveness.GetMissedPings())
+	}
+
+	// Print the latest sensor data of the node
+	fmt.Println("-- Latest Reading --")
+	if reading := nodeinfo.GetLastReading(); reading == nil {
+		fmt.Println("[info] no sensor data has been received from the node.")
+	} else {
+		fmt.Printf("Time - %v | ping - %v\n", reading.GetTime(), reading.GetPingID())
+		fmt.Printf("Fire Probability - %.2f\n", reading.GetFireProbability())
+
+		// Print the sensor readings sorted by their sensor type
+		sensortypes := make([]string, 0, len(reading.GetSensordata()))
+		for sensortype := range reading.GetSensordata() {
+			sensortypes = append(sensortypes, sensortype)
+		}
+		sort.Strings(sensortypes)
+		for _, sensortype := range sensortypes {
+			fmt.Printf("%v - %v\n", sensortype, reading.GetSensordata()[sensortype])
+		}
+	}
+}
+
+// A function that prints the name, zone, location and labels of a NodeRecord.
+func printNodeRecord(record *pb.NodeRecord) {
+	fmt.Printf("Name - %v\n", record.GetName())
+	fmt.Printf("Zone - %v\n", record.GetZone())
+	if location := record.GetLocation(); location != nil {
+		fmt.Printf("Location - %v, %v\n", location.GetLatitude(), location.GetLongitude())
+	} else {
+		fmt.Println("Location - ")
+	}
+	fmt.Printf("Labels - %v\n", formatLabels(record.GetLabels()))
+	fmt.Printf("Updated - %v\n", record.GetUpdated())
+}
+
+// A function that returns a map of labels as 'key=value' pairs sorted by their key and separated by ';'.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", key, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// A function that parses and returns a node ID from a command argument.
+func parseNodeArg(arg string) (int64, error) {
+	nodeid, err := strconv.ParseInt(arg, 0, 64)
+	if err != nil || nodeid <= 0 {
+		return 0, fmt.Errorf("invalid node ID - %v", arg)
+	}
+	return nodeid, nil
+}
+
+// nodeSetCmd represents the 'node set' command
+var nodeSetCmd = &cobra.Command{
+	Use:   "set [node]",
+	Short: "Sets the record of a node in the node registry.",
+	Long: `Sets the name, zone, location and labels of a node in the node registry by its node ID. 
+The record is created if the node is not in the registry yet. Only the values of the flags 
+that are set are changed, and setting a flag to an empty string clears its value.
+
+The 'location(l)' flag sets the GPS coordinates of the node as 'latitude,longitude' in decimal degrees.
+The 'label(L)' flag adds a label as 'key=value' or replaces its value and can be repeated.
+The 'remove-label(R)' flag removes a label by its key and can be repeated.
+
+Example: fyrcli node set 2000000001 -n "oak 14" -z north-ridge -l 11.0168,76.9558 -L line=3 -L mount=pole`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse the node ID
+		nodeid, err := parseNodeArg(args[0])
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Create the request with the changed flag values
+		request := &pb.SetNodeRecordRequest{Node: nodeid}
+		if cmd.Flags().Changed("name") {
+			request.Name, _ = cmd.Flags().GetString("name")
+			request.Update = append(request.Update, "name")
+		}
+		if cmd.Flags().Changed("zone") {
+			request.Zone, _ = cmd.Flags().GetString("zone")
+			request.Update = append(request.Update, "zone")
+		}
+		if cmd.Flags().Changed("location") {
+			coordinates, _ := cmd.Flags().GetString("location")
+			if coordinates != "" {
+				parts := strings.Split(coordinates, ",")
+				if len(parts) != 2 {
+					fmt.Printf("[error] invalid location - %v. must be of the form 'latitude,longitude'\n", coordinates)
+					return
+				}
+				location, err := tools.ParseLocation(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+				if err != nil {
+					fmt.Printf("[error] %v\n", err)
+					return
+				}
+				request.Location = &pb.NodeLocation{Latitude: location.Latitude, Longitude: location.Longitude}
+			}
+			request.Update = append(request.Update, "location")
+		}
+		labelpairs, _ := cmd.Flags().GetStringArray("label")
+		if request.Labels, err = tools.ParseLabels(labelpairs); err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+		request.RemoveLabels, _ = cmd.Flags().GetStringArray("remove-label")
+
+		// Check that the request changes the record
+		if len(request.Update) == 0 && len(request.Labels) == 0 && len(request.RemoveLabels) == 0 {
+			fmt.Println("[error] nothing to set. set at least one of the 'name', 'zone', 'location', 'label' or 'remove-label' flags")
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the SetNodeRecord method.
+		record, err := orch.Call_ORCH_SetNodeRecord(*client, request)
+		if err != nil {
+			fmt.Printf("[failure] record of node %v could not be set\n", nodeid)
+			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
+			return
+		}
+
+		fmt.Printf("[success] record of node %v set.\n", nodeid)
+		printNodeRecord(record)
+	},
+}
+
+// nodeRemoveCmd represents the 'node remove' command
+var nodeRemoveCmd = &cobra.Command{
+	Use:   "remove [node]",
+	Short: "Removes the record of a node from the node registry.",
+	Long:  `Removes the record of a node from the node registry by its node ID. The node itself is not affected.`,
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse the node ID
+		nodeid, err := parseNodeArg(args[0])
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the DeleteNodeRecord method.
+		if _, err := orch.Call_ORCH_DeleteNodeRecord(*client, nodeid); err != nil {
+			fmt.Printf("[failure] record of node %v could not be removed\n", nodeid)
+			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
+			return
+		}
+
+		fmt.Printf("[success] record of node %v removed.\n", nodeid)
+	},
+}
+
+// nodeListCmd represents the 'node list' command
+var nodeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Displays the records of the node registry.",
+	Long: `Displays the records of the node registry sorted by their node ID, including the records of nodes 
+that are not on the mesh. The 'zone(z)' flag only displays the records of the nodes in a zone.
+
+The 'format(f)' flag sets the output format and accepts the following values.
+- 'text': prints the records as a table (default)
+- 'csv': prints the records as a registry CSV file that can be imported with 'fyrcli node import'`,
+	Args: cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		zone, _ := cmd.Flags().GetString("zone")
+		format, _ := cmd.Flags().GetString("format")
+
+		// Check the flag values
+		if format != "text" && format != "csv" {
+			fmt.Printf("[error] invalid format - %v. must be one of 'text' or 'csv'\n", format)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the ListNodeRecords method.
+		records, err := orch.Call_ORCH_ListNodeRecords(*client, zone)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Print the records in the requested format.
+		if format == "csv" {
+			fmt.Println("node,name,zone,latitude,longitude,labels")
+			for _, record := range records {
+				var latitude, longitude string
+				if location := record.GetLocation(); location != nil {
+					latitude = strconv.FormatFloat(location.GetLatitude(), 'f', -1, 64)
+					longitude = strconv.FormatFloat(location.GetLongitude(), 'f', -1, 64)
+				}
+				fmt.Printf("%v,%v,%v,%v,%v,%v\n", record.GetNode(), csvField(record.GetName()), csvField(record.GetZone()),
+					latitude, longitude, csvField(formatLabels(record.GetLabels())))
+			}
+			return
+		}
+
+		if len(records) == 0 {
+			fmt.Println("[info] the node registry has no records.")
+			return
+		}
+		fmt.Printf("%-12s %-20s %-16s %-24s %v\n", "NODE", "NAME", "ZONE", "LOCATION", "LABELS")
+		for _, record := range records {
+			location := "-"
+			if record.GetLocation() != nil {
+				location = fmt.Sprintf("%v,%v", record.GetLocation().GetLatitude(), record.GetLocation().GetLongitude())
+			}
+			fmt.Printf("%-12v %-20s %-16s %-24s %v\n", record.GetNode(), record.GetName(), record.GetZone(), location, formatLabels(record.GetLabels()))
+		}
+	},
+}
+
+// A function that quotes a value for a CSV file if it contains a comma, a quote or a newline.
+func csvField(value string) string {
+	if strings.ContainsAny(value, ",\"\n") {
+		return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+	}
+	return value
+}
+
+// nodeImportCmd represents the 'node import' command
+var nodeImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Imports the records of the node registry from a CSV file.",
+	Long: `Imports the records of the node registry from a CSV file. The first row of the file must be a header 
+that names its columns, among which the 'node' column is required. The 'name', 'zone', 'latitude' and 
+'longitude' columns map to the fields of the record and the 'labels' column holds the labels of the node 
+as 'key=value' pairs separated by ';'. Any other column is imported as a label named after the column 
+in lowercase, so that an existing spreadsheet can be exported and imported as it is. Lines that start with '#' are ignored.
+
+node,name,zone,latitude,longitude,labels,line
+2000000001,oak 14,north-ridge,11.0168,76.9558,mount=pole,3
+
+The imported records replace the records of the same nodes. The 'replace(r)' flag also removes 
+the records of the nodes that are not in the file. No record is imported if any row is invalid.`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		replace, _ := cmd.Flags().GetBool("replace")
+
+		// Read and parse the CSV file
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("[error] registry CSV file could not be opened - %v\n", err)
+			return
+		}
+		defer file.Close()
+		records, err := tools.ParseRegistryCSV(file)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+		if err := tools.ValidateImport(records); err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the ImportNodeRecords method.
+		imported, err := orch.Call_ORCH_ImportNodeRecords(*client, records, replace)
+		if err != nil {
+			fmt.Println("[failure] node registry could not be imported")
+			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
+			return
+		}
+
+		fmt.Printf("[success] node registry imported | records - %v\n", imported)
+	},
+}
+
+func init() {
+	// Add the command 'node' to root CLI command.
+	rootCmd.AddCommand(nodeCmd)
+	// Add the commands 'describe', 'set', 'remove', 'list' and 'import' to the 'node' command.
+	nodeCmd.AddCommand(nodeDescribeCmd)
+	nodeCmd.AddCommand(nodeSetCmd)
+	nodeCmd.AddCommand(nodeRemoveCmd)
+	nodeCmd.AddCommand(nodeListCmd)
+	nodeCmd.AddCommand(nodeImportCmd)
+
+	// Define the flags of the 'describe' command
+	nodeDescribeCmd.Flags().StringP("format", "f", "text", "output format of the node details (text or json)")
+
+	// Define the flags of the 'set' command
+	nodeSetCmd.Flags().StringP("name", "n", "", "human name of the node")
+	nodeSetCmd.Flags().StringP("zone", "z", "", "zone of the node")
+	nodeSetCmd.Flags().StringP("location", "l", "", "GPS coordinates of the node as 'latitude,longitude'")
+	nodeSetCmd.Flags().StringArrayP("label", "L", []string{}, "label to set as 'key=value' (repeatable)")
+	nodeSetCmd.Flags().StringArrayP("remove-label", "R", []string{}, "key of a label to remove (repeatable)")
+
+	// Define the flags of the 'list' command
+	nodeListCmd.Flags().StringP("zone", "z", "", "zone to list the records of")
+	nodeListCmd.Flags().StringP("format", "f", "text", "output format of the records (text or csv)")
+
+	// Define the flags of the 'import' command
+	nodeImportCmd.Flags().BoolP("replace", "r", false, "remove the records of the nodes that are not in the file")
+}
@@ -29,19 +29,28 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
 )
 
 // nodelistCmd represents the nodelist command
 var nodelistCmd = &cobra.Command{
 	Use:   "nodelist",
 	Short: "Displays the list of nodes connected to the mesh.",
-	Long:  `Displays the list of nodes connected to the mesh.`,
+	Long: `Displays the list of nodes connected to the mesh.
+
+The 'wide(w)' flag also displays the name and zone of each node from the node registry, the pins 
+of the sensors attached to it, the sensor types it reports and its latest sensor data.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		wide, _ := cmd.Flags().GetBool("wide")
+
 		// Connect to the ORCH gRPC server.
 		client, conn, err := orch.GRPCconnect_ORCH()
 		defer conn.Close()
@@ -53,19 +62,120 @@ var nodelistCmd = &cobra.Command{
 		nodelist, err := orch.Call_ORCH_Nodelist(*client)
 		if err != nil {
 			fmt.Printf("[error] call to read mesh node list failed -%v", err)
+			return
 		}
 
-		// Iterate over the nodelist and print it.
+		// Print the nodelist.
 		fmt.Println("mesh nodelist:")
-
-		index := 1
-		for nodeid, nodeconfig := range nodelist {
-			fmt.Printf("%v] %v\t%v\n", index, nodeid, nodeconfig)
-			index++
+		if wide {
+			printNodelistWide(nodelist)
+			return
 		}
+		printNodelist(nodelist)
 	},
 }
 
+// A function that prints the nodes of a NodeList sorted by their node ID along with their config and
+// liveness. Nodes that are tracked for liveness but whose config has not been read yet are also printed.
+func printNodelist(nodelist *pb.NodeList) {
+	// Collect the IDs of the nodes with a config or a liveness
+	nodeids := make([]int64, 0)
+	for nodeid := range nodelist.GetNodes() {
+		nodeids = append(nodeids, nodeid)
+	}
+	for nodeid := range nodelist.GetLiveness() {
+		if _, ok := nodelist.GetNodes()[nodeid]; !ok {
+			nodeids = append(nodeids, nodeid)
+		}
+	}
+	sort.Slice(nodeids, func(i, j int) bool { return nodeids[i] < nodeids[j] })
+
+	// Iterate over the nodes and print them.
+	for index, nodeid := range nodeids {
+		nodeconfig, ok := nodelist.GetNodes()[nodeid]
+		if !ok {
+			nodeconfig = "-"
+		}
+
+		liveness, ok := nodelist.GetLiveness()[nodeid]
+		if !ok {
+			fmt.Printf("%v] %v\t%v\n", index+1, nodeid, nodeconfig)
+			continue
+		}
+		fmt.Printf("%v] %v\t%v\t%v (since %v) | last seen - %v | missed pings - %v\n",
+			index+1, nodeid, nodeconfig, liveness.GetState(), liveness.GetSince(), liveness.GetLastSeen(), liveness.GetMissedPings())
+	}
+}
+
+// A function that prints the nodes of a NodeList sorted by their node ID along with their name and zone, the pins
+// of their sensors, their capabilities, their liveness and their latest sensor data from the details of the NodeList.
+func printNodelistWide(nodelist *pb.NodeList) {
+	// Collect the IDs of the described nodes
+	nodeids := make([]int64, 0, len(nodelist.GetDetails()))
+	for nodeid := range nodelist.GetDetails() {
+		nodeids = append(nodeids, nodeid)
+	}
+	sort.Slice(nodeids, func(i, j int) bool { return nodeids[i] < nodeids[j] })
+
+	// Iterate over the nodes and print them.
+	for index, nodeid := range nodeids {
+		nodeinfo := nodelist.GetDetails()[nodeid]
+
+		// Assign the state of the node
+		state := "-"
+		if nodeinfo.GetLiveness() != nil {
+			state = nodeinfo.GetLiveness().GetState()
+		}
+
+		// Assign the latest sensor data of the node
+		reading := "last reading - none"
+		if nodeinfo.GetLastReading() != nil {
+			reading = fmt.Sprintf("last reading - %v | fire probability - %.2f",
+				nodeinfo.GetLastReading().GetTime(), nodeinfo.GetLastReading().GetFireProbability())
+		}
+
+		// Assign the name and zone of the node from its record
+		name, zone := "-", "-"
+		if record := nodeinfo.GetRecord(); record != nil {
+			if record.GetName() != "" {
+				name = record.GetName()
+			}
+			if record.GetZone() != "" {
+				zone = record.GetZone()
+			}
+		}
+
+		fmt.Printf("%v] %v\t%v\t%v | name - %v | zone - %v | pins - %v | capabilities - %v | %v\n",
+			index+1, nodeid, nodeinfo.GetConfig(), state, name, zone, nodePins(nodeinfo), strings.Join(nodeinfo.GetCapabilities(), ","), reading)
+	}
+}
+
+// A function that returns the pins of the sensors, pinger button and connect LED of a node as a string.
+func nodePins(nodeinfo *pb.NodeInfo) string {
+	// Check if the config of the node is known
+	if nodeinfo.GetSerialBaud() == 0 {
+		return "-"
+	}
+
+	// Collect the pins of the attached sensors
+	pins := make([]string, 0)
+	if nodeinfo.GetDhtType() > 0 {
+		pins = append(pins, fmt.Sprintf("DHT:%v", nodeinfo.GetDhtPin()))
+	}
+	if nodeinfo.GetFlmType() > 0 {
+		pins = append(pins, fmt.Sprintf("FLM:%v", nodeinfo.GetFlmPin()))
+	}
+	if nodeinfo.GetGasType() > 0 {
+		pins = append(pins, fmt.Sprintf("GAS:%v", nodeinfo.GetGasPin()))
+	}
+	if nodeinfo.GetPinger() {
+		pins = append(pins, fmt.Sprintf("PNG:%v", nodeinfo.GetPingerPin()))
+	}
+	pins = append(pins, fmt.Sprintf("LED:%v", nodeinfo.GetConnectPin()))
+
+	return strings.Join(pins, " ")
+}
+
 // nodelistCmd represents the nodelist command
 var nodelistUpdateCmd = &cobra.Command{
 	Use:   "update",
@@ -82,17 +192,15 @@ var nodelistUpdateCmd = &cobra.Command{
 
 		// Call the Command method.
 		commandmap := map[string]string{"command": "readnodelist-control"}
-		success, err := orch.Call_ORCH_Command(*client, commandmap)
-		if err != nil {
-			fmt.Printf("[error] call to update mesh node list failed -%v", err)
-		}
+		commandid, err := orch.Call_ORCH_Command(*client, commandmap)
 
-		// Check the success value and print output
-		if success {
-			fmt.Println("[success] command to update nodelist was sent successfully")
+		// Check the error and print output
+		if err == nil {
+			fmt.Printf("[success] command to update nodelist was sent successfully | cmdid - %v\n", commandid)
 		} else {
 			fmt.Println("[failure] command to update nodelist failed to be sent")
 			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
 		}
 	},
 }
@@ -100,6 +208,8 @@ var nodelistUpdateCmd = &cobra.Command{
 func init() {
 	// Add the command 'nodelist' to root CLI command.
 	rootCmd.AddCommand(nodelistCmd)
+	// Add the 'wide' flag to the 'nodelist' command
+	nodelistCmd.Flags().BoolP("wide", "w", false, "display the pins, capabilities and latest sensor data of the nodes")
 	//
 	nodelistCmd.AddCommand(nodelistUpdateCmd)
 }
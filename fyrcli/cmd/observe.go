@@ -30,11 +30,14 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
 	tools "github.com/fyrwatch/fyrmesh/tools"
 )
 
@@ -44,20 +47,30 @@ var observeCmd = &cobra.Command{
 	Short: "Observes the logstream from the ORCH server.",
 	Long: `Observes the logstream from the ORCH server and prints them to the console.
 
-The observation of the logstream can be filtered based on the source of the log, 
-the type of the log or both. A valid combination must be used when both are passed.
+The observation of the logstream can be filtered based on the source of the log, the type of the log,
+the metadata of the log and the severity of the log. Only logs that match every filter are printed.
 
-Valid source and type filters:
-- For the source filter:	'MESH', 'LINK' and 'ORCH'.
-- For the type filter:
-	- 'serverlog', 'protolog' (only supported for the 'LINK' and 'ORCH' source filter)
-	- 'cloudlog', 'schedlog', 'obstoggle' (only supported for the 'ORCH' source filter)
-	- 'message', 'newconnection', 'changedconnection', 'nodetimeadjust', 'handshake', 'sensordata',
-	'configdata', 'controlconfig', 'nodelist' (only supported for the 'MESH' source filter)
+The 'source(s)' and 'type(t)' flags accept one or more comma-separated values. A log matches if its
+source is one of the sources and its type is one of the types.
+- Valid source filters:	'MESH', 'LINK' and 'ORCH'.
+- Valid type filters:
+	- 'serverlog', 'protolog' (only generated by the 'LINK' and 'ORCH' sources)
+	- 'cloudlog', 'schedlog', 'nodestate' (only generated by the 'ORCH' source)
+	- 'message', 'meshsync', 'nodesync', 'handshake', 'sensordata', 'configdata', 'ctrldata', 
+	'nodelist' (only generated by the 'MESH' source)
+
+The 'match(m)' flag sets a metadata matcher of the form 'key=pattern' and can be repeated.
+The pattern is a glob such as 'userping-*'. For example, '-m node=123 -m ping=userping-*'.
+
+The 'severity(l)' flag sets the minimum severity of the logs to print.
+Valid values are 'debug', 'info', 'warning' and 'error'.
+
+The 'json(j)' flag prints every log as a JSON object instead of the formatted log.
 
 Observation of ORCH log can only performed by a device configured as a 'mesh-observer'.
 The observer collects the logs being printed to the ORCH server console and prints them on the 
-terminal that invokes it. Observer logs have the '[OBS]' suffix followed by the log itself.`,
+terminal that invokes it. Any number of observers can watch the logstream at once.
+Observer logs have the '[OBS]' suffix followed by the log itself.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		// Read the config file.
@@ -74,71 +87,71 @@ terminal that invokes it. Observer logs have the '[OBS]' suffix followed by the
 			return
 		}
 
-		// Connect to the ORCH gRPC server.
-		client, conn, err := orch.GRPCconnect_ORCH()
-		defer conn.Close()
-		if err != nil {
-			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
-		}
-
 		// Retrieve the log filter flags
-		sourcefilter, _ := cmd.Flags().GetString("source")
-		typefilter, _ := cmd.Flags().GetString("type")
-
-		// Check the value of source filter
-		switch sourcefilter {
-		case "ORCH":
-			// Check the value of type filter
-			switch typefilter {
-			case "serverlog", "protolog", "cloudlog", "schedlog", "obstoggle", "":
+		sourcefilters, _ := cmd.Flags().GetStringSlice("source")
+		typefilters, _ := cmd.Flags().GetStringSlice("type")
+		matchfilters, _ := cmd.Flags().GetStringArray("match")
+		severityfilter, _ := cmd.Flags().GetString("severity")
+		jsonoutput, _ := cmd.Flags().GetBool("json")
+
+		// Check the values of the source filters
+		for _, sourcefilter := range sourcefilters {
+			switch sourcefilter {
+			case "ORCH", "LINK", "MESH":
 			default:
-				fmt.Println("[error] invalid type filter applied for the 'ORCH' source filter")
+				fmt.Printf("[error] invalid source filter applied - %v\n", sourcefilter)
 				return
 			}
+		}
 
-		case "LINK":
-			// Check the value of type filter
+		// Check the values of the type filters
+		for _, typefilter := range typefilters {
 			switch typefilter {
-			case "serverlog", "protolog", "":
+			case "serverlog", "protolog", "cloudlog", "schedlog", "nodestate":
+			case "message", "meshsync", "nodesync", "handshake":
+			case "sensordata", "configdata", "ctrldata", "nodelist":
 			default:
-				fmt.Println("[error] invalid type filter applied for the 'LINK' source filter")
+				fmt.Printf("[error] invalid type filter applied - %v\n", typefilter)
 				return
 			}
+		}
 
-		case "MESH":
-			// Check the value of type filter
-			switch typefilter {
-			case "message", "newconnection", "changedconnection", "nodetimeadjust", "":
-			case "handshake", "sensordata", "configdata", "controlconfig", "nodelist":
-			default:
-				fmt.Println("[error] invalid type filter applied for the 'MESH' source filter")
+		// Parse the metadata matchers into a map
+		metadatafilter := make(map[string]string)
+		for _, matchfilter := range matchfilters {
+			matcher := strings.SplitN(matchfilter, "=", 2)
+			if len(matcher) != 2 || matcher[0] == "" {
+				fmt.Printf("[error] invalid metadata matcher applied - %v. must be of the form 'key=pattern'\n", matchfilter)
 				return
 			}
+			metadatafilter[matcher[0]] = matcher[1]
+		}
 
-		case "":
-			switch typefilter {
-			case "serverlog", "protolog", "cloudlog", "schedlog", "obstoggle", "":
-			case "message", "newconnection", "changedconnection", "nodetimeadjust":
-			case "handshake", "sensordata", "configdata", "controlconfig", "nodelist":
-			default:
-				fmt.Println("[error] invalid type filter applied")
-				return
-			}
+		// Check the value of the severity filter
+		if _, err := tools.ParseSeverity(severityfilter); err != nil {
+			fmt.Printf("[error] invalid severity filter applied - %v\n", err)
+			return
+		}
 
-		default:
-			fmt.Println("[error] invalid source filter applied")
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
 			return
 		}
 
 		// Call the Observe method of the ORCH server with the log filters
-		stream, err := orch.Call_ORCH_Observe(*client, sourcefilter, typefilter)
+		filter := &pb.ObserveFilter{Sources: sourcefilters, Types: typefilters, Metadata: metadatafilter, Severity: severityfilter}
+		stream, err := orch.Call_ORCH_Observe(*client, filter)
 		if err != nil {
 			fmt.Printf("[error] observe stream failed to be established - %v\n", err)
+			return
 		}
 
 		// Start an infinite loop to read from the stream
 		for {
-			// Recieve an Message object from the stream
+			// Recieve a ComplexLog object from the stream
 			observelog, err := stream.Recv()
 
 			// Break out of loop if stream has closed
@@ -149,12 +162,17 @@ terminal that invokes it. Observer logs have the '[OBS]' suffix followed by the
 			// Print any other error and break out of the loop.
 			if err != nil {
 				errstatus, _ := status.FromError(err)
-				fmt.Printf("[error] observe stream broke. error while streaming - (%v)%v", errstatus.Code(), errstatus.Message())
+				fmt.Printf("[error] observe stream broke. error while streaming - (%v)%v\n", errstatus.Code(), errstatus.Message())
 				break
 			}
 
 			// Print the observer log to the console.
-			fmt.Printf("[OBS] %v\n", observelog.GetMessage())
+			if jsonoutput {
+				jsonlog, _ := protojson.Marshal(observelog)
+				fmt.Println(string(jsonlog))
+			} else {
+				fmt.Printf("[OBS] %v\n", tools.FormatLog(observelog))
+			}
 		}
 	},
 }
@@ -164,7 +182,13 @@ func init() {
 	rootCmd.AddCommand(observeCmd)
 
 	// Add the flag 'source'
-	observeCmd.Flags().StringP("source", "s", "", "value of source log filter")
+	observeCmd.Flags().StringSliceP("source", "s", []string{}, "values of source log filter")
 	// Add the flag 'type'
-	observeCmd.Flags().StringP("type", "t", "", "value of type log filter")
+	observeCmd.Flags().StringSliceP("type", "t", []string{}, "values of type log filter")
+	// Add the flag 'match'
+	observeCmd.Flags().StringArrayP("match", "m", []string{}, "metadata log filter of the form 'key=pattern'")
+	// Add the flag 'severity'
+	observeCmd.Flags().StringP("severity", "l", "", "minimum severity of logs to observe")
+	// Add the flag 'json'
+	observeCmd.Flags().BoolP("json", "j", false, "print logs as JSON objects")
 }
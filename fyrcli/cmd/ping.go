@@ -29,10 +29,15 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
 
 	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
 )
 
 // pingCmd represents the ping command
@@ -44,34 +49,53 @@ var pingCmd = &cobra.Command{
 The 'type(t)' flag sets the type of ping to perform. Valid values are 'sensor', 'config' and 'control'.
 The 'node(n)' flag sets a node ID to ping. If this value is not, the whole mesh is pinged.
 The 'phrase(p)' flag sets the user phrase to use in the ping ID, which has the format 'userping-<phrase>' for user generated pings.
+The 'timeout(w)' flag sets the number of seconds to wait for the nodes to respond to the ping.
 
-If the type is set as 'control', the other flags are ignored and the control node is pinged for its config. 
+The responses of the nodes to sensor and config pings are printed as they arrive, followed by 
+the list of nodes that did not respond before the timeout.
 
-Note: the responses from the pings are currently not captured and will appear in the 
-ORCH logs with a MESH source and a sensordata/configdata type.`,
+If the type is set as 'control', the other flags are ignored and the control node is pinged for its config. 
+The response of the control node is not captured and will appear in the ORCH logs with a MESH source and a ctrldata type.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		// Retrieve the command flags
 		pingtype, _ := cmd.Flags().GetString("type")
 		pingnode, _ := cmd.Flags().GetString("node")
 		pingphrase, _ := cmd.Flags().GetString("phrase")
-		// Declare a trigger string
-		var pingtrigger string
+		pingtimeout, _ := cmd.Flags().GetInt("timeout")
+		// Declare the scope, type and node of the ping
+		var pingscope pb.PingScope
+		var pingkind pb.PingType
+		var nodeid int64
 
 		// Check the value of the pingtype
 		switch pingtype {
 		case "sensor", "config":
+			if pingtype == "sensor" {
+				pingkind = pb.PingType_PING_TYPE_SENSOR
+			} else {
+				pingkind = pb.PingType_PING_TYPE_CONFIG
+			}
+
 			if pingnode == "mesh" {
-				pingtrigger = fmt.Sprintf("ping-%s-mesh", pingtype)
+				pingscope = pb.PingScope_PING_SCOPE_MESH
 			} else {
-				pingtrigger = fmt.Sprintf("ping-%s-node", pingtype)
+				// Parse the node ID
+				id, err := strconv.ParseInt(pingnode, 0, 64)
+				if err != nil {
+					fmt.Printf("[error] an invalid node ID was provided - %v\n", pingnode)
+					return
+				}
+				pingscope = pb.PingScope_PING_SCOPE_NODE
+				nodeid = id
 			}
 
 		case "control":
-			pingtrigger = "ping-control"
+			pingscope = pb.PingScope_PING_SCOPE_CONTROL
 
 		default:
 			fmt.Println("[error] an invalid ping type was provided")
+			return
 		}
 
 		// Connect to the ORCH gRPC server.
@@ -79,16 +103,74 @@ ORCH logs with a MESH source and a sensordata/configdata type.`,
 		defer conn.Close()
 		if err != nil {
 			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
 		}
 
-		// Call the Ping method with the trigger, node and phrase.
-		success, err := orch.Call_ORCH_Ping(*client, pingtrigger, pingnode, pingphrase)
-		// Check the acknowledgment and print the appropriate message.
-		if success {
-			fmt.Printf("[success] %v was pinged successfully\n", pingnode)
-		} else {
+		// The control node responds without a ping ID and cannot be streamed.
+		if pingscope == pb.PingScope_PING_SCOPE_CONTROL {
+			// Call the Ping method for the control node.
+			_, err := orch.Call_ORCH_Ping(*client, pingscope, pingkind, nodeid, pingphrase)
+			// Check the error and print the appropriate message.
+			if err == nil {
+				fmt.Println("[success] control node was pinged successfully")
+			} else {
+				fmt.Println("[failure] control node was failed to be pinged")
+				fmt.Printf("[error] %v\n", err)
+				printRetryHint(err)
+			}
+			return
+		}
+
+		// Call the PingStream method with the scope, type, node, phrase and timeout.
+		stream, err := orch.Call_ORCH_PingStream(*client, pingscope, pingkind, nodeid, pingphrase, time.Second*time.Duration(pingtimeout))
+		if err != nil {
 			fmt.Printf("[failure] %v was failed to be pinged\n", pingnode)
 			fmt.Printf("[error] %v\n", err)
+			printRetryHint(err)
+			return
+		}
+
+		// Start an infinite loop to read the responses from the stream
+		replies := 0
+		for {
+			// Recieve a PingResponse object from the stream
+			response, err := stream.Recv()
+
+			// Break out of loop if stream has closed
+			if err == io.EOF {
+				break
+			}
+
+			// Print any other error and break out of the loop.
+			if err != nil {
+				errstatus, _ := status.FromError(err)
+				fmt.Printf("[error] ping stream broke. error while streaming - (%v)%v\n", errstatus.Code(), errstatus.Message())
+				printRetryHint(err)
+				break
+			}
+
+			// Print the summary of the ping once it is complete.
+			if response.GetComplete() {
+				unanswered := response.GetUnanswered()
+				fmt.Printf("[success] %v was pinged | ping - %v | replies - %v\n", pingnode, response.GetPingID(), replies)
+
+				if len(unanswered) != 0 {
+					fmt.Println("[missing] nodes that did not reply:")
+					for index, nodeid := range unanswered {
+						fmt.Printf("%v] %v\n", index+1, nodeid)
+					}
+				}
+				break
+			}
+
+			// Print the reply of the node.
+			replies++
+			switch response.GetPingtype() {
+			case "sensordata":
+				fmt.Printf("[reply] node - %v | time - %v | sensors - %v\n", response.GetNode(), response.GetPingtime(), response.GetSensordata())
+			case "configdata":
+				fmt.Printf("[reply] node - %v | time - %v | config - %v\n", response.GetNode(), response.GetPingtime(), response.GetConfigdata())
+			}
 		}
 	},
 }
@@ -103,4 +185,6 @@ func init() {
 	pingCmd.Flags().StringP("node", "n", "mesh", "node ID to ping")
 	// Add the flag 'phrase'
 	pingCmd.Flags().StringP("phrase", "p", "atestping", "phrase to use with ping ID")
+	// Add the flag 'timeout'
+	pingCmd.Flags().IntP("timeout", "w", 10, "seconds to wait for replies")
 }
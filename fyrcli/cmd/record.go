@@ -0,0 +1,154 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// recordCmd represents the record command
+var recordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Records the logstream from the LINK server.",
+	Long: `Records every log that the ORCH server receives from the LINK server, along with the time it arrived,
+to a compressed recording file. The recording can be attached to a bug report and replayed against a development 
+orchestrator with 'fyrcli replay'. Requires the admin role, since the recording holds the mesh credentials.
+
+The 'output(o)' flag sets the path of the recording file. Defaults to 'recording-<time>.fyrrec' in the current directory.
+The 'duration(d)' flag sets how long to record for, such as '30m' or '2h'. If it is not set, the recording 
+continues until it is stopped with Ctrl+C.`,
+	Args: cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		output, _ := cmd.Flags().GetString("output")
+		duration, _ := cmd.Flags().GetDuration("duration")
+		if duration < 0 || (duration > 0 && duration < time.Second) {
+			fmt.Printf("[error] invalid duration - %v. must be at least a second\n", duration)
+			return
+		}
+		if output == "" {
+			output = fmt.Sprintf("recording-%v.fyrrec", time.Now().UTC().Format("20060102T150405"))
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Cancel the recording on SIGINT or SIGTERM
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		// Call the Record method of the ORCH server with the duration
+		stream, err := orch.Call_ORCH_Record(ctx, *client, duration)
+		if err != nil {
+			fmt.Printf("[error] record stream failed to be established - %v\n", err)
+			return
+		}
+
+		// Create the recording file with the controller ID of the orchestrator in its header
+		controllerid := ""
+		if meshstatus, err := orch.Call_ORCH_Status(*client); err == nil {
+			controllerid = meshstatus.GetControllerID()
+		}
+		file, err := os.Create(output)
+		if err != nil {
+			fmt.Printf("[error] recording file could not be created - %v\n", err)
+			return
+		}
+		defer file.Close()
+		recording, err := tools.NewRecordingWriter(file, time.Now(), controllerid)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		fmt.Printf("[info] recording the LINK logstream to %v. press Ctrl+C to stop.\n", output)
+
+		// Read the recorded logs from the stream and write them to the recording
+		var dropped uint64
+		for {
+			recordedlog, err := stream.Recv()
+
+			// Break out of the loop if the recording has ended or has been stopped
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				break
+			}
+
+			// Print any other error and break out of the loop.
+			if err != nil {
+				errstatus, _ := status.FromError(err)
+				fmt.Printf("[error] record stream broke. error while streaming - (%v)%v\n", errstatus.Code(), errstatus.Message())
+				break
+			}
+
+			// Write the log with its arrival time to the recording
+			dropped = recordedlog.GetDropped()
+			if err := recording.Write(tools.NewRecordedLog(recordedlog.GetLog(), time.Unix(0, recordedlog.GetArrival()))); err != nil {
+				fmt.Printf("[error] %v\n", err)
+				break
+			}
+		}
+
+		// Complete the recording
+		if err := recording.Close(); err != nil {
+			fmt.Printf("[error] recording could not be completed - %v\n", err)
+			return
+		}
+		if dropped > 0 {
+			fmt.Printf("[info] %v logs were dropped from the recording because the recorder fell behind\n", dropped)
+		}
+		fmt.Printf("[success] %v logs recorded to %v\n", recording.Count(), output)
+	},
+}
+
+func init() {
+	// Add the command 'record' to root CLI command.
+	rootCmd.AddCommand(recordCmd)
+
+	// Define the flags of the 'record' command
+	recordCmd.Flags().StringP("output", "o", "", "path of the recording file")
+	recordCmd.Flags().DurationP("duration", "d", 0, "duration of the recording (records until stopped if not set)")
+}
@@ -0,0 +1,173 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// replayCmd represents the replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay <recording>",
+	Short: "Replays a recorded LINK logstream into the ORCH server.",
+	Long: `Replays a recording made with 'fyrcli record' into the ORCH server. By default the replay is a dry-run 
+that only counts the logs of the recording by their type, without changing the state of the orchestrator.
+
+The 'live(l)' flag feeds the logs into the orchestrator as though they were received from the LINK server, 
+so that the behaviour of the orchestrator in the field can be reproduced. Live replays are meant for 
+development orchestrators, since the replayed logs change the liveness, topology, registry and commands 
+of the mesh and are published to the cloud. Requires the admin role.
+
+The 'speed(s)' flag sets the factor by which the original pace of the recording is accelerated.
+The original pace is 1, a value of 10 replays ten times as fast and 0 replays the logs without any delay.
+The 'step(t)' flag replays the recording one log at a time. Press Enter to replay the next log or 
+enter 'q' to stop the replay. The speed is ignored when stepping.`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		speed, _ := cmd.Flags().GetFloat64("speed")
+		step, _ := cmd.Flags().GetBool("step")
+		live, _ := cmd.Flags().GetBool("live")
+		if speed < 0 {
+			fmt.Printf("[error] invalid speed - %v. must not be negative\n", speed)
+			return
+		}
+
+		// Open the recording file and read its header
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("[error] recording file could not be opened - %v\n", err)
+			return
+		}
+		defer file.Close()
+		recording, err := tools.NewRecordingReader(file)
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Cancel the replay on SIGINT or SIGTERM
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		// Call the Replay method of the ORCH server
+		stream, err := orch.Call_ORCH_Replay(ctx, *client, live)
+		if err != nil {
+			fmt.Printf("[error] replay stream failed to be established - %v\n", err)
+			return
+		}
+
+		header := recording.Header()
+		fmt.Printf("[info] replaying the recording of controller '%v' started at %v.\n", header.ControllerID, header.Started)
+
+		// Set the pace of the replay
+		options := tools.ReplayOptions{Speed: speed}
+		if step {
+			stdin := bufio.NewReader(os.Stdin)
+			options.Step = func(log *tools.RecordedLog) bool {
+				// Show the next log and wait for the input
+				fmt.Printf("[next] %v\n", tools.FormatLog(log.Log))
+				fmt.Print("[step] press Enter to replay or enter 'q' to stop: ")
+				input, err := stdin.ReadString('\n')
+				return err == nil && strings.TrimSpace(input) != "q"
+			}
+		}
+
+		// Replay the recording onto the stream, printing every log that is replayed
+		_, err = tools.Replay(ctx, recording, options, func(log tools.Log) error {
+			if err := stream.Send(orch.NewComplexLog(log)); err != nil {
+				return err
+			}
+			fmt.Printf("[RPL] %v\n", tools.FormatLog(log))
+			return nil
+		})
+		if ctx.Err() != nil {
+			fmt.Println("[info] replay was stopped before the recording ended")
+			return
+		}
+		if err != nil && err != io.EOF {
+			fmt.Printf("[error] %v\n", err)
+		}
+
+		// Complete the replay and print the number of logs that the ORCH server replayed.
+		// A failed send is reported with the status of the stream.
+		response, err := stream.CloseAndRecv()
+		if err != nil {
+			fmt.Println("[failure] replay did not complete")
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+		if response.GetLive() {
+			fmt.Printf("[success] %v logs replayed\n", response.GetReplayed())
+			return
+		}
+
+		// Print the count of the logs by their type for a dry-run
+		fmt.Printf("[success] %v logs replayed as a dry-run, the state of the orchestrator was not changed\n", response.GetReplayed())
+		logtypes := make([]string, 0, len(response.GetLogtypes()))
+		for logtype := range response.GetLogtypes() {
+			logtypes = append(logtypes, logtype)
+		}
+		sort.Strings(logtypes)
+		for _, logtype := range logtypes {
+			fmt.Printf("[info] %v - %v logs\n", logtype, response.GetLogtypes()[logtype])
+		}
+	},
+}
+
+func init() {
+	// Add the command 'replay' to root CLI command.
+	rootCmd.AddCommand(replayCmd)
+
+	// Define the flags of the 'replay' command
+	replayCmd.Flags().Float64P("speed", "s", 1, "factor by which the original pace is accelerated (0 for no delay)")
+	replayCmd.Flags().BoolP("step", "t", false, "replay one log at a time")
+	replayCmd.Flags().BoolP("live", "l", false, "feed the logs into the live orchestrator instead of a dry-run")
+}
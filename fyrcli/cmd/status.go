@@ -51,17 +51,16 @@ is currently set as being connected to the controller (meshconnected).`,
 		defer conn.Close()
 		if err != nil {
 			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
 		}
 
 		// Call the Status method.
 		meshstatus, err := orch.Call_ORCH_Status(*client)
 		if err != nil {
-			fmt.Printf("[error] call to read mesh status failed -%v", err)
+			fmt.Printf("[error] call to read mesh status failed - %v\n", err)
+			return
 		}
 
-		// Retrieve the map of nodes
-		nodelist := meshstatus.GetNodelist().GetNodes()
-
 		// Print the mesh status values.
 		fmt.Printf("mesh connection state: %v\n", meshstatus.GetConnected())
 		fmt.Println()
@@ -70,15 +69,54 @@ is currently set as being connected to the controller (meshconnected).`,
 		fmt.Println()
 		fmt.Printf("mesh SSID: %v\n", meshstatus.GetMeshSSID())
 		fmt.Printf("mesh PORT: %v\n", meshstatus.GetMeshPORT())
-		fmt.Printf("mesh password: %v\n", meshstatus.GetMeshPSWD())
+		if meshstatus.GetMeshPSWD() != "" {
+			fmt.Printf("mesh password: %v\n", meshstatus.GetMeshPSWD())
+		} else {
+			fmt.Println("mesh password: [redacted] (requires an authenticated admin)")
+		}
+		fmt.Println()
+
+		// Print the health of the components of the orchestrator.
+		if meshstatus.GetDegraded() {
+			fmt.Println("orchestrator state: degraded")
+		} else {
+			fmt.Println("orchestrator state: healthy")
+		}
+		for _, component := range meshstatus.GetComponents() {
+			health := "ok"
+			if !component.GetHealthy() {
+				health = "missing"
+			}
+			fmt.Printf("- %v: %v | %v (since %v)\n", component.GetComponent(), health, component.GetReason(), component.GetUpdated())
+		}
+		if meshstatus.GetCloudPending() > 0 {
+			fmt.Printf("cloud documents buffered: %v\n", meshstatus.GetCloudPending())
+		}
 		fmt.Println()
-		fmt.Println("mesh nodelist:")
 
-		index := 1
-		for nodeid, nodeconfig := range nodelist {
-			fmt.Printf("%v] %v\t%v\n", index, nodeid, nodeconfig)
-			index++
+		// Print the status of the LINK read stream.
+		link := meshstatus.GetLink()
+		fmt.Printf("link state: %v (since %v)\n", link.GetState(), link.GetSince())
+		fmt.Printf("link reconnects: %v\n", link.GetReconnects())
+		if link.GetOutageStart() != "" {
+			fmt.Printf("link outage: since %v | attempts - %v | next retry - %v\n", link.GetOutageStart(), link.GetAttempts(), link.GetNextRetry())
+			fmt.Printf("link error: %v\n", link.GetLastError())
+		}
+		if link.GetLastOutageStart() != "" {
+			fmt.Printf("link last outage: %v to %v\n", link.GetLastOutageStart(), link.GetLastOutageEnd())
 		}
+		fmt.Println()
+
+		// Print the depths and drop counts of the queues of the orchestrator.
+		fmt.Println("orchestrator queues:")
+		for _, queue := range meshstatus.GetQueues() {
+			fmt.Printf("- %v: %v/%v (peak %v) | policy - %v | enqueued - %v | dropped - %v | rejected - %v | deferred - %v\n",
+				queue.GetName(), queue.GetDepth(), queue.GetCapacity(), queue.GetHighWater(), queue.GetPolicy(),
+				queue.GetEnqueued(), queue.GetDropped(), queue.GetRejected(), queue.GetDeferred())
+		}
+		fmt.Println()
+		fmt.Println("mesh nodelist:")
+		printNodelist(meshstatus.GetNodelist())
 	},
 }
 
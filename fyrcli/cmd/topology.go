@@ -0,0 +1,185 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrCLI
+===========================================================================
+*/
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
+)
+
+// topologyCmd represents the topology command
+var topologyCmd = &cobra.Command{
+	Use:   "topology",
+	Short: "Displays the topology of the mesh.",
+	Long: `Displays the topology of the mesh as a tree rooted at the control node, 
+in which every node is listed below the node that relays for it.
+
+The topology is derived from the nodelist and the handshakes of the mesh with every node connected 
+directly to the control node, which is shown as the 'nodelist' source. If the firmware of the control 
+node reports the topology, it is read from the control node whenever the connections of the mesh 
+change instead, which is shown as the 'mesh' source.
+
+The 'format(f)' flag sets the output format and accepts the following values.
+- 'tree': prints the topology as a tree (default)
+- 'dot': prints the topology as a Graphviz DOT graph, such as for 'fyrcli topology -f dot | dot -Tpng > mesh.png'
+- 'json': prints the topology and its history as a JSON object
+
+The 'history(H)' flag sets the number of the latest changes of the topology to print. 
+The JSON object includes every change that is kept by the orchestrator if it is not set.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retrieve the flag values
+		format, _ := cmd.Flags().GetString("format")
+		history, _ := cmd.Flags().GetInt("history")
+
+		// Check the flag values
+		switch format {
+		case "tree", "dot", "json":
+		default:
+			fmt.Printf("[error] invalid format - %v. must be one of 'tree', 'dot' or 'json'\n", format)
+			return
+		}
+		if history < 0 {
+			fmt.Printf("[error] invalid history - %v. must not be negative\n", history)
+			return
+		}
+
+		// Connect to the ORCH gRPC server.
+		client, conn, err := orch.GRPCconnect_ORCH()
+		defer conn.Close()
+		if err != nil {
+			fmt.Printf("[error] connection to ORCH gRPC server could not be established - %v\n", err)
+			return
+		}
+
+		// Call the Topology method.
+		topology, err := orch.Call_ORCH_Topology(*client, int64(history))
+		if err != nil {
+			fmt.Printf("[error] %v\n", err)
+			return
+		}
+
+		// Print the topology in the requested format.
+		switch format {
+		case "json":
+			jsontopology, _ := protojson.Marshal(topology)
+			fmt.Println(string(jsontopology))
+
+		case "dot":
+			fmt.Print(topologyDOT(topology))
+
+		case "tree":
+			if topology.GetSource() == "" {
+				fmt.Println("[info] the topology of the mesh has not been read yet.")
+				return
+			}
+
+			fmt.Printf("mesh topology: source - %v | updated - %v\n", topology.GetSource(), topology.GetUpdated())
+			fmt.Print(topologyTree(topology))
+
+			if history > 0 {
+				fmt.Println()
+				fmt.Println("topology changes:")
+				for _, change := range topology.GetHistory() {
+					moved := make([]string, 0, len(change.GetMoved()))
+					for _, link := range change.GetMoved() {
+						moved = append(moved, fmt.Sprintf("%v->%v", link.GetNode(), link.GetParent()))
+					}
+					fmt.Printf("%v (%v) | joined - %v | left - %v | moved - %v\n", change.GetTime(), change.GetSource(), change.GetJoined(), change.GetLeft(), moved)
+				}
+			}
+		}
+	},
+}
+
+// A function that returns the nodes of a MeshTopology that are connected through each node, sorted by their ID.
+func topologyChildren(topology *pb.MeshTopology) map[int64][]int64 {
+	children := make(map[int64][]int64)
+	for _, link := range topology.GetLinks() {
+		children[link.GetParent()] = append(children[link.GetParent()], link.GetNode())
+	}
+	for _, nodes := range children {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	}
+	return children
+}
+
+// A function that returns a MeshTopology as a tree of nodes
+// drawn below the control node at the root of the mesh.
+func topologyTree(topology *pb.MeshTopology) string {
+	children := topologyChildren(topology)
+
+	// Draw the root and then every node below its parent with the prefix of its branch
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%v (control node)\n", topology.GetRoot()))
+	var draw func(parent int64, prefix string)
+	draw = func(parent int64, prefix string) {
+		for index, node := range children[parent] {
+			branch, indent := "├── ", "│   "
+			if index == len(children[parent])-1 {
+				branch, indent = "└── ", "    "
+			}
+			builder.WriteString(fmt.Sprintf("%v%v%v\n", prefix, branch, node))
+			draw(node, prefix+indent)
+		}
+	}
+	draw(topology.GetRoot(), "")
+	return builder.String()
+}
+
+// A function that returns a MeshTopology as a Graphviz DOT graph with
+// an edge from every node to each of the nodes that it relays for.
+func topologyDOT(topology *pb.MeshTopology) string {
+	var builder strings.Builder
+	builder.WriteString("digraph fyrmesh {\n")
+	builder.WriteString(fmt.Sprintf("\t\"%v\" [shape=box, label=\"%v\\ncontrol node\"];\n", topology.GetRoot(), topology.GetRoot()))
+	for _, link := range topology.GetLinks() {
+		builder.WriteString(fmt.Sprintf("\t\"%v\" -> \"%v\";\n", link.GetParent(), link.GetNode()))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func init() {
+	// Add the command 'topology' to root CLI command.
+	rootCmd.AddCommand(topologyCmd)
+
+	// Define the flags of the 'topology' command
+	topologyCmd.Flags().StringP("format", "f", "tree", "output format of the topology (tree, dot or json)")
+	topologyCmd.Flags().IntP("history", "H", 0, "number of the latest topology changes to print")
+	topologyCmd.RegisterFlagCompletionFunc("format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"tree", "dot", "json"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
@@ -28,18 +28,58 @@ FyrMesh FyrCLI
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/fyrwatch/fyrmesh/fyrcli/cmd"
+	tools "github.com/fyrwatch/fyrmesh/tools"
 )
 
 func main() {
+	// Shell completion output is read by the shell and must not be colored
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "completion", "__complete", "__completeNoDesc":
+			cmd.Execute()
+			return
+		}
+	}
+
 	// Colors are defined with ANSI escape commands https://en.wikipedia.org/wiki/ANSI_escape_code#8-bit
 	// Change terminal color to orange
 	fmt.Println("\033[38;5;208m")
 
+	// Start the tracing of the calls to the ORCH server if it is configured
+	stoptracing := startTracing()
+
 	cmd.Execute()
 
+	// Flush the spans of the calls before exiting
+	stoptracing()
+
 	// Change terminal color to original
 	fmt.Println("\033[0m")
 }
+
+// A function that starts the tracing of the FyrCLI from the config file and returns a function that
+// flushes the remaining spans. The CLI runs without tracing if the config or the tracing is unavailable.
+func startTracing() func() {
+	config, err := tools.ReadConfig()
+	if err != nil {
+		return func() {}
+	}
+
+	stoptracing, err := tools.StartTracing(config.Tracing, "fyrcli")
+	if err != nil {
+		fmt.Printf("[error] tracing could not be started - %v\n", err)
+		return func() {}
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		stoptracing(ctx)
+	}
+}
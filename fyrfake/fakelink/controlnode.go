@@ -0,0 +1,473 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrFAKE gopkg fakelink
+===========================================================================
+*/
+package fakelink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// The number of commands that can wait to be written to the control node.
+const CommandQueueSize = 64
+
+// The number of logs that can wait to be read from the control node. Older logs are dropped once it is full.
+const LogQueueSize = 1024
+
+// An error returned when a command is written to a control node whose command queue is full.
+var ErrCommandQueueFull = errors.New("command queue of the control node is full")
+
+// An error returned when a command is written to a control node that has been stopped.
+var ErrStopped = errors.New("control node has been stopped")
+
+// A struct that defines a virtual control node that emulates the firmware of the control node and
+// the mesh of sensor nodes connected to it. Commands written to it are answered after the latency
+// of the mesh with the same logs that the LINK server parses from the serial port of a real one.
+type ControlNode struct {
+	mutex sync.Mutex
+
+	// The configuration of the mesh
+	config MeshConfig
+	// The virtual sensor nodes on the mesh in the order they joined
+	nodes []*virtualNode
+	// A bool indicating whether the mesh connection of the control node is on
+	connected bool
+	// The random source of the sensor readings
+	random *rand.Rand
+
+	// The queues of the commands written to and the logs read from the control node
+	commands chan *pb.ControlCommand
+	logs     chan *pb.ComplexLog
+
+	// The context that stops the control node
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// A constructor function that generates and returns a ControlNode for a MeshConfig.
+// The mesh connection of the control node is on and every sensor node has joined it.
+// The control node does not respond to commands until it is started.
+func NewControlNode(config MeshConfig) *ControlNode {
+	controlnode := ControlNode{
+		config:    config,
+		connected: true,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		commands:  make(chan *pb.ControlCommand, CommandQueueSize),
+		logs:      make(chan *pb.ComplexLog, LogQueueSize),
+	}
+	controlnode.ctx, controlnode.cancel = context.WithCancel(context.Background())
+
+	// Create the virtual sensor nodes
+	for _, nodeconfig := range config.Nodes {
+		controlnode.nodes = append(controlnode.nodes, &virtualNode{config: nodeconfig, overrides: make(map[string]float64)})
+	}
+
+	return &controlnode
+}
+
+// A method of ControlNode that starts the go-routine which answers the commands written to it.
+// The commands are answered in order, each after the latency of the mesh.
+func (controlnode *ControlNode) Start() {
+	go func() {
+		latency := time.Millisecond * time.Duration(controlnode.config.Latency)
+		for {
+			select {
+			case <-controlnode.ctx.Done():
+				return
+			case command := <-controlnode.commands:
+				// Wait for the latency of the mesh before answering
+				select {
+				case <-controlnode.ctx.Done():
+					return
+				case <-time.After(latency):
+				}
+				controlnode.handle(command)
+			}
+		}
+	}()
+}
+
+// A method of ControlNode that stops the control node.
+// Pending commands are dropped and open read streams are closed.
+func (controlnode *ControlNode) Stop() {
+	controlnode.cancel()
+}
+
+// A method of ControlNode that returns a channel which is closed once the control node is stopped.
+func (controlnode *ControlNode) Done() <-chan struct{} {
+	return controlnode.ctx.Done()
+}
+
+// A method of ControlNode that writes a command to the control node.
+// Returns an error if the control node is stopped or its command queue is full.
+func (controlnode *ControlNode) Write(command *pb.ControlCommand) error {
+	select {
+	case <-controlnode.ctx.Done():
+		return ErrStopped
+	default:
+	}
+
+	select {
+	case controlnode.commands <- command:
+		return nil
+	default:
+		return ErrCommandQueueFull
+	}
+}
+
+// A method of ControlNode that returns the channel of the logs read from the control node.
+func (controlnode *ControlNode) Logs() <-chan *pb.ComplexLog {
+	return controlnode.logs
+}
+
+// A method of ControlNode that returns the IDs of the sensor nodes on the mesh in the order they joined.
+func (controlnode *ControlNode) NodeIDs() []int64 {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	nodeids := make([]int64, 0, len(controlnode.nodes))
+	for _, node := range controlnode.nodes {
+		nodeids = append(nodeids, node.config.NodeID)
+	}
+	return nodeids
+}
+
+// A method of ControlNode that adds a sensor node to the mesh. The node handshakes
+// with the control node and the mesh synchronizes if the mesh connection is on.
+// Returns an error if a node with the same ID is already on the mesh.
+func (controlnode *ControlNode) AddNode(nodeconfig NodeConfig) error {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	if controlnode.find(nodeconfig.NodeID) != nil || nodeconfig.NodeID == controlnode.config.ControlNodeID {
+		return fmt.Errorf("node '%v' is already on the mesh", nodeconfig.NodeID)
+	}
+	controlnode.nodes = append(controlnode.nodes, &virtualNode{config: nodeconfig, overrides: make(map[string]float64)})
+
+	// Handshake the node and synchronize the mesh
+	if controlnode.connected {
+		controlnode.emit(newHandshakelog(nodeconfig.NodeID))
+		controlnode.emit(newMeshsynclog())
+	}
+	return nil
+}
+
+// A method of ControlNode that removes a sensor node from the mesh. The mesh
+// synchronizes if the mesh connection is on. Returns an error if the node is not on the mesh.
+func (controlnode *ControlNode) RemoveNode(nodeid int64) error {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	for index, node := range controlnode.nodes {
+		if node.config.NodeID == nodeid {
+			controlnode.nodes = append(controlnode.nodes[:index], controlnode.nodes[index+1:]...)
+			if controlnode.connected {
+				controlnode.emit(newMeshsynclog())
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("node '%v' is not on the mesh", nodeid)
+}
+
+// A method of ControlNode that overrides the reading of a sensor type, such as 'TEM' or 'FLM', of a node.
+// The node reports the value in its sensor data until the override is cleared with ClearReadings.
+// Returns an error if the node is not on the mesh.
+func (controlnode *ControlNode) SetReading(nodeid int64, sensortype string, value float64) error {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	node := controlnode.find(nodeid)
+	if node == nil {
+		return fmt.Errorf("node '%v' is not on the mesh", nodeid)
+	}
+	node.overrides[sensortype] = value
+	return nil
+}
+
+// A method of ControlNode that clears the overridden readings of a node.
+// Returns an error if the node is not on the mesh.
+func (controlnode *ControlNode) ClearReadings(nodeid int64) error {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	node := controlnode.find(nodeid)
+	if node == nil {
+		return fmt.Errorf("node '%v' is not on the mesh", nodeid)
+	}
+	node.overrides = make(map[string]float64)
+	return nil
+}
+
+// A method of ControlNode that answers a command the way the firmware of the control node does.
+// Commands for the sensor nodes are only answered while the mesh connection is on.
+func (controlnode *ControlNode) handle(command *pb.ControlCommand) {
+	controlnode.mutex.Lock()
+	defer controlnode.mutex.Unlock()
+
+	metadata := command.GetMetadata()
+	switch command.GetCommand() {
+	case "readsensors-mesh":
+		for _, node := range controlnode.reachable("") {
+			controlnode.emit(newSensordatalog(metadata["ping"], node, controlnode.random))
+		}
+
+	case "readsensors-node":
+		for _, node := range controlnode.reachable(metadata["node"]) {
+			controlnode.emit(newSensordatalog(metadata["ping"], node, controlnode.random))
+		}
+
+	case "readconfig-mesh":
+		for _, node := range controlnode.reachable("") {
+			controlnode.emit(newConfigdatalog(metadata["ping"], node))
+		}
+
+	case "readconfig-node":
+		for _, node := range controlnode.reachable(metadata["node"]) {
+			controlnode.emit(newConfigdatalog(metadata["ping"], node))
+		}
+
+	case "readconfig-control":
+		controlnode.emit(newCtrldatalog(controlnode.config))
+
+	case "readnodelist-control":
+		nodeids := make([]string, 0, len(controlnode.nodes))
+		for _, node := range controlnode.reachable("") {
+			nodeids = append(nodeids, fmt.Sprint(node.config.NodeID))
+		}
+		controlnode.emit(newNodelistlog(nodeids))
+
+	case "readtopology-control":
+		// Firmware without the topology capability does not recognize the command
+		if controlnode.config.NoTopology {
+			controlnode.emit(newMessagelog(fmt.Sprintf("unknown control command '%v'", command.GetCommand()), "controlcommand"))
+			break
+		}
+		controlnode.emit(newTopologylog(controlnode.topology()))
+
+	case "connection-on":
+		// Handshake every node and synchronize the mesh if the connection was off
+		if !controlnode.connected {
+			controlnode.connected = true
+			for _, node := range controlnode.nodes {
+				controlnode.emit(newHandshakelog(node.config.NodeID))
+			}
+			controlnode.emit(newMeshsynclog())
+		}
+
+	case "connection-off":
+		// Synchronize the mesh without any nodes if the connection was on
+		if controlnode.connected {
+			controlnode.connected = false
+			controlnode.emit(newMeshsynclog())
+		}
+
+	default:
+		// The firmware echoes the commands that it does not recognize
+		controlnode.emit(newMessagelog(fmt.Sprintf("unknown control command '%v'", command.GetCommand()), "controlcommand"))
+	}
+}
+
+// A method of ControlNode that returns the sensor nodes that can be reached over the mesh.
+// Returns the node with the given ID, or every node if the ID is empty. Requires the lock to be held.
+func (controlnode *ControlNode) reachable(nodeid string) []*virtualNode {
+	if !controlnode.connected {
+		return nil
+	}
+	if nodeid == "" {
+		return controlnode.nodes
+	}
+
+	for _, node := range controlnode.nodes {
+		if fmt.Sprint(node.config.NodeID) == nodeid {
+			return []*virtualNode{node}
+		}
+	}
+	return nil
+}
+
+// A struct that defines the shape of a node in the topology JSON of painlessMesh,
+// with the nodes that are connected through it as its subs.
+type topologyNode struct {
+	NodeID int64          `json:"nodeId"`
+	Root   bool           `json:"root,omitempty"`
+	Subs   []topologyNode `json:"subs"`
+}
+
+// A method of ControlNode that returns the topology of the mesh as a tree rooted at the control node. Nodes
+// whose parent is not on the mesh are connected directly to the control node. Requires the lock to be held.
+func (controlnode *ControlNode) topology() topologyNode {
+	// Collect the nodes connected through each node
+	subs := make(map[int64][]int64)
+	for _, node := range controlnode.reachable("") {
+		parent := node.config.Parent
+		if controlnode.find(parent) == nil {
+			parent = controlnode.config.ControlNodeID
+		}
+		subs[parent] = append(subs[parent], node.config.NodeID)
+	}
+
+	// Build the tree from the control node
+	var build func(nodeid int64) topologyNode
+	build = func(nodeid int64) topologyNode {
+		tree := topologyNode{NodeID: nodeid, Subs: []topologyNode{}}
+		for _, sub := range subs[nodeid] {
+			tree.Subs = append(tree.Subs, build(sub))
+		}
+		return tree
+	}
+	tree := build(controlnode.config.ControlNodeID)
+	tree.Root = true
+	return tree
+}
+
+// A method of ControlNode that returns the sensor node with the given ID or nil. Requires the lock to be held.
+func (controlnode *ControlNode) find(nodeid int64) *virtualNode {
+	for _, node := range controlnode.nodes {
+		if node.config.NodeID == nodeid {
+			return node
+		}
+	}
+	return nil
+}
+
+// A method of ControlNode that adds a log to the log queue. The oldest
+// log is dropped if the queue is full, since nothing is reading it.
+func (controlnode *ControlNode) emit(log *pb.ComplexLog) {
+	for {
+		select {
+		case controlnode.logs <- log:
+			return
+		default:
+		}
+
+		select {
+		case <-controlnode.logs:
+		default:
+		}
+	}
+}
+
+// A constructor function that generates and returns a ComplexLog from the
+// LINK server or the mesh, in the shape that the LINK server sends them.
+func newComplexLog(source string, logtype string, message string, metadata map[string]string) *pb.ComplexLog {
+	return &pb.ComplexLog{
+		Logsource:   source,
+		Logtype:     logtype,
+		Logtime:     tools.CurrentISOtime(),
+		Logmessage:  message,
+		Logmetadata: metadata,
+	}
+}
+
+// A constructor function that generates and returns a 'sensordata' log of a sensor node.
+func newSensordatalog(ping string, node *virtualNode, random *rand.Rand) *pb.ComplexLog {
+	return newComplexLog("MESH", "sensordata", "sensordata acquired", map[string]string{
+		"ping":    ping,
+		"node":    fmt.Sprint(node.config.NodeID),
+		"sensors": deepserialize(node.readings(random), []string{"HUM", "TEM", "FLM", "GAS"}),
+	})
+}
+
+// A constructor function that generates and returns a 'configdata' log of a sensor node.
+func newConfigdatalog(ping string, node *virtualNode) *pb.ComplexLog {
+	keys := []string{"NODEID", "SERIALBAUD", "PINGER", "PINGERPIN", "CONNECTLEDPIN", "DHTTYP", "DHTPIN", "FLMTYP", "FLMPIN", "GASTYP", "GASPIN"}
+	return newComplexLog("MESH", "configdata", "configdata acquired", map[string]string{
+		"ping":   ping,
+		"node":   fmt.Sprint(node.config.NodeID),
+		"config": deepserialize(node.configmap(), keys),
+	})
+}
+
+// A constructor function that generates and returns a 'ctrldata' log of the control node.
+// The firmware advertises that it reports the topology of the mesh with the TOPOLOGY key.
+func newCtrldatalog(config MeshConfig) *pb.ComplexLog {
+	keys := []string{"NODEID", "SERIALBAUD", "PINGER", "PINGERPIN", "CONNECTLEDPIN", "MESH_SSID", "MESH_PSWD", "MESH_PORT", "TOPOLOGY"}
+	configmap := map[string]string{
+		"NODEID":        fmt.Sprint(config.ControlNodeID),
+		"SERIALBAUD":    "115200",
+		"PINGER":        "false",
+		"PINGERPIN":     "0",
+		"CONNECTLEDPIN": "2",
+		"MESH_SSID":     config.MeshSSID,
+		"MESH_PSWD":     config.MeshPSWD,
+		"MESH_PORT":     fmt.Sprint(config.MeshPORT),
+	}
+	if !config.NoTopology {
+		configmap["TOPOLOGY"] = "true"
+	}
+	return newComplexLog("MESH", "ctrldata", "controlnode config acquired", map[string]string{
+		"nodeID": fmt.Sprint(config.ControlNodeID),
+		"config": deepserialize(configmap, keys),
+	})
+}
+
+// A constructor function that generates and returns a 'nodelist' log of the control node.
+func newNodelistlog(nodeids []string) *pb.ComplexLog {
+	return newComplexLog("MESH", "nodelist", "mesh nodelist acquired", map[string]string{
+		"nodelist": strings.Join(nodeids, "-"),
+	})
+}
+
+// A constructor function that generates and returns a 'topology' log of the control node.
+func newTopologylog(tree topologyNode) *pb.ComplexLog {
+	data, _ := json.Marshal(tree)
+	return newComplexLog("MESH", "topology", "mesh topology acquired", map[string]string{
+		"topology": string(data),
+	})
+}
+
+// A constructor function that generates and returns a 'handshake' log of a sensor node.
+func newHandshakelog(nodeid int64) *pb.ComplexLog {
+	return newComplexLog("MESH", "handshake", "node handshaked", map[string]string{
+		"node": fmt.Sprint(nodeid),
+	})
+}
+
+// A constructor function that generates and returns a 'meshsync' log for a change in the connections of the mesh.
+func newMeshsynclog() *pb.ComplexLog {
+	return newComplexLog("MESH", "meshsync", "mesh synchronization event", map[string]string{
+		"synctype": "changed-connections",
+	})
+}
+
+// A constructor function that generates and returns a 'message' log received from the mesh.
+func newMessagelog(message string, messagetype string) *pb.ComplexLog {
+	return newComplexLog("MESH", "message", message, map[string]string{
+		"format": "str",
+		"type":   messagetype,
+	})
+}
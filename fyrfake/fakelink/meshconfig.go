@@ -0,0 +1,258 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrFAKE gopkg fakelink
+===========================================================================
+*/
+package fakelink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+)
+
+// The sensors that can be attached to a virtual sensor node.
+// A DHT sensor reports both the humidity (HUM) and the temperature (TEM).
+var Sensors = []string{"DHT", "FLM", "GAS"}
+
+// The default node ID of the virtual control node.
+const DefaultControlNodeID = 2000000000
+
+// The default latency in milliseconds between a command being written and the mesh responding to it.
+const DefaultLatency = 50
+
+// A struct that defines the configuration of a virtual sensor node.
+type NodeConfig struct {
+	// The identifier of the node
+	NodeID int64 `json:"nodeID"`
+	// The sensors attached to the node, any of 'DHT', 'FLM' and 'GAS'
+	Sensors []string `json:"sensors"`
+	// A bool indicating if the node has a pinger button
+	Pinger bool `json:"pinger"`
+	// The identifier of the sensor node that relays for the node. The node
+	// is connected directly to the control node if it is not set.
+	Parent int64 `json:"parent,omitempty"`
+}
+
+// A struct that defines the configuration of the virtual mesh
+// that is emulated by the control node of the fake LINK server.
+type MeshConfig struct {
+	// The identifier of the control node
+	ControlNodeID int64 `json:"controlnode"`
+	// The SSID, password and port of the mesh network
+	MeshSSID string `json:"meshssid"`
+	MeshPSWD string `json:"meshpswd"`
+	MeshPORT int    `json:"meshport"`
+	// The latency in milliseconds between a command being written and the mesh responding to it
+	Latency int `json:"latency"`
+	// Whether the control node runs firmware that does not report the topology of the mesh
+	NoTopology bool `json:"notopology"`
+	// The virtual sensor nodes on the mesh
+	Nodes []NodeConfig `json:"nodes"`
+}
+
+// A constructor function that generates and returns a MeshConfig with the given number
+// of virtual sensor nodes. Every node has all the sensors and a pinger button attached.
+func NewMeshConfig(nodes int) MeshConfig {
+	// Create a MeshConfig with the default control node values
+	config := MeshConfig{
+		ControlNodeID: DefaultControlNodeID,
+		MeshSSID:      "fyrmesh",
+		MeshPSWD:      "fyrmesh",
+		MeshPORT:      5555,
+		Latency:       DefaultLatency,
+	}
+
+	// Add the sensor nodes with the IDs following the control node
+	for index := 1; index <= nodes; index++ {
+		config.Nodes = append(config.Nodes, NodeConfig{NodeID: DefaultControlNodeID + int64(index), Sensors: Sensors, Pinger: true})
+	}
+
+	return config
+}
+
+// A function that reads a MeshConfig from a JSON file at the given path.
+// Returns an error if the file cannot be read or a node is malformed.
+func ReadMeshConfig(filepath string) (MeshConfig, error) {
+	// Start from an empty mesh so the defaults of the control node apply
+	config := NewMeshConfig(0)
+
+	// Read and decode the file
+	data, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return config, fmt.Errorf("could not read mesh config file - %v", err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("could not decode mesh config file - %v", err)
+	}
+
+	// Check the nodes for duplicate IDs and unknown sensors
+	nodeids := map[int64]bool{config.ControlNodeID: true}
+	for _, node := range config.Nodes {
+		if nodeids[node.NodeID] {
+			return config, fmt.Errorf("node ID '%v' is used more than once", node.NodeID)
+		}
+		nodeids[node.NodeID] = true
+
+		for _, sensor := range node.Sensors {
+			if !hasSensor(Sensors, sensor) {
+				return config, fmt.Errorf("node '%v' has an unknown sensor '%v'", node.NodeID, sensor)
+			}
+		}
+	}
+
+	// Check the parents of the nodes for unknown nodes and cycles
+	parents := make(map[int64]int64, len(config.Nodes))
+	for _, node := range config.Nodes {
+		parents[node.NodeID] = node.Parent
+	}
+	for _, node := range config.Nodes {
+		if node.Parent == 0 {
+			continue
+		}
+		if _, ok := parents[node.Parent]; !ok {
+			return config, fmt.Errorf("node '%v' has an unknown parent '%v'", node.NodeID, node.Parent)
+		}
+		// Follow the parents up to the control node, which must be reached within the number of nodes
+		parent := node.Parent
+		for hops := 0; parent != 0; hops++ {
+			if hops == len(config.Nodes) {
+				return config, fmt.Errorf("node '%v' is part of a cycle of parents", node.NodeID)
+			}
+			parent = parents[parent]
+		}
+	}
+
+	return config, nil
+}
+
+// A struct that defines the baseline of the readings of a sensor and how far they wander from it.
+type baseline struct {
+	value     float64
+	variation float64
+	precision float64
+}
+
+// The baselines of the readings of the sensors, matching the seeds of the orchestrator's simulator.
+var baselines = map[string]baseline{
+	"HUM": {value: 50, variation: 3, precision: 100},
+	"TEM": {value: 27, variation: 3, precision: 100},
+	"GAS": {value: 450, variation: 75, precision: 1},
+}
+
+// A struct that defines a virtual sensor node and its latest sensor readings.
+type virtualNode struct {
+	config NodeConfig
+	// The readings that are overridden and reported instead of generated ones
+	overrides map[string]float64
+}
+
+// A method of virtualNode that returns its hardware config as a map, in the
+// shape that the firmware reports it. The pins follow those of the real nodes.
+func (node *virtualNode) configmap() map[string]string {
+	// Set the type of each sensor to 1 if it is attached
+	sensortype := func(sensor string) string {
+		if hasSensor(node.config.Sensors, sensor) {
+			return "1"
+		}
+		return "0"
+	}
+
+	return map[string]string{
+		"NODEID":        fmt.Sprint(node.config.NodeID),
+		"SERIALBAUD":    "115200",
+		"PINGER":        fmt.Sprint(node.config.Pinger),
+		"PINGERPIN":     "4",
+		"CONNECTLEDPIN": "2",
+		"DHTTYP":        sensortype("DHT"),
+		"DHTPIN":        "5",
+		"FLMTYP":        sensortype("FLM"),
+		"FLMPIN":        "18",
+		"GASTYP":        sensortype("GAS"),
+		"GASPIN":        "34",
+	}
+}
+
+// A method of virtualNode that generates and returns its sensor readings as a map.
+// The readings wander around their baseline unless they have been overridden.
+func (node *virtualNode) readings(random *rand.Rand) map[string]string {
+	// Collect the sensor types reported by the attached sensors
+	var sensortypes []string
+	if hasSensor(node.config.Sensors, "DHT") {
+		sensortypes = append(sensortypes, "HUM", "TEM")
+	}
+	if hasSensor(node.config.Sensors, "FLM") {
+		sensortypes = append(sensortypes, "FLM")
+	}
+	if hasSensor(node.config.Sensors, "GAS") {
+		sensortypes = append(sensortypes, "GAS")
+	}
+
+	readings := make(map[string]string)
+	for _, sensortype := range sensortypes {
+		// Report the overridden reading if it is set
+		if value, ok := node.overrides[sensortype]; ok {
+			readings[sensortype] = fmt.Sprint(value)
+			continue
+		}
+
+		// The flame sensor only reports a flame when it is overridden
+		if sensortype == "FLM" {
+			readings[sensortype] = "0"
+			continue
+		}
+
+		// Generate a reading around the baseline
+		base := baselines[sensortype]
+		value := base.value + (random.Float64()*2-1)*base.variation
+		readings[sensortype] = fmt.Sprint(float64(int64(value*base.precision)) / base.precision)
+	}
+
+	return readings
+}
+
+// A function that serializes a map into a string with a format akin to 'key1-value1=key2-value2..'
+// The keys are serialized in the given order, as the firmware reports them.
+func deepserialize(data map[string]string, keys []string) string {
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := data[key]; ok {
+			pairs = append(pairs, fmt.Sprintf("%v-%v", key, value))
+		}
+	}
+	return strings.Join(pairs, "=")
+}
+
+// A function that returns whether a slice of sensors contains a sensor.
+func hasSensor(sensors []string, sensor string) bool {
+	for _, s := range sensors {
+		if s == sensor {
+			return true
+		}
+	}
+	return false
+}
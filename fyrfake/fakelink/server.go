@@ -0,0 +1,142 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrFAKE gopkg fakelink
+===========================================================================
+*/
+package fakelink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+)
+
+// The trigger message that starts the read stream of the LINK server.
+const ReadTrigger = "start-stream-read"
+
+// A struct that implements the Interface gRPC service of the LINK server over a virtual ControlNode.
+// Like the LINK server, the logs of the control node are delivered to one read stream at a time.
+type Server struct {
+	pb.UnimplementedInterfaceServer
+
+	// The virtual control node that the commands are written to
+	controlnode *ControlNode
+	// A channel that holds a token while a read stream is delivering logs
+	reader chan struct{}
+}
+
+// A constructor function that generates and returns a Server for a ControlNode.
+func NewServer(controlnode *ControlNode) *Server {
+	return &Server{controlnode: controlnode, reader: make(chan struct{}, 1)}
+}
+
+// A function that implements the 'Read' method of the Interface service. Streams the logs of the
+// control node until the client cancels the stream or the control node is stopped. A stream with an
+// invalid trigger message receives an error log every 2 seconds, like it does from the LINK server.
+func (server *Server) Read(trigger *pb.Trigger, stream pb.Interface_ReadServer) error {
+	ctx := stream.Context()
+
+	// Send an error log periodically for an invalid trigger message
+	if trigger.GetTriggermessage() != ReadTrigger {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-server.controlnode.Done():
+				return nil
+			case <-time.After(time.Second * 2):
+			}
+
+			log := newComplexLog("LINK", "protolog", "(error) invalid read stream initiation code", map[string]string{
+				"server": "LINK", "service": "Read", "error": "nil",
+			})
+			if err := stream.Send(log); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Wait for any other read stream to stop delivering logs
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-server.controlnode.Done():
+		return nil
+	case server.reader <- struct{}{}:
+	}
+	defer func() { <-server.reader }()
+
+	// Stream the logs of the control node
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-server.controlnode.Done():
+			return nil
+		case log := <-server.controlnode.Logs():
+			if err := stream.Send(log); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// A function that implements the 'Write' method of the Interface service. Writes the command to the
+// control node and logs the result on the read stream. Returns a false acknowledge if it could not be written.
+func (server *Server) Write(ctx context.Context, command *pb.ControlCommand) (*pb.Acknowledge, error) {
+	// Write the command to the control node
+	if err := server.controlnode.Write(command); err != nil {
+		server.controlnode.emit(newComplexLog("LINK", "protolog", fmt.Sprintf("(failure) command '%v' failed to be written to control node.", command.GetCommand()), map[string]string{
+			"server": "LINK", "service": "Write", "error": err.Error(),
+		}))
+		return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
+	}
+
+	server.controlnode.emit(newComplexLog("LINK", "protolog", fmt.Sprintf("(success) command '%v' written to control node successfully", command.GetCommand()), map[string]string{
+		"server": "LINK", "service": "Write", "error": "nil",
+	}))
+	return &pb.Acknowledge{Success: true, Error: "nil"}, nil
+}
+
+// A function that starts a ControlNode and serves the fake LINK server for it on the listener in the
+// background. Intended for running the orchestrator against a virtual mesh in-process. The control
+// node should be stopped before the returned gRPC server, so that its open read streams are closed.
+func Serve(listener net.Listener, controlnode *ControlNode, options ...grpc.ServerOption) *grpc.Server {
+	// Start the control node
+	controlnode.Start()
+
+	// Create the gRPC server and register the Interface service
+	grpcserver := grpc.NewServer(options...)
+	pb.RegisterInterfaceServer(grpcserver, NewServer(controlnode))
+
+	// Serve in the background
+	go grpcserver.Serve(listener)
+	return grpcserver
+}
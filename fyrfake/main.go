@@ -0,0 +1,124 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrFAKE
+===========================================================================
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+
+	fakelink "github.com/fyrwatch/fyrmesh/fyrfake/fakelink"
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that generates and returns a LINK serverlog with the given message.
+func serverlog(message string) *pb.ComplexLog {
+	return &pb.ComplexLog{Logsource: "LINK", Logtype: "serverlog", Logtime: tools.CurrentISOtime(), Logmessage: message}
+}
+
+func main() {
+	// Define and parse the flags
+	nodes := flag.Int("nodes", 3, "number of virtual sensor nodes on the mesh")
+	meshfile := flag.String("mesh", "", "path to a JSON file that defines the virtual mesh (overrides -nodes)")
+	latency := flag.Int("latency", 0, "latency of the mesh in milliseconds (overrides the mesh file)")
+	port := flag.Int("port", 0, "port to serve on (defaults to the port of the LINK service in the config)")
+	notopology := flag.Bool("notopology", false, "emulate control node firmware that does not report the topology (overrides the mesh file)")
+	flag.Parse()
+
+	// Construct the config of the virtual mesh
+	meshconfig := fakelink.NewMeshConfig(*nodes)
+	if *meshfile != "" {
+		var err error
+		if meshconfig, err = fakelink.ReadMeshConfig(*meshfile); err != nil {
+			fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(error) mesh config could not be read | error - %v", err))))
+			os.Exit(1)
+		}
+	}
+	if *latency > 0 {
+		meshconfig.Latency = *latency
+	}
+	if *notopology {
+		meshconfig.NoTopology = true
+	}
+
+	// Read the config file for the port and the credentials of the LINK service. The config is
+	// only optional if the port is set, in which case the server is served without TLS.
+	var options []grpc.ServerOption
+	config, err := tools.ReadConfig()
+	if err != nil {
+		if *port == 0 {
+			fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(error) config file could not be read and no port was set | error - %v", err))))
+			os.Exit(1)
+		}
+	} else {
+		if *port == 0 {
+			*port = config.Services["LINK"].Port
+		}
+		if options, err = tools.NewServerCredentials(config, "LINK"); err != nil {
+			fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(error) could not load TLS credentials | error - %v", err))))
+			os.Exit(1)
+		}
+
+		// Trace the writes and read streams of the server, the server runs without tracing if it cannot be started
+		stoptracing, err := tools.StartTracing(config.Tracing, "fyrfake")
+		if err != nil {
+			fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(error) tracing could not be started | error - %v", err))))
+		} else {
+			defer stoptracing(context.Background())
+			options = append(options, tools.TraceServerOptions()...)
+		}
+	}
+
+	// Setup the listener on the port
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(error) could not set up listener on the port tcp:%v | error - %v", *port, err))))
+		os.Exit(1)
+	}
+
+	// Create the virtual control node and serve the fake LINK server for it
+	controlnode := fakelink.NewControlNode(meshconfig)
+	grpcserver := fakelink.Serve(listener, controlnode, options...)
+	fmt.Println(tools.FormatLog(serverlog(fmt.Sprintf("(startup) fake interface link grpc server started on port %v | controlnode - %v | nodes - %v",
+		*port, meshconfig.ControlNodeID, controlnode.NodeIDs()))))
+
+	// Wait for SIGINT or SIGTERM and stop the control node before the server to close the read streams
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+	controlnode.Stop()
+	grpcserver.GracefulStop()
+	fmt.Println(tools.FormatLog(serverlog("(shutdown) fake interface link grpc server has stopped")))
+}
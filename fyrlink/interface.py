@@ -37,6 +37,7 @@ import proto.fyrmesh_pb2 as fyrmesh_pb2
 import proto.fyrmesh_pb2_grpc as fyrmesh_pb2_grpc
 
 from fyrlink.parsers import logtime
+from fyrlink.tracing import starttracing, startspan, endspan, TRACEKEY
 from fyrlink.workers import commandqueue, logqueue, loglock
 from fyrlink.workers import reader, writer, logger, readfromqueue
 from fyrlink.workers import KillableThread
@@ -53,21 +54,28 @@ class Interface(fyrmesh_pb2_grpc.InterfaceServicer):
         to the gRPC Interface client. """
 
         if request.triggermessage == "start-stream-read":
-            with loglock:       
-                while True:
-                    message = readfromqueue(logqueue)
-
-                    if message:
-                        yield fyrmesh_pb2.ComplexLog(
-                            logsource=message['source'], 
-                            logtype=message['type'],
-                            logtime=message['time'], 
-                            logmessage=message['log'], 
-                            logmetadata=message['metadata']
-                        )
-
-                    else:
-                        pass
+            # Trace the stream until it is closed by the client
+            span = startspan("Read", context)
+            streamed = 0
+            try:
+                with loglock:       
+                    while True:
+                        message = readfromqueue(logqueue)
+
+                        if message:
+                            yield fyrmesh_pb2.ComplexLog(
+                                logsource=message['source'], 
+                                logtype=message['type'],
+                                logtime=message['time'], 
+                                logmessage=message['log'], 
+                                logmetadata=message['metadata']
+                            )
+                            streamed += 1
+
+                        else:
+                            pass
+            finally:
+                endspan(span, attributes={"fyrmesh.logs": streamed})
         else:
             while True:
                 time.sleep(2)
@@ -89,7 +97,11 @@ class Interface(fyrmesh_pb2_grpc.InterfaceServicer):
         appropriate structure. """
 
         command = request.command
-        metadata = request.metadata
+        metadata = dict(request.metadata)
+
+        # Trace the write as a child of the caller, the trace key is not written to the control node
+        span = startspan("Write", context, metadata, {"fyrmesh.command": command})
+        metadata.pop(TRACEKEY, None)
 
         try:
             commandqueue.put({"type": "controlcommand", "command": command, **metadata})
@@ -111,8 +123,10 @@ class Interface(fyrmesh_pb2_grpc.InterfaceServicer):
                     "service": "Write",
                     "error": str(e)
             }})
+            endspan(span, e)
             return fyrmesh_pb2.Acknowledge(success=False, error=str(e))
 
+        endspan(span)
         return fyrmesh_pb2.Acknowledge(success=True, error="nil")
 
 
@@ -140,9 +154,41 @@ def grpc_serve():
     with open(configfilepath) as configfile:
         configdata = json.load(configfile)
 
+    # Start the tracing of the server, the server runs without tracing if it cannot be started
+    try:
+        stoptracing = starttracing(configpath, configdata.get('tracing', {}))
+    except Exception as e:
+        stoptracing = lambda: None
+        logqueue.put({
+            "source": "LINK", 
+            "type": "serverlog", 
+            "time": logtime(), 
+            "log": f"(error) tracing could not be started | error - {e}",
+            "metadata": {}
+        })
+
     # Setup the server listening port and start it.
-    port = configdata['services']['LINK']['port']
-    server.add_insecure_port(f'[::]:{port}')
+    linkconfig = configdata['services']['LINK']
+    port = linkconfig['port']
+
+    if linkconfig.get('tls'):
+        # Read the certificate files of the device, resolving relative paths against the config directory
+        tlsconfig = configdata.get('tls', {})
+        def readfile(filepath):
+            with open(os.path.join(configpath, filepath), 'rb') as certfile:
+                return certfile.read()
+
+        # Serve with the device certificate and require client certificates signed by the CA for mutual TLS
+        mutualtls = bool(linkconfig.get('mtls'))
+        credentials = grpc.ssl_server_credentials(
+            [(readfile(tlsconfig['key']), readfile(tlsconfig['cert']))],
+            root_certificates=readfile(tlsconfig['ca']) if mutualtls else None,
+            require_client_auth=mutualtls
+        )
+        server.add_secure_port(f'[::]:{port}', credentials)
+    else:
+        server.add_insecure_port(f'[::]:{port}')
+
     server.start()
 
     # Log the start of the server.
@@ -154,8 +200,11 @@ def grpc_serve():
         "metadata": {}
     })
 
-    # Server will wait indefinitely for termination
-    server.wait_for_termination()
+    # Server will wait indefinitely for termination, flushing the spans once it stops
+    try:
+        server.wait_for_termination()
+    finally:
+        stoptracing()
 
 
 if __name__ == "__main__":
@@ -154,6 +154,16 @@ def meshlogparse(meshlog: dict):
             }
         })
 
+    elif meshlogtype == "controltopology":
+        # Only sent by control node firmware that advertises the TOPOLOGY capability in its config
+        logmessage.update({
+            "type": "topology",
+            "log": "mesh topology acquired",
+            "metadata": {
+                "topology": json.dumps(meshlogdata["topology"]) if isinstance(meshlogdata["topology"], dict) else meshlogdata["topology"]
+            }
+        })
+
     elif meshlogtype == "messagerx":
         logmessage.update({
             "type": "message", 
@@ -0,0 +1,151 @@
+"""
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrLINK module
+
+A module that contains functions that trace the calls to the LINK 
+server with OpenTelemetry. The trace context of a call is extracted from 
+the 'traceparent' of its gRPC metadata or from the 'trace' key of the 
+command, so that the spans of the LINK server continue the traces of the 
+ORCH server. Tracing is disabled if the exporter is not set in the config 
+or if the OpenTelemetry packages are not installed.
+===========================================================================
+"""
+
+import os
+
+try:
+    from opentelemetry import trace
+    from opentelemetry.trace import SpanKind, Status, StatusCode
+    from opentelemetry.trace.propagation.tracecontext import TraceContextTextMapPropagator
+    from opentelemetry.sdk.resources import Resource
+    from opentelemetry.sdk.trace import TracerProvider
+    from opentelemetry.sdk.trace.export import BatchSpanProcessor, ConsoleSpanExporter
+    from opentelemetry.sdk.trace.sampling import ParentBased, TraceIdRatioBased
+    otelavailable = True
+except ImportError:
+    otelavailable = False
+
+# The reserved key of a command that carries the W3C trace context of the span that issued it.
+TRACEKEY = "trace"
+# The name of the tracer of the FyrMesh services.
+TRACERNAME = "github.com/fyrwatch/fyrmesh"
+# The address of the OpenTelemetry collector when the endpoint is not set in the config file.
+DEFAULTENDPOINT = "localhost:4317"
+
+# The tracer of the LINK server, which is None while tracing is disabled.
+tracer = None
+
+
+def starttracing(configpath: str, tracingconfig: dict):
+    """ A function that starts the tracing of the LINK server from the 'tracing' 
+    section of the config file. Returns a function that flushes the remaining 
+    spans and stops the exporter before the server exits. Raises an exception 
+    if the exporter is invalid or the OpenTelemetry packages are not installed. """
+
+    global tracer
+    exporter = tracingconfig.get('exporter', "")
+    if not exporter:
+        return lambda: None
+
+    if not otelavailable:
+        raise RuntimeError("the 'opentelemetry-sdk' package is not installed")
+
+    # Construct the exporter of the spans
+    tracefile = None
+    if exporter == "otlp":
+        from opentelemetry.exporter.otlp.proto.grpc.trace_exporter import OTLPSpanExporter
+        spanexporter = OTLPSpanExporter(
+            endpoint=tracingconfig.get('endpoint') or DEFAULTENDPOINT, 
+            insecure=bool(tracingconfig.get('insecure'))
+        )
+
+    elif exporter == "file":
+        # Write the spans as JSON lines to the trace file of the server, a
+        # relative directory is resolved against the config directory
+        directory = os.path.join(configpath, tracingconfig.get('directory') or "")
+        tracefile = open(os.path.join(directory, "traces-fyrlink.json"), "a")
+        spanexporter = ConsoleSpanExporter(out=tracefile, formatter=lambda span: span.to_json(indent=None) + os.linesep)
+
+    else:
+        raise ValueError(f"invalid trace exporter '{exporter}' - must be 'otlp' or 'file'")
+
+    # Sample a ratio of the traces, following the decision of the parent span
+    ratio = tracingconfig.get('sampleratio') or 1
+    if ratio <= 0:
+        ratio = 1
+
+    # Create the tracer provider of the server and install it
+    provider = TracerProvider(
+        sampler=ParentBased(TraceIdRatioBased(ratio)), 
+        resource=Resource.create({"service.name": "fyrlink"})
+    )
+    provider.add_span_processor(BatchSpanProcessor(spanexporter))
+    trace.set_tracer_provider(provider)
+    tracer = provider.get_tracer(TRACERNAME)
+
+    def stoptracing():
+        provider.shutdown()
+        if tracefile:
+            tracefile.close()
+
+    return stoptracing
+
+
+def startspan(method: str, context, metadata: dict = None, attributes: dict = None):
+    """ A function that starts the span of a call to a method of the LINK server 
+    as a child of the trace context in the gRPC metadata of the call. The 'trace' 
+    key of the command metadata is used if the gRPC metadata carries no trace 
+    context. Returns None if tracing is disabled. """
+
+    if tracer is None:
+        return None
+
+    # Collect the trace context from the gRPC metadata or else from the command
+    carrier = {key: value for key, value in context.invocation_metadata()}
+    if "traceparent" not in carrier and metadata and metadata.get(TRACEKEY):
+        carrier = {"traceparent": metadata[TRACEKEY]}
+
+    parent = TraceContextTextMapPropagator().extract(carrier)
+    return tracer.start_span(f"main.Interface/{method}", context=parent, kind=SpanKind.SERVER, attributes={
+        "rpc.system": "grpc", 
+        "rpc.service": "main.Interface", 
+        "rpc.method": method,
+        **(attributes or {})
+    })
+
+
+def endspan(span, error: Exception = None, attributes: dict = None):
+    """ A function that ends a span started by startspan, recording the error 
+    of the call if any. Does nothing if the span is None. """
+
+    if span is None:
+        return
+
+    if attributes:
+        span.set_attributes(attributes)
+    if error is not None:
+        span.record_exception(error)
+        span.set_status(Status(StatusCode.ERROR, str(error)))
+    span.end()
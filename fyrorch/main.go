@@ -28,9 +28,12 @@ FyrMesh FyrORCH
 package main
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	pb "github.com/fyrwatch/fyrmesh/proto"
 	tools "github.com/fyrwatch/fyrmesh/tools"
 )
 
@@ -40,28 +43,61 @@ func main() {
 	if err != nil {
 		// Generate an ORCH serverlog and print it.
 		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) mesh orchestrator could not be constructed | error - %v |", err))))
+		return
 	}
 
-	// Defer the closing of the meshorchestrator channels
-	defer meshorchestrator.Close()
+	// Read the config file for the shutdown timeout
+	config, err := tools.ReadConfig()
+	if err != nil {
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) config file could not be read | error - %v |", err))))
+		return
+	}
+
+	// Start the tracing of the orchestrator. The orchestrator runs without tracing if it cannot be started.
+	stoptracing, err := tools.StartTracing(config.Tracing, "fyrorch")
+	if err != nil {
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) tracing could not be started | error - %v", err))))
+	} else {
+		defer func() {
+			// Flush the remaining spans before exiting
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+			stoptracing(ctx)
+		}()
+	}
+
+	// Create the lifecycle of the orchestrator and cancel its root context on SIGINT or SIGTERM
+	lifecycle := orch.NewLifecycle(config.GetShutdownTimeout())
+	lifecycle.NotifySignals()
 
 	// Start a go-routine that handles log parsing, formatting, printing and forwarding.
-	go tools.LogHandler(meshorchestrator)
+	loghandler := lifecycle.GoHandler(func() { tools.LogHandler(meshorchestrator) })
 
-	// Initiate the connect runtime to the LINK server over gRPC
+	// Initiate the connect runtime to the LINK server over gRPC. The connection is established in the
+	// background, so the orchestrator starts in a degraded mode until the LINK server is available.
+	var linkclient pb.InterfaceClient
 	client, conn, err := orch.GRPCconnect_LINK()
-	defer conn.Close()
 	if err != nil {
-		// Generate an ORCH serverlog and send it over the LogQueue of the meshorchestrator
-		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) connection to LINK server could not be established | error - %v |", err))))
+		// Generate an ORCH serverlog and fall back to a LINK client that fails every call
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) connection to LINK server could not be set up | error - %v |", err))))
+		linkclient = orch.NewUnavailableLINK(err)
+	} else {
+		defer conn.Close()
+		linkclient = *client
 	}
 
-	// Start the go routine that starts streaming logs from the LINK server
-	go orch.Call_LINK_Read(*client, meshorchestrator.LogQueue)
+	// Start the go routine that supervises the stream of logs from the LINK server
+	lifecycle.Go(func(ctx context.Context) { orch.Supervise_LINK_Read(ctx, linkclient, meshorchestrator) })
 
-	// Start the Orchestrator ORCH gRPC Server
-	if err = orch.Start_ORCH_Server(*client, meshorchestrator); err != nil {
-		// Generate an ORCH serverlog and send it over the LogQueue of the meshorchestrator
-		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) starting the ORCH server failed | error - %v |", err))))
+	// Start the Orchestrator ORCH gRPC Server and serve until the orchestrator is shut down
+	if err = orch.Start_ORCH_Server(lifecycle, linkclient, meshorchestrator); err != nil {
+		// Generate an ORCH serverlog and print it. The channels of the meshorchestrator
+		// are left open since the background tasks might still be sending on them.
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(error) ORCH server failed | error - %v |", err))))
+		return
 	}
+
+	// Close the channels and clients of the meshorchestrator and wait for the remaining logs to be printed
+	meshorchestrator.Close()
+	<-loghandler
 }
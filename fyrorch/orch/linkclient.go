@@ -29,11 +29,17 @@ package orch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/fyrwatch/fyrmesh/proto"
@@ -54,7 +60,17 @@ func GRPCconnect_LINK() (*pb.InterfaceClient, *grpc.ClientConn, error) {
 	linkhost := fmt.Sprintf("%s:%d", linkconfig.Host, linkconfig.Port)
 
 	// Connect to the Interface LINK gRPC Server
-	conn, err := grpc.Dial(linkhost, grpc.WithInsecure(), grpc.WithBlock())
+	// Construct the transport credentials for the LINK server
+	credentials, err := tools.NewDialCredentials(config, "LINK")
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load TLS credentials - %v", err)
+	}
+
+	// The connection is not blocked on, it is established in the background so that the
+	// orchestrator can start before the LINK server is available. The calls to the 'Write'
+	// method are traced, along with every 'Read' stream for as long as it stays open.
+	options := append([]grpc.DialOption{credentials}, tools.TraceDialOptions()...)
+	conn, err := grpc.Dial(linkhost, options...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not dialup LINK gRPC server - %v", err)
 	}
@@ -64,61 +80,226 @@ func GRPCconnect_LINK() (*pb.InterfaceClient, *grpc.ClientConn, error) {
 	return &client, conn, nil
 }
 
+// A struct that defines a LINK client that fails every call. It stands in for the LINK client when
+// a connection to the LINK server could not be set up, such as when its config or TLS credentials
+// are invalid, so that the orchestrator can still start and report the problem in its status.
+type unavailableLINK struct {
+	err error
+}
+
+// A constructor function that generates and returns a LINK client that fails every call with an error.
+func NewUnavailableLINK(err error) pb.InterfaceClient {
+	return unavailableLINK{err: err}
+}
+
+// A method of unavailableLINK that fails a call to the 'Read' method of the LINK server.
+func (link unavailableLINK) Read(ctx context.Context, trigger *pb.Trigger, opts ...grpc.CallOption) (pb.Interface_ReadClient, error) {
+	return nil, status.Errorf(codes.Unavailable, "LINK client unavailable - %v", link.err)
+}
+
+// A method of unavailableLINK that fails a call to the 'Write' method of the LINK server.
+func (link unavailableLINK) Write(ctx context.Context, command *pb.ControlCommand, opts ...grpc.CallOption) (*pb.Acknowledge, error) {
+	return nil, status.Errorf(codes.Unavailable, "LINK client unavailable - %v", link.err)
+}
+
+// An error that is wrapped by Call_LINK_Write when the LINK server did not acknowledge a command.
+// Such a command reached the LINK server and is not retried.
+var errLINKRejected = errors.New("call to LINK Write returned a false acknowledge")
+
 // A function that calls the 'Write' method of the LINK server over a gRPC connection.
-// Requires the LINK client object, a logqueue channel and the string command to send.
-func Call_LINK_Write(client pb.InterfaceClient, logqueue chan tools.Log, command map[string]string) {
+// Requires a context, the LINK client object, a function that sends logs to the LogQueue and the string command to send.
+// The reserved command ID and trace keys are removed before the command is written. Returns an
+// error if the call failed or the LINK did not acknowledge the command.
+func Call_LINK_Write(ctx context.Context, client pb.InterfaceClient, sendlog func(tools.Log), command map[string]string) error {
 	commandmessage := command["command"]
+	commandid := command[tools.CommandIDKey]
 	delete(command, "command")
+	delete(command, tools.CommandIDKey)
+	delete(command, tools.TraceKey)
 
 	// Send a string command to the Interface LINK server and get the acknowledgment
-	acknowledge, err := client.Write(context.Background(), &pb.ControlCommand{Command: commandmessage, Metadata: command})
+	acknowledge, err := client.Write(ctx, &pb.ControlCommand{Command: commandmessage, Metadata: command})
 
 	// Check for errors and construct appropriate protolog
 	var logmessage *tools.OrchLog
 	if err != nil {
-		logmessage = tools.NewOrchProtolog("(failure) method call failed", "LINK", "Write", err)
+		logmessage = tools.NewOrchProtolog(fmt.Sprintf("(failure) method call failed | command - %v | cmdid - %v", commandmessage, commandid), "LINK", "Write", err)
+		err = fmt.Errorf("call to LINK Write runtime failed - %v", err)
 	} else {
-		msg := fmt.Sprintf("(success) method call complete | command - %v | success - %v", commandmessage, acknowledge.GetSuccess())
+		msg := fmt.Sprintf("(success) method call complete | command - %v | cmdid - %v | success - %v", commandmessage, commandid, acknowledge.GetSuccess())
 		logmessage = tools.NewOrchProtolog(msg, "LINK", "Write", fmt.Errorf("%v", acknowledge.GetError()))
+		if !acknowledge.GetSuccess() {
+			err = fmt.Errorf("%w - %v", errLINKRejected, acknowledge.GetError())
+		}
 	}
 
-	// Send logmessage onto the logqueue channel
-	logqueue <- logmessage
+	// Send logmessage onto the LogQueue
+	sendlog(logmessage)
+	return err
 }
 
-// A function that calls the 'Read' method of the LINK server over a gRPC connection.
-// Requires the LINK client object and a logqueue channel. InterfaceLogs recieved from
-// LINK server will continously parsed and passed into the logqueue channel to be handled.
-func Call_LINK_Read(client pb.InterfaceClient, logqueue chan tools.Log) {
-	// Sleep to let other services initialize
-	time.Sleep(time.Second * 5)
+// A function that writes a command from the CommandQueue or the outbox to the LINK server in a span
+// that continues the trace of the caller that issued the command. The span of a ping is registered
+// so that the responses of the mesh are traced to it. Returns the error of Call_LINK_Write.
+func writeCommand(linkclient pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator, command map[string]string) error {
+	// Start the span of the write from the trace context of the command
+	ctx, span := tools.Tracer().Start(tools.ExtractTrace(context.Background(), command), "fyrmesh.command/"+command["command"],
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("fyrmesh.cmdid", command[tools.CommandIDKey]), attribute.String("fyrmesh.ping", command["ping"])),
+	)
+	defer span.End()
 
+	// Register the span of a ping for its responses and write the command
+	pingid := command["ping"]
+	meshorchestrator.PingTraces.Register(pingid, span.SpanContext())
+	if err := Call_LINK_Write(ctx, linkclient, meshorchestrator.SendLog, command); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return err
+	}
+
+	// Count the ping on the metrics and record the nodes it addresses as pinged
+	if pingid != "" {
+		meshorchestrator.Metrics.PingWritten()
+		meshorchestrator.TrackPing(command)
+	}
+	return nil
+}
+
+// The initial and maximum delays between attempts to open the read stream from the LINK server,
+// the factor by which the delay grows after each failed attempt, the fraction of random jitter
+// and the time a stream must stay open for the delay to be reset to the initial delay.
+const (
+	linkBackoffInitial    = time.Second
+	linkBackoffMax        = time.Minute
+	linkBackoffMultiplier = 2.0
+	linkBackoffJitter     = 0.2
+	linkBackoffReset      = 30 * time.Second
+)
+
+// A function that calls the 'Read' method of the LINK server over a gRPC connection.
+// Requires a context, the LINK client object, a function that sends logs to the LogQueue, the tap
+// that publishes the received logs to the recorders and a function that is called once the stream
+// is open. InterfaceLogs recieved from LINK server will continously parsed and passed into the
+// LogQueue to be handled. Returns the error that broke the stream, or that prevented it from
+// opening, once the stream is no longer readable.
+func Call_LINK_Read(ctx context.Context, client pb.InterfaceClient, sendlog func(tools.Log), tap *tools.ObserverBroker, onopen func()) error {
 	// Call the 'Read' method of the LINK client with the appropriate trigger message
-	stream, err := client.Read(context.Background(), &pb.Trigger{Triggermessage: "start-stream-read"})
+	stream, err := client.Read(ctx, &pb.Trigger{Triggermessage: "start-stream-read"})
 	if err != nil {
 		// Check for an error and push the protolog into the channel
-		logqueue <- tools.NewOrchProtolog("(failure) method call failed.", "LINK", "Read", err)
+		sendlog(tools.NewOrchProtolog("(failure) method call failed.", "LINK", "Read", err))
+		return fmt.Errorf("read stream could not be started - %v", err)
 	}
 
+	// Signal that the stream is open
+	onopen()
+
 	// Start an infinite loop to read from the stream
 	for {
 		// Recieve an InterfaceLog object from the stream
 		complexlog, err := stream.Recv()
 
-		// Break out of loop if stream has closed
+		// Return if stream has closed
 		if err == io.EOF {
-			break
+			return fmt.Errorf("read stream closed by the LINK server")
 		}
 
-		// Push to logqueue if any other error occurs and break from loop.
+		// Push to the LogQueue if any other error occurs and return.
 		if err != nil {
 			errstatus, _ := status.FromError(err)
 			errmsg := fmt.Errorf("StreamError - (%v)%v", errstatus.Code(), errstatus.Message())
-			logqueue <- tools.NewOrchProtolog("(failure) method runtime failed while streaming", "LINK", "Read", errmsg)
-			break
+			sendlog(tools.NewOrchProtolog("(failure) method runtime failed while streaming", "LINK", "Read", errmsg))
+			return fmt.Errorf("read stream broke - %v", errmsg)
+		}
+
+		// Publish the ComplexLog with its arrival time to the recorders and push it into the LogQueue.
+		tap.Publish(tools.NewRecordedLog(complexlog, time.Now()))
+		sendlog(complexlog)
+	}
+}
+
+// A function that supervises the read stream from the LINK server until the context is done.
+// The stream is reopened with an exponential backoff and jitter whenever it breaks or fails to
+// open. Every time the stream opens, the LINK is marked healthy and the meshorchestrator is
+// initialized to refresh the control node config and the nodelist. The state of the stream and
+// its outages are recorded on the LinkMonitor of the meshorchestrator.
+func Supervise_LINK_Read(ctx context.Context, client pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator) {
+	// Create a random source for the jitter of the backoff
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// Sleep to let other services initialize
+	select {
+	case <-ctx.Done():
+		meshorchestrator.Link.Stopped()
+		return
+	case <-time.After(time.Second * 5):
+	}
+
+	// The number of the next attempt for the backoff. Reset once a stream stays open
+	// long enough, so that a LINK that accepts and immediately drops the stream still backs off.
+	attempt := 1
+
+	for {
+		// Attempt to open and read the stream
+		var opened time.Time
+		meshorchestrator.Link.Connecting()
+		err := Call_LINK_Read(ctx, client, meshorchestrator.SendLog, meshorchestrator.LinkTap, func() {
+			opened = time.Now()
+			// Mark the LINK as healthy and end the outage
+			meshorchestrator.Health.Set(tools.ComponentLINK, true, "read stream open")
+			reopened, outagestart := meshorchestrator.Link.Connected()
+			if reopened || outagestart != "" {
+				meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(success) LINK read stream reopened | outage - %v to %v |", outagestart, tools.CurrentISOtime())))
+			}
+
+			// Initialize the meshorchestrator with the control node config and the nodelist
+			meshorchestrator.Initialize()
+			// Resume the outbox so that the pending commands are written without waiting for the backoff
+			if meshorchestrator.Outbox != nil {
+				meshorchestrator.Outbox.Wake()
+			}
+		})
+
+		// Stop supervising if the context is done
+		if ctx.Err() != nil {
+			meshorchestrator.Health.Set(tools.ComponentLINK, false, "read stream stopped")
+			meshorchestrator.Link.Stopped()
+			return
 		}
 
-		// Push the ComplexLog from the LINK into the logqueue.
-		logqueue <- complexlog
+		// Mark the LINK as unhealthy and wait for the backoff before the next attempt
+		meshorchestrator.Health.Set(tools.ComponentLINK, false, err.Error())
+		if !opened.IsZero() && time.Since(opened) >= linkBackoffReset {
+			attempt = 1
+		}
+		delay := linkBackoff(attempt, random)
+		attempt++
+		meshorchestrator.Link.Backoff(err, time.Now().Add(delay))
+		meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(failure) LINK read stream unavailable | retry in - %v | error - %v", delay.Round(time.Millisecond), err)))
+
+		select {
+		case <-ctx.Done():
+			meshorchestrator.Link.Stopped()
+			return
+		case <-time.After(delay):
+		}
 	}
 }
+
+// A function that returns the delay before an attempt to open the read stream from the LINK server.
+// The delay grows exponentially with the number of the attempt up to a maximum, with random jitter.
+func linkBackoff(attempt int, random *rand.Rand) time.Duration {
+	// Grow the delay exponentially up to the maximum
+	delay := float64(linkBackoffInitial)
+	for index := 1; index < attempt && delay < float64(linkBackoffMax); index++ {
+		delay *= linkBackoffMultiplier
+	}
+	if delay > float64(linkBackoffMax) {
+		delay = float64(linkBackoffMax)
+	}
+
+	// Add a random jitter of up to the jitter fraction in either direction
+	delay += delay * linkBackoffJitter * (random.Float64()*2 - 1)
+	return time.Duration(delay)
+}
@@ -0,0 +1,227 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A mapping of the full gRPC method names of the mutating RPCs of the ORCH server to their audit action.
+// Calls to methods that are not declared here are not audited.
+var auditActions = map[string]string{
+	fullMethod(pb.Orchestrator_ServiceDesc, "Connection"):      "connection",
+	fullMethod(pb.Orchestrator_ServiceDesc, "Ping"):            "ping",
+	fullMethod(pb.Orchestrator_ServiceDesc, "PingStream"):      "ping",
+	fullMethod(pb.Orchestrator_ServiceDesc, "Command"):         "command",
+	fullMethod(pb.Orchestrator_ServiceDesc, "SchedulerToggle"): "scheduler",
+	fullMethod(pb.Orchestrator_ServiceDesc, "Simulate"):        "simulate",
+
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetConnection"): "connection",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Ping"):          "ping",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "PingStream"):    "ping",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Command"):       "command",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetScheduler"):  "scheduler",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Simulate"):      "simulate",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "PurgeOutbox"):   "outbox",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Replay"):        "replay",
+
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetNodeRecord"):     "registry",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "DeleteNodeRecord"):  "registry",
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "ImportNodeRecords"): "registry",
+}
+
+// A struct that defines the slot that the authenticator fills with the Caller of an audited call.
+// The audit interceptor runs before the authenticator so that denied calls are also audited.
+type callerSlot struct {
+	caller Caller
+	filled bool
+}
+
+// A type that defines the context key of the callerSlot of an audited call.
+type callerSlotKey struct{}
+
+// A function that fills the callerSlot of a context with a Caller, if the context has one.
+func fillCallerSlot(ctx context.Context, caller Caller) {
+	if slot, ok := ctx.Value(callerSlotKey{}).(*callerSlot); ok {
+		slot.caller = caller
+		slot.filled = true
+	}
+}
+
+// A struct that defines the auditor of the ORCH server that records every mutating call in the AuditLog.
+type Auditor struct {
+	// The audit log to record the calls in
+	auditlog *tools.AuditLog
+
+	// The function that sends logs to the LogQueue to report failures to record calls
+	sendlog func(tools.Log)
+}
+
+// A constructor function that generates and returns an Auditor for an AuditLog.
+func NewAuditor(auditlog *tools.AuditLog, sendlog func(tools.Log)) *Auditor {
+	return &Auditor{auditlog: auditlog, sendlog: sendlog}
+}
+
+// A method of Auditor that returns a gRPC unary interceptor that audits every mutating call.
+// Must be chained before the interceptors of the Authenticator.
+func (auditor *Auditor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Check if the method is audited
+		action, ok := auditActions[info.FullMethod]
+		if !ok {
+			return handler(ctx, request)
+		}
+
+		// Add a caller slot to the context and call the handler
+		slot := &callerSlot{}
+		started := tools.CurrentISOtime()
+		response, err := handler(context.WithValue(ctx, callerSlotKey{}, slot), request)
+
+		// Record the call
+		auditor.record(ctx, started, action, info.FullMethod, slot, request, response, err)
+		return response, err
+	}
+}
+
+// A method of Auditor that returns a gRPC stream interceptor that audits every mutating stream.
+// The first message received on the stream is recorded as its request. Must be chained before the
+// interceptors of the Authenticator.
+func (auditor *Auditor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Check if the method is audited
+		action, ok := auditActions[info.FullMethod]
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		// Wrap the stream with a caller slot and call the handler
+		slot := &callerSlot{}
+		started := tools.CurrentISOtime()
+		auditedstream := &auditStream{ServerStream: stream, ctx: context.WithValue(stream.Context(), callerSlotKey{}, slot)}
+		err := handler(srv, auditedstream)
+
+		// Record the stream
+		auditor.record(stream.Context(), started, action, info.FullMethod, slot, auditedstream.request, nil, err)
+		return err
+	}
+}
+
+// A struct that defines a gRPC server stream that carries a caller slot and keeps its first received message.
+type auditStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	request interface{}
+}
+
+// A method of auditStream that returns the context of the stream with the caller slot.
+func (stream *auditStream) Context() context.Context {
+	return stream.ctx
+}
+
+// A method of auditStream that receives a message and keeps it if it is the first.
+func (stream *auditStream) RecvMsg(message interface{}) error {
+	err := stream.ServerStream.RecvMsg(message)
+	if err == nil && stream.request == nil {
+		stream.request = message
+	}
+	return err
+}
+
+// A method of Auditor that records an audited call in the AuditLog.
+func (auditor *Auditor) record(ctx context.Context, started string, action string, method string, slot *callerSlot, request interface{}, response interface{}, err error) {
+	// Create the audit entry with the caller of the call
+	caller := slot.caller
+	if !slot.filled {
+		caller = CallerFromContext(ctx)
+	}
+	entry := tools.AuditEntry{
+		Time:       started,
+		Action:     action,
+		Method:     method,
+		Caller:     caller.Name,
+		Role:       caller.Role.String(),
+		AuthMethod: caller.Method,
+	}
+
+	// Set the address of the peer
+	if p, ok := peer.FromContext(ctx); ok {
+		entry.Peer = p.Addr.String()
+	}
+
+	// Set the JSON payload of the request
+	if message, ok := request.(proto.Message); ok {
+		if payload, merr := (protojson.MarshalOptions{EmitUnpopulated: true}).Marshal(message); merr == nil {
+			entry.Request = string(payload)
+		}
+	}
+
+	// Set the result of the call
+	entry.Result = "success"
+	if err != nil {
+		entry.Result = "error"
+		if code := status.Code(err); code == codes.Unauthenticated || code == codes.PermissionDenied {
+			entry.Result = "denied"
+		}
+		entry.Error = err.Error()
+	} else if acknowledge, ok := response.(*pb.Acknowledge); ok && !acknowledge.GetSuccess() {
+		entry.Result = "failure"
+		entry.Error = acknowledge.GetError()
+	}
+
+	// Record the entry and report a failure to the log queue
+	if rerr := auditor.auditlog.Record(entry); rerr != nil {
+		auditor.sendlog(tools.NewOrchServerlog(fmt.Sprintf("(error) audit entry for '%v' could not be recorded | error - %v |", method, rerr)))
+	}
+}
+
+// A constructor function that generates and returns an AuditEntry proto from a tools.AuditEntry.
+func NewAuditEntry(entry tools.AuditEntry) *pb.AuditEntry {
+	return &pb.AuditEntry{
+		Time:       entry.Time,
+		Action:     entry.Action,
+		Method:     entry.Method,
+		Caller:     entry.Caller,
+		Role:       entry.Role,
+		AuthMethod: entry.AuthMethod,
+		Peer:       entry.Peer,
+		Request:    entry.Request,
+		Result:     entry.Result,
+		Error:      entry.Error,
+	}
+}
@@ -0,0 +1,253 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A mapping of the full gRPC method names of the ORCH server to the role that a caller requires to call them.
+// Methods that are not declared here require the admin role. Methods declared with RoleNone require no authentication.
+var methodRoles = map[string]tools.Role{
+	"/grpc.health.v1.Health/Check": tools.RoleNone,
+	"/grpc.health.v1.Health/Watch": tools.RoleNone,
+
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": tools.RoleViewer,
+
+	fullMethod(pb.Orchestrator_ServiceDesc, "Status"):          tools.RoleViewer,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Observe"):         tools.RoleViewer,
+	fullMethod(pb.Orchestrator_ServiceDesc, "ObserveComplex"):  tools.RoleViewer,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Nodelist"):        tools.RoleViewer,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Connection"):      tools.RoleOperator,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Ping"):            tools.RoleOperator,
+	fullMethod(pb.Orchestrator_ServiceDesc, "PingStream"):      tools.RoleOperator,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Command"):         tools.RoleOperator,
+	fullMethod(pb.Orchestrator_ServiceDesc, "SchedulerToggle"): tools.RoleOperator,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Simulate"):        tools.RoleAdmin,
+
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Status"):            tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Observe"):           tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Nodelist"):          tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "DescribeCommands"):  tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "CommandStatus"):     tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "ListCommands"):      tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "ListOutbox"):        tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Topology"):          tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "GetNode"):           tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "ListNodeRecords"):   tools.RoleViewer,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetConnection"):     tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Ping"):              tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "PingStream"):        tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Command"):           tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetScheduler"):      tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "PurgeOutbox"):       tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetNodeRecord"):     tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "DeleteNodeRecord"):  tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "ImportNodeRecords"): tools.RoleOperator,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Simulate"):          tools.RoleAdmin,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "QueryAudit"):        tools.RoleAdmin,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Record"):            tools.RoleAdmin,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Replay"):            tools.RoleAdmin,
+}
+
+// A function that returns the full gRPC method name of a method of a service.
+func fullMethod(service grpc.ServiceDesc, method string) string {
+	return "/" + service.ServiceName + "/" + method
+}
+
+// A function that returns the role required to call a full gRPC method name.
+func requiredRole(method string) tools.Role {
+	if role, ok := methodRoles[method]; ok {
+		return role
+	}
+	return tools.RoleAdmin
+}
+
+// A struct that defines the authenticated caller of an RPC of the ORCH server.
+type Caller struct {
+	// The name of the caller. The name of the API token or the common name of the client certificate.
+	Name string
+
+	// The role of the caller
+	Role tools.Role
+
+	// The method used to authenticate the caller. One of 'token', 'certificate' or 'anonymous'.
+	Method string
+}
+
+// A method of Caller that returns whether the caller may view the secrets of the mesh, such as the mesh password.
+// Only admins that were authenticated with a token or a certificate may view them, so that the anonymous admins
+// allowed when the authentication is not enabled do not expose them to anyone who can reach the ORCH server.
+func (caller Caller) CanViewSecrets() bool {
+	return caller.Role >= tools.RoleAdmin && caller.Method != "anonymous"
+}
+
+// A type that defines the context key of the Caller of an RPC.
+type callerKey struct{}
+
+// A function that returns the Caller of an RPC from its context.
+// Returns an anonymous caller with no role if the context has no caller.
+func CallerFromContext(ctx context.Context) Caller {
+	if caller, ok := ctx.Value(callerKey{}).(Caller); ok {
+		return caller
+	}
+	return Caller{Name: "anonymous", Role: tools.RoleNone, Method: "anonymous"}
+}
+
+// A struct that defines the authenticator of the callers of the ORCH server. Callers are authenticated
+// with an API token in the 'authorization' metadata or with a verified client certificate. If the
+// authentication is not enabled, callers that cannot be authenticated are anonymous admins that may
+// call every method but may not view the secrets of the mesh.
+type Authenticator struct {
+	// A bool that indicates whether authentication is required
+	enabled bool
+
+	// The store of the API tokens
+	tokens *tools.TokenStore
+}
+
+// A constructor function that generates and returns an Authenticator for the ORCH service from the config.
+func NewAuthenticator(config tools.Config) (*Authenticator, error) {
+	// Create the token store
+	tokens, err := tools.NewTokenStore()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the authenticator
+	return &Authenticator{enabled: config.Services["ORCH"].Auth, tokens: tokens}, nil
+}
+
+// A method of Authenticator that identifies the Caller of an RPC from its context.
+// Returns false if the caller could not be identified by a token or a certificate.
+func (authenticator *Authenticator) identify(ctx context.Context) (Caller, bool) {
+	// Check the 'authorization' metadata for an API token
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			token := strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+			if name, role, ok := authenticator.tokens.Authenticate(token); ok {
+				return Caller{Name: name, Role: role, Method: "token"}, true
+			}
+		}
+	}
+
+	// Check the peer for a verified client certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsinfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsinfo.State.VerifiedChains) > 0 {
+			certificate := tlsinfo.State.VerifiedChains[0][0]
+			return Caller{Name: certificate.Subject.CommonName, Role: tools.CertificateRole(certificate), Method: "certificate"}, true
+		}
+	}
+
+	return Caller{}, false
+}
+
+// A method of Authenticator that authenticates the caller of a method and checks that its role
+// is allowed to call the method. Returns the context with the Caller or a gRPC status error.
+func (authenticator *Authenticator) authorize(ctx context.Context, method string) (context.Context, error) {
+	// Identify the caller
+	caller, ok := authenticator.identify(ctx)
+	required := requiredRole(method)
+
+	if !ok {
+		if authenticator.enabled && required != tools.RoleNone {
+			return nil, status.Errorf(codes.Unauthenticated, "a valid API token or client certificate is required")
+		}
+		// Treat the unidentified caller as an anonymous admin when the authentication is not enabled
+		caller = Caller{Name: "anonymous", Role: tools.RoleAdmin, Method: "anonymous"}
+		if authenticator.enabled {
+			caller.Role = tools.RoleNone
+		}
+	}
+
+	// Record the caller for the auditor and check the role of the caller
+	fillCallerSlot(ctx, caller)
+	if caller.Role < required {
+		return nil, status.Errorf(codes.PermissionDenied, "caller '%v' with role '%v' is not allowed to call %v (requires '%v')", caller.Name, caller.Role, method, required)
+	}
+
+	// Return the context with the caller
+	return context.WithValue(ctx, callerKey{}, caller), nil
+}
+
+// A method of Authenticator that returns a gRPC unary interceptor that authorizes every call.
+func (authenticator *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Authorize the call
+		ctx, err := authenticator.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		// Call the handler with the context of the caller
+		return handler(ctx, request)
+	}
+}
+
+// A method of Authenticator that returns a gRPC stream interceptor that authorizes every stream.
+func (authenticator *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Authorize the stream
+		ctx, err := authenticator.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		// Call the handler with a stream that carries the context of the caller
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// A struct that defines a gRPC server stream with a replaced context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// A method of contextStream that returns the replaced context of the stream.
+func (stream *contextStream) Context() context.Context {
+	return stream.ctx
+}
+
+// A function that removes the mesh password from a MeshOrchStatus unless the caller
+// of the RPC is an admin that was authenticated with a token or a certificate.
+func redactStatus(ctx context.Context, meshstatus *pb.MeshOrchStatus) *pb.MeshOrchStatus {
+	if !CallerFromContext(ctx).CanViewSecrets() {
+		meshstatus.MeshPSWD = ""
+	}
+	return meshstatus
+}
@@ -29,9 +29,16 @@ package orch
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	pb "github.com/fyrwatch/fyrmesh/proto"
 	tools "github.com/fyrwatch/fyrmesh/tools"
@@ -39,7 +46,8 @@ import (
 
 // A function that establishes a gRPC connection to the orchestrator ORCH server and returns the ORCH
 // client object, the gPRC connection object and any error that occurs while attempting to connect.
-func GRPCconnect_ORCH() (*pb.OrchestratorClient, *grpc.ClientConn, error) {
+// The client uses the typed v2 Orchestrator service.
+func GRPCconnect_ORCH() (*pb.OrchestratorV2Client, *grpc.ClientConn, error) {
 	// Read the service config for the ORCH server
 	config, err := tools.ReadConfig()
 	if err != nil {
@@ -51,49 +59,85 @@ func GRPCconnect_ORCH() (*pb.OrchestratorClient, *grpc.ClientConn, error) {
 	orchhost := fmt.Sprintf("%s:%d", orchconfig.Host, orchconfig.Port)
 
 	// Connect to Orchestrator ORCH gRPC Server
-	conn, err := grpc.Dial(orchhost, grpc.WithInsecure(), grpc.WithBlock())
+	// Construct the transport credentials for the ORCH server
+	credentials, err := tools.NewDialCredentials(config, "ORCH")
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load TLS credentials - %v", err)
+	}
+
+	// Trace every call and attach the API token of the client to every call if one is available
+	options := append([]grpc.DialOption{credentials, grpc.WithBlock()}, tools.TraceDialOptions()...)
+	if token := tools.ReadClientToken(); token != "" {
+		options = append(options, grpc.WithPerRPCCredentials(tokenCredentials{token: token}))
+	}
+
+	conn, err := grpc.Dial(orchhost, options...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not dialup ORCH gRPC server - %v", err)
 	}
 
 	// Create an Orchestrator ORCH Client and return it along with the connection object and a nil error
-	client := pb.NewOrchestratorClient(conn)
+	client := pb.NewOrchestratorV2Client(conn)
 	return &client, conn, nil
 }
 
-// A function that calls the 'Connection' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client object and a boolean value of the connection state to transmit.
-func Call_ORCH_Connection(client pb.OrchestratorClient, value bool) (bool, error) {
-	// Test the boolean value and set the appropriate commandmessage
-	var triggermessage string
-	if value {
-		triggermessage = "setconnection-on"
-	} else {
-		triggermessage = "setconnection-off"
+// A struct that defines the per-RPC credentials that carry the API token of a client
+// in the 'authorization' metadata of every call to the ORCH server.
+type tokenCredentials struct {
+	token string
+}
+
+// A method of tokenCredentials that returns the metadata to attach to a call.
+func (creds tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + creds.token}, nil
+}
+
+// A method of tokenCredentials that reports whether the token requires a secure transport. The token
+// is also sent over plaintext connections to support meshes that do not use TLS on the local network.
+func (creds tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// A function that returns the retry delay of an error of a call that was rejected by the rate limiter of
+// the ORCH server. The error may be wrapped. Returns false if the call was not rejected by the limiter.
+func RetryAfter(err error) (time.Duration, bool) {
+	// Retrieve the gRPC status of the error
+	var statuserr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &statuserr) || statuserr.GRPCStatus().Code() != codes.ResourceExhausted {
+		return 0, false
 	}
 
-	// Send the commandmessage for the Connection method to the
-	// Orchestrator ORCH server and get the acknowledgment
-	acknowledge, err := client.Connection(context.Background(), &pb.Trigger{Triggermessage: triggermessage})
+	// Find the retry info in the details of the status
+	for _, detail := range statuserr.GRPCStatus().Details() {
+		if retryinfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryinfo.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
 
-	// Check for errors and return the appropriate acknowledgement and error if any.
+// A function that calls the 'SetConnection' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client object and a boolean value of the connection state to transmit.
+func Call_ORCH_Connection(client pb.OrchestratorV2Client, value bool) (bool, error) {
+	// Call the SetConnection method with the connection state
+	response, err := client.SetConnection(context.Background(), &pb.ConnectionRequest{Connected: value})
 	if err != nil {
-		return false, fmt.Errorf("call to ORCH Connection runtime failed - %v", err)
+		return false, fmt.Errorf("call to ORCH SetConnection runtime failed - %w", err)
 	}
 
-	if success := acknowledge.GetSuccess(); success {
-		return true, nil
-	} else {
-		return false, fmt.Errorf("call to ORCH Connection returned a false acknowledge - %v", acknowledge.GetError())
+	// Check that the connection state was applied
+	if response.GetConnected() != value {
+		return false, fmt.Errorf("call to ORCH SetConnection did not apply the connection state")
 	}
+
+	return true, nil
 }
 
 // A function that calls the 'Observe' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client object and returns the stream client for the Observe service.
-func Call_ORCH_Observe(client pb.OrchestratorClient, sourcefilter string, typefilter string) (pb.Orchestrator_ObserveClient, error) {
-	// Send the valid initiation code as a Trigger to the Observe method of the ORCH Server
-	metadata := map[string]string{"source": sourcefilter, "type": typefilter}
-	stream, err := client.Observe(context.Background(), &pb.Trigger{Triggermessage: "start-stream-observe", Metadata: metadata})
+// Requires the ORCH client object and the ObserveFilter spec. Returns the stream client for the Observe service.
+func Call_ORCH_Observe(client pb.OrchestratorV2Client, filter *pb.ObserveFilter) (pb.OrchestratorV2_ObserveClient, error) {
+	// Call the Observe method with the filter spec
+	stream, err := client.Observe(context.Background(), filter)
 	if err != nil {
 		return nil, fmt.Errorf("call to ORCH Observe runtime failed - %v", err)
 	}
@@ -102,11 +146,48 @@ func Call_ORCH_Observe(client pb.OrchestratorClient, sourcefilter string, typefi
 	return stream, nil
 }
 
+// A function that calls the 'Record' method of the ORCH server over a gRPC connection.
+// Requires a context that cancels the recording, the ORCH client object and the duration of
+// the recording, 0 to record until the context is cancelled. Returns the stream handling client for the Record method.
+func Call_ORCH_Record(ctx context.Context, client pb.OrchestratorV2Client, duration time.Duration) (pb.OrchestratorV2_RecordClient, error) {
+	// Call the Record method with the duration
+	request := &pb.RecordRequest{}
+	if duration > 0 {
+		request.Duration = durationpb.New(duration)
+	}
+	stream, err := client.Record(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH Record runtime failed - %v", err)
+	}
+
+	// Return the stream handling client for the Record method.
+	return stream, nil
+}
+
+// A function that calls the 'Replay' method of the ORCH server over a gRPC connection.
+// Requires a context that cancels the replay, the ORCH client object and whether the replayed
+// logs are fed into the live orchestrator. Returns the stream handling client for the Replay method.
+func Call_ORCH_Replay(ctx context.Context, client pb.OrchestratorV2Client, live bool) (pb.OrchestratorV2_ReplayClient, error) {
+	// Ask for a live replay with the metadata of the call
+	if live {
+		ctx = metadata.AppendToOutgoingContext(ctx, ReplayLiveKey, "true")
+	}
+
+	// Call the Replay method
+	stream, err := client.Replay(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH Replay runtime failed - %v", err)
+	}
+
+	// Return the stream handling client for the Replay method.
+	return stream, nil
+}
+
 // A function that calls the 'Status' method of the ORCH server over a gRPC connection.
 // Requires the ORCH client object and returns a MeshStatus object.
-func Call_ORCH_Status(client pb.OrchestratorClient) (*pb.MeshOrchStatus, error) {
-	// Call the Status method with an arbitrary message
-	meshstatus, err := client.Status(context.Background(), &pb.Trigger{Triggermessage: "status-request"})
+func Call_ORCH_Status(client pb.OrchestratorV2Client) (*pb.MeshOrchStatus, error) {
+	// Call the Status method with an empty request
+	meshstatus, err := client.Status(context.Background(), &pb.StatusRequest{})
 	if err != nil {
 		return &pb.MeshOrchStatus{}, fmt.Errorf("call to ORCH Status runtime failed - %v", err)
 	}
@@ -116,103 +197,257 @@ func Call_ORCH_Status(client pb.OrchestratorClient) (*pb.MeshOrchStatus, error)
 }
 
 // A function that calls the 'Ping' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client object and returns a boolean success acknowledgment.
-func Call_ORCH_Ping(client pb.OrchestratorClient, trigger string, node string, phrase string) (bool, error) {
-	// Call the Ping method with the trigger code for mesh pinging
-	acknowledge, err := client.Ping(context.Background(), &pb.Trigger{Triggermessage: trigger, Metadata: map[string]string{"node": node, "phrase": phrase}})
-
-	// Check for errors and return the appropriate acknowledgement and error if any.
+// Requires the ORCH client object, the scope and type of the ping, the node ID for node
+// pings and the phrase for the ping ID. Returns the ID of the ping.
+func Call_ORCH_Ping(client pb.OrchestratorV2Client, scope pb.PingScope, pingtype pb.PingType, node int64, phrase string) (string, error) {
+	// Call the Ping method with the ping request
+	request := &pb.PingRequest{Scope: scope, Type: pingtype, Node: node, Phrase: phrase}
+	receipt, err := client.Ping(context.Background(), request)
 	if err != nil {
-		return false, fmt.Errorf("call to ORCH Ping runtime failed - %v", err)
+		return "", fmt.Errorf("call to ORCH Ping runtime failed - %w", err)
 	}
 
-	if success := acknowledge.GetSuccess(); success {
-		return true, nil
-	} else {
-		return false, fmt.Errorf("call to ORCH Ping returned a false acknowledge - %v", acknowledge.GetError())
+	// Return the ping ID
+	return receipt.GetPingID(), nil
+}
+
+// A function that calls the 'PingStream' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client object, the scope and type of the ping, the node ID for node pings,
+// the phrase for the ping ID and the duration to wait for responses. Returns the stream client
+// for the PingStream service.
+func Call_ORCH_PingStream(client pb.OrchestratorV2Client, scope pb.PingScope, pingtype pb.PingType, node int64, phrase string, timeout time.Duration) (pb.OrchestratorV2_PingStreamClient, error) {
+	// Call the PingStream method with the ping request
+	request := &pb.PingRequest{Scope: scope, Type: pingtype, Node: node, Phrase: phrase}
+	if timeout > 0 {
+		request.Timeout = durationpb.New(timeout)
+	}
+	stream, err := client.PingStream(context.Background(), request)
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH PingStream runtime failed - %w", err)
 	}
+
+	// Return the stream handling client for the PingStream method.
+	return stream, nil
 }
 
 // A function that calls the 'Command' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client object and a command map and returns a boolean success acknowledgment.
-func Call_ORCH_Command(client pb.OrchestratorClient, command map[string]string) (bool, error) {
+// Requires the ORCH client object and a command map and returns the ID of the command.
+func Call_ORCH_Command(client pb.OrchestratorV2Client, command map[string]string) (string, error) {
 	commandmessage := command["command"]
 	delete(command, "command")
 
-	// Call the Command method with the ControlCommand proto
-	acknowledge, err := client.Command(context.Background(), &pb.ControlCommand{Command: commandmessage, Metadata: command})
+	// Call the Command method with the CommandRequest proto
+	response, err := client.Command(context.Background(), &pb.CommandRequest{Command: commandmessage, Metadata: command})
+	if err != nil {
+		return "", fmt.Errorf("call to ORCH Command runtime failed - %w", err)
+	}
 
-	// Check for errors and return the appropriate acknowledgement and error if any.
+	// Return the command ID
+	return response.GetCommandID(), nil
+}
+
+// A function that calls the 'CommandStatus' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the ID of a command. Returns the CommandRecord of the command.
+func Call_ORCH_CommandStatus(client pb.OrchestratorV2Client, commandid string) (*pb.CommandRecord, error) {
+	// Call the CommandStatus method with the command ID
+	record, err := client.CommandStatus(context.Background(), &pb.CommandStatusRequest{CommandID: commandid})
 	if err != nil {
-		return false, fmt.Errorf("call to ORCH Command runtime failed - %v", err)
+		return nil, fmt.Errorf("call to ORCH CommandStatus runtime failed - %v", err)
 	}
 
-	if success := acknowledge.GetSuccess(); success {
-		return true, nil
-	} else {
-		return false, fmt.Errorf("call to ORCH Command returned a false acknowledge - %v", acknowledge.GetError())
+	// Return the command record
+	return record, nil
+}
+
+// A function that calls the 'ListCommands' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client, a state to filter by (unspecified for all states) and the maximum
+// number of commands to list. Returns the CommandRecords of the commands, newest first.
+func Call_ORCH_ListCommands(client pb.OrchestratorV2Client, state pb.CommandState, limit int) ([]*pb.CommandRecord, error) {
+	// Call the ListCommands method with the filter
+	recordlist, err := client.ListCommands(context.Background(), &pb.ListCommandsRequest{State: state, Limit: int64(limit)})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH ListCommands runtime failed - %v", err)
 	}
+
+	// Return the command records
+	return recordlist.GetCommands(), nil
 }
 
-// A function that calls the 'Nodelist' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client and returns a slice of int64.
-func Call_ORCH_Nodelist(client pb.OrchestratorClient) (map[int64]string, error) {
-	// Call the Nodelist method with the Trigger proto
-	nodelist, err := client.Nodelist(context.Background(), &pb.Trigger{Triggermessage: "nodelist-request"})
+// A function that calls the 'QueryAudit' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and an AuditQuery. Returns the matching AuditEntries, newest first.
+func Call_ORCH_QueryAudit(client pb.OrchestratorV2Client, query *pb.AuditQuery) ([]*pb.AuditEntry, error) {
+	// Call the QueryAudit method with the query
+	entrylist, err := client.QueryAudit(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH QueryAudit runtime failed - %v", err)
+	}
 
-	// Check for errors and return the appropriate acknowledgement and error if any.
+	// Return the audit entries
+	return entrylist.GetEntries(), nil
+}
+
+// A function that calls the 'ListOutbox' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and returns the commands pending in the outbox, oldest first.
+func Call_ORCH_ListOutbox(client pb.OrchestratorV2Client) ([]*pb.OutboxEntry, error) {
+	// Call the ListOutbox method with an empty request
+	entrylist, err := client.ListOutbox(context.Background(), &pb.ListOutboxRequest{})
 	if err != nil {
-		return nil, fmt.Errorf("call to ORCH Nodelist runtime failed - %v", err)
+		return nil, fmt.Errorf("call to ORCH ListOutbox runtime failed - %v", err)
 	}
 
-	// Obtain the slice of node IDs and return it.
-	nodes := nodelist.GetNodes()
-	return nodes, nil
+	// Return the outbox entries
+	return entrylist.GetEntries(), nil
 }
 
-// A function that calls the 'SchedulerToggle' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client and bool representing the toggle state.
-func Call_ORCH_SchedulerToggle(client pb.OrchestratorClient, toggle bool) error {
-	// Declare a trigger string
-	var trigger string
+// A function that calls the 'Topology' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the number of the latest topology changes to return, all of them if it is 0.
+func Call_ORCH_Topology(client pb.OrchestratorV2Client, history int64) (*pb.MeshTopology, error) {
+	// Call the Topology method with the request
+	topology, err := client.Topology(context.Background(), &pb.TopologyRequest{History: history})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH Topology runtime failed - %v", err)
+	}
+
+	// Return the topology
+	return topology, nil
+}
 
-	// Check the toggle
-	switch toggle {
-	case true:
-		trigger = "setscheduler-on"
-	case false:
-		trigger = "setscheduler-off"
+// A function that calls the 'GetNode' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the ID of the node. Returns the NodeInfo of the node.
+func Call_ORCH_GetNode(client pb.OrchestratorV2Client, nodeid int64) (*pb.NodeInfo, error) {
+	// Call the GetNode method with the request
+	nodeinfo, err := client.GetNode(context.Background(), &pb.GetNodeRequest{Node: nodeid})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH GetNode runtime failed - %v", err)
 	}
 
-	// Call the SchedulerToggle method with the Trigger proto
-	acknowledge, err := client.SchedulerToggle(context.Background(), &pb.Trigger{Triggermessage: trigger})
+	// Return the node info
+	return nodeinfo, nil
+}
 
-	// Check for errors and return the appropriate acknowledgement and error if any.
+// A function that calls the 'SetNodeRecord' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the SetNodeRecordRequest with the changes to the record. Returns the updated record.
+func Call_ORCH_SetNodeRecord(client pb.OrchestratorV2Client, request *pb.SetNodeRecordRequest) (*pb.NodeRecord, error) {
+	// Call the SetNodeRecord method with the request
+	record, err := client.SetNodeRecord(context.Background(), request)
 	if err != nil {
-		return fmt.Errorf("call to ORCH Command runtime failed - %v", err)
+		return nil, fmt.Errorf("call to ORCH SetNodeRecord runtime failed - %v", err)
 	}
 
-	if success := acknowledge.GetSuccess(); success {
-		return nil
-	} else {
-		return fmt.Errorf("call to ORCH Command returned a false acknowledge - %v", acknowledge.GetError())
+	// Return the updated record
+	return record, nil
+}
+
+// A function that calls the 'DeleteNodeRecord' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the ID of the node. Returns the removed record.
+func Call_ORCH_DeleteNodeRecord(client pb.OrchestratorV2Client, nodeid int64) (*pb.NodeRecord, error) {
+	// Call the DeleteNodeRecord method with the request
+	record, err := client.DeleteNodeRecord(context.Background(), &pb.DeleteNodeRecordRequest{Node: nodeid})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH DeleteNodeRecord runtime failed - %v", err)
 	}
+
+	// Return the removed record
+	return record, nil
 }
 
-// A function that calls the 'Simulate' method of the ORCH server over a gRPC connection.
-// Requires the ORCH client.
-func Call_ORCH_Simulate(client pb.OrchestratorClient) error {
-	// Call the SchedulerToggle method with the Trigger proto
-	acknowledge, err := client.Simulate(context.Background(), &pb.Trigger{Triggermessage: "start-fire-event"})
+// A function that calls the 'ListNodeRecords' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and the zone to list the records of, every zone if it is empty.
+func Call_ORCH_ListNodeRecords(client pb.OrchestratorV2Client, zone string) ([]*pb.NodeRecord, error) {
+	// Call the ListNodeRecords method with the request
+	recordlist, err := client.ListNodeRecords(context.Background(), &pb.ListNodeRecordsRequest{Zone: zone})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH ListNodeRecords runtime failed - %v", err)
+	}
+
+	// Return the records
+	return recordlist.GetRecords(), nil
+}
+
+// A function that calls the 'ImportNodeRecords' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client, the records to import and whether to replace the records of other nodes.
+// Returns the number of imported records.
+func Call_ORCH_ImportNodeRecords(client pb.OrchestratorV2Client, records []tools.NodeRecord, replace bool) (int64, error) {
+	// Convert the records and call the ImportNodeRecords method with the request
+	request := &pb.ImportNodeRecordsRequest{Records: make([]*pb.NodeRecord, 0, len(records)), Replace: replace}
+	for _, record := range records {
+		request.Records = append(request.Records, NewNodeRecord(record))
+	}
+	response, err := client.ImportNodeRecords(context.Background(), request)
+	if err != nil {
+		return 0, fmt.Errorf("call to ORCH ImportNodeRecords runtime failed - %v", err)
+	}
+
+	// Return the number of imported records
+	return response.GetImported(), nil
+}
+
+// A function that calls the 'PurgeOutbox' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client, the IDs of the commands to purge and whether to purge all of them.
+// Returns the IDs of the purged commands.
+func Call_ORCH_PurgeOutbox(client pb.OrchestratorV2Client, cmdids []string, all bool) ([]string, error) {
+	// Call the PurgeOutbox method with the request
+	response, err := client.PurgeOutbox(context.Background(), &pb.PurgeOutboxRequest{CommandIDs: cmdids, All: all})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH PurgeOutbox runtime failed - %v", err)
+	}
+
+	// Return the IDs of the purged commands
+	return response.GetCommandIDs(), nil
+}
+
+// A function that calls the 'DescribeCommands' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and returns the slice of CommandSpecs registered on the server.
+func Call_ORCH_DescribeCommands(client pb.OrchestratorV2Client) ([]*pb.CommandSpec, error) {
+	// Call the DescribeCommands method with an empty request
+	catalog, err := client.DescribeCommands(context.Background(), &pb.DescribeCommandsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("call to ORCH DescribeCommands runtime failed - %v", err)
+	}
+
+	// Return the command specs
+	return catalog.GetCommands(), nil
+}
+
+// A function that calls the 'Nodelist' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and returns a slice of int64.
+func Call_ORCH_Nodelist(client pb.OrchestratorV2Client) (*pb.NodeList, error) {
+	// Call the Nodelist method with an empty request
+	nodelist, err := client.Nodelist(context.Background(), &pb.NodelistRequest{})
 
 	// Check for errors and return the appropriate acknowledgement and error if any.
 	if err != nil {
-		return fmt.Errorf("call to ORCH Command runtime failed - %v", err)
+		return nil, fmt.Errorf("call to ORCH Nodelist runtime failed - %v", err)
 	}
 
-	if success := acknowledge.GetSuccess(); success {
-		return nil
-	} else {
-		return fmt.Errorf("call to ORCH Command returned a false acknowledge - %v", acknowledge.GetError())
+	// Return the nodelist with the liveness of the nodes.
+	return nodelist, nil
+}
+
+// A function that calls the 'SetScheduler' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client and bool representing the toggle state.
+func Call_ORCH_SchedulerToggle(client pb.OrchestratorV2Client, toggle bool) error {
+	// Call the SetScheduler method with the toggle state
+	response, err := client.SetScheduler(context.Background(), &pb.SchedulerRequest{Enabled: toggle})
+	if err != nil {
+		return fmt.Errorf("call to ORCH SetScheduler runtime failed - %v", err)
 	}
+
+	// Check that the toggle state was applied
+	if response.GetEnabled() != toggle {
+		return fmt.Errorf("call to ORCH SetScheduler did not apply the toggle state")
+	}
+
+	return nil
+}
+
+// A function that calls the 'Simulate' method of the ORCH server over a gRPC connection.
+// Requires the ORCH client.
+func Call_ORCH_Simulate(client pb.OrchestratorV2Client) error {
+	// Call the Simulate method with an empty request
+	if _, err := client.Simulate(context.Background(), &pb.SimulateRequest{}); err != nil {
+		return fmt.Errorf("call to ORCH Simulate runtime failed - %v", err)
+	}
+
+	return nil
 }
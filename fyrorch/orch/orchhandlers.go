@@ -0,0 +1,324 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// The default duration to wait for the responses to a streamed ping.
+const defaultPingTimeout = time.Second * 10
+
+// The number of responses a ping collector can buffer beyond the expected nodes.
+const pingBufferPadding = 8
+
+// A function that sets the connection state of the mesh and sends the appropriate connection
+// command to the command queue with the trace context of the caller. Returns an error if the
+// command could not be queued.
+func setConnection(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, connected bool) error {
+	// Send a command to the command queue
+	command := map[string]string{"command": "connection-off"}
+	if connected {
+		command["command"] = "connection-on"
+	}
+	if _, err := meshorchestrator.SendCommand(ctx, command); err != nil {
+		return err
+	}
+
+	// Set the meshconnected value
+	meshorchestrator.SetMeshConnected(connected)
+	return nil
+}
+
+// A function that sets the state of the scheduler and logs the change to the LogQueue.
+func setScheduler(meshorchestrator *tools.MeshOrchestrator, enabled bool) {
+	// Set the schedulerOn value
+	meshorchestrator.SetSchedulerOn(enabled)
+
+	// Log the start or stop of the scheduled pinging to the LogQueue
+	if enabled {
+		meshorchestrator.SendLog(tools.NewOrchSchedlog("(start) scheduler has started"))
+	} else {
+		meshorchestrator.SendLog(tools.NewOrchSchedlog("(stop) scheduler has stopped"))
+	}
+}
+
+// A function that activates the simulator and starts a fire event in the background.
+func startSimulation(meshorchestrator *tools.MeshOrchestrator) {
+	// Activate the orchestrator's simulator
+	meshorchestrator.Simulator.SetSimulationOn(true)
+
+	// Start the fire event
+	meshorchestrator.Go(func() { meshorchestrator.Simulator.StartFireEvent(meshorchestrator.SendLog) })
+}
+
+// A function that collects a command message and its metadata into a command map and sends it over
+// the CommandQueue with the trace context of the caller. Returns the ID of the command and an error
+// if it could not be queued.
+func dispatchCommand(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, commandmessage string, commandmetadata map[string]string) (string, error) {
+	// Set the command message as the 'command' key in a new map
+	command := map[string]string{"command": commandmessage}
+	// Collect the metadata values into the same command map
+	for key, value := range commandmetadata {
+		command[key] = value
+	}
+
+	// Send the command over the CommandQueue
+	return meshorchestrator.SendCommand(ctx, command)
+}
+
+// A function that converts an error from sending a command to the CommandQueue into a gRPC status.
+// A full queue is reported as ResourceExhausted and a closed queue as Unavailable.
+func queueStatus(err error) error {
+	switch err {
+	case tools.ErrQueueFull:
+		return status.Errorf(codes.ResourceExhausted, "command could not be queued - %v", err)
+	case tools.ErrQueueClosed:
+		return status.Errorf(codes.Unavailable, "command could not be queued - %v", err)
+	default:
+		return status.Errorf(codes.Internal, "command could not be queued - %v", err)
+	}
+}
+
+// A function that returns the suffix used in the ping IDs of a ping scope.
+func pingScopeSuffix(scope pb.PingScope) string {
+	switch scope {
+	case pb.PingScope_PING_SCOPE_MESH:
+		return "mesh"
+	case pb.PingScope_PING_SCOPE_NODE:
+		return "node"
+	case pb.PingScope_PING_SCOPE_CONTROL:
+		return "control"
+	default:
+		return "unknown"
+	}
+}
+
+// A counter of the user ping IDs generated by newPingID, which keeps the IDs of
+// pings with the same phrase and scope unique within the same second.
+var pingSequence uint64
+
+// A function that generates and returns a unique user ping ID from a phrase and a ping scope.
+func newPingID(phrase string, scope pb.PingScope) string {
+	sequence := atomic.AddUint64(&pingSequence, 1)
+	return fmt.Sprintf("userping-%v-%v-%v-%v", phrase, tools.CurrentISOtime(), sequence, pingScopeSuffix(scope))
+}
+
+// A function that generates and returns the control node command for a ping.
+// Requires the scope and type of the ping, the node ID for node pings and the ping ID.
+// Control pings read the config of the control node and do not carry a ping ID.
+func newPingCommand(scope pb.PingScope, pingtype pb.PingType, node int64, pingid string) (map[string]string, error) {
+	// Control pings have no type and no ping ID
+	if scope == pb.PingScope_PING_SCOPE_CONTROL {
+		return map[string]string{"command": "readconfig-control"}, nil
+	}
+
+	// Determine the data to read from the type of the ping
+	var reading string
+	switch pingtype {
+	case pb.PingType_PING_TYPE_SENSOR:
+		reading = "readsensors"
+	case pb.PingType_PING_TYPE_CONFIG:
+		reading = "readconfig"
+	default:
+		return nil, fmt.Errorf("unsupported ping type '%v'", pingtype)
+	}
+
+	// Construct the command for the scope of the ping
+	switch scope {
+	case pb.PingScope_PING_SCOPE_MESH:
+		return map[string]string{"command": reading + "-mesh", "ping": pingid}, nil
+	case pb.PingScope_PING_SCOPE_NODE:
+		return map[string]string{"command": reading + "-node", "ping": pingid, "node": strconv.FormatInt(node, 10)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported ping scope '%v'", scope)
+	}
+}
+
+// A function that pings the mesh or a node and calls the send function with a PingResponse for every node
+// response as it arrives, until all the expected nodes have responded or the timeout expires. Always ends
+// with a complete PingResponse that lists the nodes that did not respond. If no nodes are known on the
+// mesh, responses are collected until the timeout. Returns the error of the send function, if any.
+func streamPing(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, scope pb.PingScope, pingtype pb.PingType, node int64, phrase string, timeout time.Duration, send func(*pb.PingResponse) error) error {
+	// Generate a unique ping ID for the ping
+	pingid := newPingID(phrase, scope)
+
+	// Construct the command for the ping
+	command, err := newPingCommand(scope, pingtype, node, pingid)
+	if err != nil {
+		return err
+	}
+
+	// Determine the list of nodes expected to respond
+	var nodelist []int64
+	if scope == pb.PingScope_PING_SCOPE_NODE {
+		nodelist = []int64{node}
+	} else {
+		nodelist = meshorchestrator.GetNodeIDlist()
+	}
+
+	// Register a collector for the ping ID and defer its removal
+	collector, err := meshorchestrator.PingRegistry.Register(pingid, len(nodelist)+pingBufferPadding)
+	if err != nil {
+		return err
+	}
+	defer meshorchestrator.PingRegistry.Unregister(pingid)
+
+	// Send the ping command to the command queue
+	if _, err := meshorchestrator.SendCommand(ctx, command); err != nil {
+		return queueStatus(err)
+	}
+
+	// Create a set of nodes that have not yet responded
+	pending := make(map[int64]bool)
+	for _, node := range nodelist {
+		pending[node] = true
+	}
+
+	// Start the timer for the ping deadline
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+collect:
+	// Iterate until every expected node has responded.
+	for len(pending) > 0 || len(nodelist) == 0 {
+		select {
+		case log := <-collector.Responses:
+			// Construct a PingResponse from the log and send it. A malformed response is
+			// skipped, which leaves its node unanswered unless it responds again.
+			response, err := NewPingResponse(log)
+			if err != nil {
+				meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(failure) ping response skipped | ping - %v | node - %v | error - %v", pingid, log.GetLogmetadata()["node"], err)))
+				continue
+			}
+			delete(pending, response.GetNode())
+			if err := send(response); err != nil {
+				return err
+			}
+
+		case <-timer.C:
+			// The ping deadline has passed
+			break collect
+
+		case <-ctx.Done():
+			// The caller has gone away
+			return ctx.Err()
+		}
+	}
+
+	// Collect and sort the nodes that never responded
+	unanswered := make([]int64, 0, len(pending))
+	for node := range pending {
+		unanswered = append(unanswered, node)
+	}
+	sort.Slice(unanswered, func(i, j int) bool { return unanswered[i] < unanswered[j] })
+
+	// Send the final PingResponse that marks the ping as complete
+	return send(&pb.PingResponse{PingID: pingid, Complete: true, Unanswered: unanswered})
+}
+
+// A function that subscribes to the observer broker and calls the send function
+// for every log that matches the filter until the context is done. A warning log
+// is sent regardless of the filter when logs are dropped for the observer.
+func observeLogs(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, filter *tools.ObserveFilter, send func(tools.Log) error) error {
+	// Subscribe to the observer broker and unsubscribe when the stream ends.
+	subscription := meshorchestrator.ObserverBroker.Subscribe()
+	defer meshorchestrator.ObserverBroker.Unsubscribe(subscription)
+
+	// Declare a count of the dropped logs that have been reported to the observer
+	var reported uint64
+
+	for {
+		select {
+		case <-ctx.Done():
+			// The observer has disconnected or cancelled the stream.
+			return nil
+
+		case <-subscription.Notify:
+			// Drain the logs buffered for this observer
+			logs, dropped := subscription.Drain()
+
+			// Notify the observer if any logs were dropped since the last report
+			if dropped > reported {
+				notice := tools.NewOrchServerlog(fmt.Sprintf("(observer) %v logs were dropped because the observer fell behind", dropped-reported))
+				notice.Logmetadata["severity"] = tools.SeverityName(tools.SeverityWarning)
+				if err := send(notice); err != nil {
+					return err
+				}
+				reported = dropped
+			}
+
+			// Send the drained logs that match the filter
+			for _, log := range logs {
+				if !filter.Match(log) {
+					continue
+				}
+				if err := send(log); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// A function that records the logs received from the LINK server until the context is done.
+// Every log is sent with its arrival time and the number of logs dropped from the recording
+// so far, since a recorder that falls behind the LINK stream loses the oldest logs.
+func recordLogs(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, send func(*tools.RecordedLog, uint64) error) error {
+	// Subscribe to the LINK tap and unsubscribe when the recording ends.
+	subscription := meshorchestrator.LinkTap.Subscribe()
+	defer meshorchestrator.LinkTap.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-ctx.Done():
+			// The recording has ended or the recorder has disconnected.
+			return nil
+
+		case <-subscription.Notify:
+			// Drain the logs buffered for this recorder and send them
+			logs, dropped := subscription.Drain()
+			for _, log := range logs {
+				if err := send(log.(*tools.RecordedLog), dropped); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A slice of the components that the orchestrator cannot serve without.
+// The overall health of the ORCH server is NOT_SERVING if any of them is unhealthy.
+var criticalComponents = []string{tools.ComponentLINK, tools.ComponentCommandHandler}
+
+// A slice of the names of the gRPC services whose health follows the overall health of the ORCH server.
+var orchestratorServices = []string{"", pb.Orchestrator_ServiceDesc.ServiceName, pb.OrchestratorV2_ServiceDesc.ServiceName}
+
+// A constructor function that generates and returns a gRPC health server that reflects the HealthRegistry
+// of a MeshOrchestrator. Every component is exposed as a health service of the same name, such as 'link',
+// while the overall health and the health of the orchestrator services follows the critical components.
+func NewHealthServer(meshorchestrator *tools.MeshOrchestrator) *health.Server {
+	// Create a health server
+	healthserver := health.NewServer()
+
+	// Watch the health registry and update the serving status of the health server
+	meshorchestrator.Health.Watch(func(componenthealth tools.ComponentHealth) {
+		// Set the serving status of the component
+		healthserver.SetServingStatus(componenthealth.Component, servingStatus(componenthealth.Healthy))
+
+		// Set the overall serving status from the critical components
+		serving := true
+		for _, component := range criticalComponents {
+			if componenthealth, ok := meshorchestrator.Health.Get(component); !ok || !componenthealth.Healthy {
+				serving = false
+			}
+		}
+		for _, service := range orchestratorServices {
+			healthserver.SetServingStatus(service, servingStatus(serving))
+		}
+	})
+
+	// Return the health server
+	return healthserver
+}
+
+// A function that returns the gRPC health serving status for a bool of health.
+func servingStatus(healthy bool) healthpb.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,219 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A struct that defines the lifecycle of the orchestrator. It holds the root context of the
+// orchestrator, which is cancelled on SIGINT or SIGTERM, and tracks the workers that run until
+// the root context is done, such as the scheduler, the LINK supervisor and the cloud connector.
+type Lifecycle struct {
+	// The root context of the orchestrator
+	ctx context.Context
+	// A function that cancels the root context
+	cancel context.CancelFunc
+	// The duration that a graceful shutdown may take before it is abandoned
+	timeout time.Duration
+	// A WaitGroup that tracks the workers of the lifecycle
+	workers sync.WaitGroup
+}
+
+// A constructor function that generates and returns a Lifecycle.
+// Requires the duration that a graceful shutdown may take.
+func NewLifecycle(timeout time.Duration) *Lifecycle {
+	// Create a Lifecycle with a new root context
+	lifecycle := Lifecycle{timeout: timeout}
+	lifecycle.ctx, lifecycle.cancel = context.WithCancel(context.Background())
+
+	// Return the lifecycle
+	return &lifecycle
+}
+
+// A method of Lifecycle that returns the root context of the orchestrator.
+func (lifecycle *Lifecycle) Context() context.Context {
+	return lifecycle.ctx
+}
+
+// A method of Lifecycle that cancels the root context of the orchestrator when a SIGINT or SIGTERM
+// is recieved. A second signal exits the orchestrator immediately without waiting for the shutdown.
+func (lifecycle *Lifecycle) NotifySignals() {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		// Cancel the root context on the first signal
+		received := <-signals
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(shutdown) signal recieved | signal - %v | timeout - %v", received, lifecycle.timeout))))
+		lifecycle.cancel()
+
+		// Exit on the second signal
+		received = <-signals
+		fmt.Println(tools.FormatLog(tools.NewOrchServerlog(fmt.Sprintf("(shutdown) signal recieved again, exiting immediately | signal - %v", received))))
+		os.Exit(1)
+	}()
+}
+
+// A method of Lifecycle that runs a worker in the background with the root context.
+// The worker must return once the root context is done.
+func (lifecycle *Lifecycle) Go(worker func(ctx context.Context)) {
+	lifecycle.workers.Add(1)
+	go func() {
+		defer lifecycle.workers.Done()
+		worker(lifecycle.ctx)
+	}()
+}
+
+// A method of Lifecycle that runs a handler of a queue in the background.
+// Returns a channel that is closed once the handler has returned.
+func (lifecycle *Lifecycle) GoHandler(handler func()) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler()
+	}()
+	return done
+}
+
+// A method of Lifecycle that returns a gRPC stream interceptor that ends every
+// stream once the root context is done, so that the observers do not hold up a
+// graceful stop of the server.
+func (lifecycle *Lifecycle) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Create a context for the stream that is also cancelled with the root context
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-lifecycle.ctx.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		// Call the handler with a stream that carries the new context
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// A method of Lifecycle that shuts down the orchestrator once the root context is done. Stops the
+// workers and the simulator, gracefully stops the gRPC server, waits for the background tasks,
+// drains the CommandQueue and the AccumulatorQueue, and flushes the pending MeshPings and the
+// mesh document. Requires the channels that are closed once the CommandHandler and PingHandler
+// return. Returns an error if the shutdown did not complete before the timeout, in which case
+// the channels of the meshorchestrator must not be closed.
+func (lifecycle *Lifecycle) Shutdown(grpcserver *grpc.Server, healthserver *health.Server, meshorchestrator *tools.MeshOrchestrator, commandhandler <-chan struct{}, pinghandler <-chan struct{}) error {
+	// Create a context that expires after the shutdown timeout
+	ctx, cancel := context.WithTimeout(context.Background(), lifecycle.timeout)
+	defer cancel()
+	meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(shutdown) mesh orchestrator is shutting down | timeout - %v", lifecycle.timeout)))
+
+	// Stop the workers and the simulator
+	lifecycle.cancel()
+	meshorchestrator.Simulator.Stop()
+	if err := waitFor(ctx, "stopping the workers", lifecycle.workers.Wait); err != nil {
+		grpcserver.Stop()
+		return err
+	}
+
+	// Report every service as not serving and stop accepting RPCs, waiting for the pending RPCs to complete.
+	// The server is stopped forcefully if the pending RPCs do not complete before the timeout.
+	healthserver.Shutdown()
+	if err := waitFor(ctx, "stopping the ORCH server", grpcserver.GracefulStop); err != nil {
+		grpcserver.Stop()
+		return err
+	}
+	meshorchestrator.SendLog(tools.NewOrchServerlog("(shutdown) ORCH server has stopped"))
+
+	// Wait for the background tasks of the meshorchestrator
+	if err := waitFor(ctx, "waiting for the background tasks", meshorchestrator.StopTasks); err != nil {
+		return err
+	}
+
+	// Close the CommandQueue and wait for the CommandHandler to write the remaining commands
+	if err := waitFor(ctx, "draining the command queue", func() {
+		meshorchestrator.CloseCommandQueue()
+		<-commandhandler
+	}); err != nil {
+		return err
+	}
+
+	// Close the AccumulatorQueue and wait for the PingHandler to accumulate the remaining pings
+	if err := waitFor(ctx, "draining the accumulator queue", func() {
+		meshorchestrator.CloseAccumulatorQueue()
+		<-pinghandler
+	}); err != nil {
+		return err
+	}
+
+	// Flush the pending meshpings and the mesh document
+	if err := waitFor(ctx, "flushing the mesh", func() {
+		flushed := meshorchestrator.FlushAccumulation()
+		meshorchestrator.SendLog(tools.NewOrchCloudlog(fmt.Sprintf("(shutdown) pending mesh pings flushed | count - %v", flushed)))
+		meshorchestrator.Flush()
+	}); err != nil {
+		return err
+	}
+
+	// Log the commands that remain persisted in the outbox
+	if meshorchestrator.Outbox != nil {
+		meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(shutdown) commands persisted in the outbox | count - %v", len(meshorchestrator.Outbox.List()))))
+	}
+
+	meshorchestrator.SendLog(tools.NewOrchServerlog("(shutdown) mesh orchestrator has stopped"))
+	return nil
+}
+
+// A function that runs a step of the shutdown and waits for it to return.
+// Returns an error if the context is done before the step returns.
+func waitFor(ctx context.Context, step string, run func()) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run()
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown timed out while %v - %v", step, ctx.Err())
+	}
+}
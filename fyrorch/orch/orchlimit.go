@@ -0,0 +1,141 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A set of the full gRPC method names of the ORCH server whose calls send commands to the control node.
+// Calls to these methods are rate limited, while the commands issued by the orchestrator itself, such
+// as the pings of the scheduler, do not pass through the limiter.
+var limitedMethods = map[string]bool{
+	fullMethod(pb.Orchestrator_ServiceDesc, "Connection"): true,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Ping"):       true,
+	fullMethod(pb.Orchestrator_ServiceDesc, "PingStream"): true,
+	fullMethod(pb.Orchestrator_ServiceDesc, "Command"):    true,
+
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "SetConnection"): true,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Ping"):          true,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "PingStream"):    true,
+	fullMethod(pb.OrchestratorV2_ServiceDesc, "Command"):       true,
+}
+
+// A struct that defines the limiter of the calls to the ORCH server that send commands to the control node.
+// Calls that exceed the limits are rejected with a ResourceExhausted status that carries the retry delay.
+type Limiter struct {
+	// The rate limiter of the callers
+	ratelimiter *tools.RateLimiter
+
+	// A function that counts a rejected call
+	rejected func()
+}
+
+// A constructor function that generates and returns a Limiter for the ORCH service from the config.
+// Requires a function that is called for every rejected call.
+func NewLimiter(config tools.Config, rejected func()) *Limiter {
+	return &Limiter{ratelimiter: tools.NewRateLimiter(config.RateLimit), rejected: rejected}
+}
+
+// A function that returns the name under which the calls of the caller of an RPC are limited.
+// Anonymous callers are told apart by the host of their peer address.
+func limitedCaller(ctx context.Context) string {
+	caller := CallerFromContext(ctx)
+	if caller.Method != "anonymous" {
+		return caller.Name
+	}
+
+	// Add the host of the peer to the anonymous caller
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return fmt.Sprintf("%v@%v", caller.Name, host)
+	}
+	return caller.Name
+}
+
+// A method of Limiter that checks whether a call to a method is allowed.
+// Returns a ResourceExhausted status with the retry delay if it is not.
+func (limiter *Limiter) allow(ctx context.Context, method string) error {
+	if !limitedMethods[method] {
+		return nil
+	}
+
+	// Check the limits of the caller
+	caller := limitedCaller(ctx)
+	allowed, delay := limiter.ratelimiter.Allow(caller)
+	if allowed {
+		return nil
+	}
+	limiter.rejected()
+
+	// Round the delay up to the millisecond and attach it as the retry info of the status
+	delay = delay.Truncate(time.Millisecond) + time.Millisecond
+	rejection := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded for caller '%v' - retry after %v", caller, delay))
+	if detailed, err := rejection.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		rejection = detailed
+	}
+	return rejection.Err()
+}
+
+// A method of Limiter that returns a gRPC unary interceptor that limits every call.
+// Must be chained after the interceptor of the Authenticator, which identifies the caller.
+func (limiter *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limiter.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, request)
+	}
+}
+
+// A method of Limiter that returns a gRPC stream interceptor that limits every stream.
+// Must be chained after the interceptor of the Authenticator, which identifies the caller.
+func (limiter *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limiter.allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
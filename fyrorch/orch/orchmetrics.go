@@ -0,0 +1,73 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// The path on which the metrics of the orchestrator are served.
+const MetricsPath = "/metrics"
+
+// A function that serves the metrics of the orchestrator to Prometheus over HTTP on a listener.
+// The HTTP server is shut down when the context is done. Returns once the server has stopped.
+func ServeMetrics(ctx context.Context, listener net.Listener, meshorchestrator *tools.MeshOrchestrator) {
+	// Create the HTTP server with the handler of the metrics registry
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(meshorchestrator.Metrics.Registry, promhttp.HandlerOpts{}))
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: time.Second * 10}
+
+	// Shut down the server when the context is done
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+
+		shutdownctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		server.Shutdown(shutdownctx)
+	}()
+
+	// Log the beginning of the metrics server
+	meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(startup) metrics server has started | address - %v%v", listener.Addr(), MetricsPath)))
+
+	// Serve the metrics until the server is shut down
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(failure) metrics server has stopped | error - %v", err)))
+	}
+
+	<-stopped
+}
@@ -0,0 +1,131 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that writes the commands in the outbox of the meshorchestrator to the LINK server in the
+// order they were added. A command is removed from the outbox once the LINK server acknowledges or
+// rejects it. If the LINK server cannot be reached, the outbox is paused with the same backoff as the
+// LINK read stream, until the next attempt or until the LINK read stream reopens. Commands that expire
+// in the outbox are dropped. Returns when the context is done, leaving the pending commands persisted.
+func OutboxHandler(ctx context.Context, linkclient pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator) {
+	// Log the beginning of the outbox handler
+	outbox := meshorchestrator.Outbox
+	meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(startup) outbox handler has started | pending - %v", len(outbox.List()))))
+
+	// Create a random source for the jitter of the backoff
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	// The number of the next attempt for the backoff. Reset once a command is written.
+	attempt := 1
+
+	for {
+		// Drop the commands that have expired
+		expired, err := outbox.Expire(time.Now())
+		if err != nil {
+			meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(failure) outbox could not be persisted | error - %v", err)))
+		}
+		for _, entry := range expired {
+			meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandExpired, fmt.Errorf("expired in the outbox after %v attempts", entry.Attempts))
+			meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(expired) command dropped from the outbox | command - %v | cmdid - %v | attempts - %v", entry.Command["command"], entry.CommandID, entry.Attempts)))
+		}
+
+		// Write the oldest command if the outbox is not paused
+		entry, due, wait := outbox.Next(time.Now())
+		if due {
+			// Mark the command as sent and write it to the LINK
+			meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandSent, nil)
+			err := writeCommand(linkclient, meshorchestrator, entry.CopyCommand())
+
+			switch {
+			case err == nil:
+				// Remove the command and mark it as acked
+				attempt = 1
+				outbox.Remove(entry.CommandID)
+				meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandAcked, nil)
+
+			case errors.Is(err, errLINKRejected):
+				// Remove the command that the LINK rejected and mark it as failed
+				attempt = 1
+				outbox.Remove(entry.CommandID)
+				meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandFailed, err)
+
+			default:
+				// Keep the command and pause the outbox for the backoff
+				delay := linkBackoff(attempt, random)
+				attempt++
+				attempts, _ := outbox.Retry(entry.CommandID, err, time.Now().Add(delay))
+				meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandRetrying, err)
+				meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(retry) command held in the outbox | cmdid - %v | attempts - %v | retry in - %v", entry.CommandID, attempts, delay.Round(time.Millisecond))))
+			}
+			continue
+		}
+
+		// Wait for a new command, the next attempt or expiry, or the context to be done
+		var timer <-chan time.Time
+		if wait > 0 {
+			timer = time.After(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-outbox.Notify:
+		case <-timer:
+		}
+	}
+}
+
+// A constructor function that generates and returns an OutboxEntry proto from a tools.OutboxEntry.
+func NewOutboxEntry(entry tools.OutboxEntry) *pb.OutboxEntry {
+	// Copy the metadata of the command without the command, its ID and its trace context
+	metadata := make(map[string]string)
+	for key, value := range entry.Command {
+		if key != "command" && key != tools.CommandIDKey && key != tools.TraceKey {
+			metadata[key] = value
+		}
+	}
+
+	return &pb.OutboxEntry{
+		CommandID: entry.CommandID,
+		Command:   entry.Command["command"],
+		Metadata:  metadata,
+		Queued:    tools.FormatISOtime(entry.Queued),
+		Expires:   tools.FormatISOtime(entry.Expires),
+		Attempts:  int64(entry.Attempts),
+		LastError: entry.LastError,
+	}
+}
@@ -29,11 +29,16 @@ package orch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	pb "github.com/fyrwatch/fyrmesh/proto"
 	tools "github.com/fyrwatch/fyrmesh/tools"
@@ -53,19 +58,11 @@ func (server *OrchestratorServer) Connection(ctx context.Context, trigger *pb.Tr
 
 	// Check the value of the command message
 	switch triggermessage {
-	case "setconnection-on":
-		// Set the meshconnected value to True
-		server.meshorchestrator.MeshConnected = true
-		// Send a command to the server's command queue
-		command := map[string]string{"command": "connection-on"}
-		server.meshorchestrator.CommandQueue <- command
-
-	case "setconnection-off":
-		// Set the meshconnected value to True
-		server.meshorchestrator.MeshConnected = false
-		// Send a command to the server's command queue
-		command := map[string]string{"command": "connection-off"}
-		server.meshorchestrator.CommandQueue <- command
+	case "setconnection-on", "setconnection-off":
+		// Set the mesh connection on or off
+		if err := setConnection(ctx, server.meshorchestrator, triggermessage == "setconnection-on"); err != nil {
+			return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
+		}
 
 	default:
 		// Default to returning a fail Acknowledge because of an unsupported command message
@@ -92,65 +89,35 @@ func (server *OrchestratorServer) Observe(trigger *pb.Trigger, stream pb.Orchest
 		return nil
 	}
 
-	// Send the signal to enable the observer queue for the log handler.
-	obstoggle := tools.NewObsCommand("enable-observe")
-	server.meshorchestrator.LogQueue <- obstoggle
-
-	// Iterate over the observer channel
-	for log := range server.meshorchestrator.ObserverQueue {
-
-		if sourcefilter == "" && typefilter == "" {
-			// If both filters are not set - send all logs recieved on the channel to the stream.
-			if err := stream.Send(&pb.SimpleLog{Message: log.Logmessage}); err != nil {
-				return err
-			}
-
-		} else if sourcefilter != "" && typefilter == "" {
-			// If only source filter is set - check the source of logs recieved on the channel and send on the stream
-			if log.Logsource == sourcefilter {
-				if err := stream.Send(&pb.SimpleLog{Message: log.Logmessage}); err != nil {
-					return err
-				}
-			}
+	// Construct an ObserveFilter for the source and type filters.
+	filter, _ := tools.NewObserveFilter([]string{sourcefilter}, []string{typefilter}, nil, "")
 
-		} else if sourcefilter == "" && typefilter != "" {
-			// If only type filter is set - check the type of logs recieved on the channel and send on the stream
-			if log.Logsource == typefilter {
-				if err := stream.Send(&pb.SimpleLog{Message: log.Logmessage}); err != nil {
-					return err
-				}
-			}
+	// Observe the logs and send them as stringified logs on the stream.
+	return observeLogs(stream.Context(), server.meshorchestrator, filter, func(log tools.Log) error {
+		return stream.Send(&pb.SimpleLog{Message: tools.FormatLog(log)})
+	})
+}
 
-		} else if sourcefilter != "" && typefilter != "" {
-			// If both filters are set - check the type and source of logs receieved on the channel and send on the stream
-			if log.Logsource == sourcefilter && log.Logtype == typefilter {
-				if err := stream.Send(&pb.SimpleLog{Message: log.Logmessage}); err != nil {
-					return err
-				}
-			}
-		}
+// A function that implements the 'ObserveComplex' method of the Orchestrator service.
+// Accepts an ObserveFilter and returns a stream of ComplexLog
+func (server *OrchestratorServer) ObserveComplex(observefilter *pb.ObserveFilter, stream pb.Orchestrator_ObserveComplexServer) error {
+	// Construct an ObserveFilter from the filter spec.
+	filter, err := tools.NewObserveFilter(observefilter.GetSources(), observefilter.GetTypes(), observefilter.GetMetadata(), observefilter.GetSeverity())
+	if err != nil {
+		return fmt.Errorf("invalid observe filter - %v", err)
 	}
 
-	// Send the signal to disable the observer queue for the log handler.
-	obstoggle = tools.NewObsCommand("disable-observe")
-	server.meshorchestrator.LogQueue <- obstoggle
-
-	return nil
+	// Observe the logs and send them as structured logs on the stream.
+	return observeLogs(stream.Context(), server.meshorchestrator, filter, func(log tools.Log) error {
+		return stream.Send(NewComplexLog(log))
+	})
 }
 
 // A function that implements the 'Status' method of the Orchestrator service.
 // Accepts a Message and returns a MeshStatus
 func (server *OrchestratorServer) Status(ctx context.Context, trigger *pb.Trigger) (*pb.MeshOrchStatus, error) {
-	// Return values from the server configuration as a MeshOrchStatus object.
-	return &pb.MeshOrchStatus{
-		Connected:     server.meshorchestrator.MeshConnected,
-		ControllerID:  server.meshorchestrator.ControllerID,
-		ControlnodeID: int64(server.meshorchestrator.Controlnode.NodeID),
-		Nodelist:      &pb.NodeList{Nodes: server.meshorchestrator.GetSimpleNodeList()},
-		MeshSSID:      server.meshorchestrator.Controlnode.MeshSSID,
-		MeshPSWD:      server.meshorchestrator.Controlnode.MeshPSWD,
-		MeshPORT:      int32(server.meshorchestrator.Controlnode.MeshPORT),
-	}, nil
+	// Return the status of the mesh orchestrator. The mesh password is only returned to admins.
+	return redactStatus(ctx, NewMeshOrchStatus(server.meshorchestrator)), nil
 }
 
 // A function that implements the 'Ping' method of the Orchestrator service.
@@ -160,58 +127,88 @@ func (server *OrchestratorServer) Ping(ctx context.Context, trigger *pb.Trigger)
 	triggermessage := trigger.GetTriggermessage()
 	triggermetadata := trigger.GetMetadata()
 
-	// Check the value of the trigger message
-	switch triggermessage {
-	case "ping-sensor-mesh":
-		// Send a command to ping mesh for sensor data to the server's command queue
-		command := map[string]string{"command": "readsensors-mesh", "ping": fmt.Sprintf("userping-%v-mesh", triggermetadata["phrase"])}
-		server.meshorchestrator.CommandQueue <- command
-
-	case "ping-sensor-node":
-		// Send a command to ping a node for sensor data to the server's command queue
-		command := map[string]string{"command": "readsensors-node", "ping": fmt.Sprintf("userping-%v-node", triggermetadata["phrase"]), "node": triggermetadata["node"]}
-		server.meshorchestrator.CommandQueue <- command
-
-	case "ping-config-mesh":
-		// Send a command to ping mesh for config data to the server's command queue
-		command := map[string]string{"command": "readconfig-mesh", "ping": fmt.Sprintf("userping-%v-mesh", triggermetadata["phrase"])}
-		server.meshorchestrator.CommandQueue <- command
+	// Parse the scope and type of the ping from the trigger message
+	scope, pingtype, err := parsePingTrigger(triggermessage)
+	if err != nil {
+		// Return a fail Acknowledge because of an unsupported trigger message.
+		return &pb.Acknowledge{Success: false, Error: "unsupported trigger command"}, nil
+	}
 
-	case "ping-config-node":
-		// Send a command to ping a node for config data to the server's command queue
-		command := map[string]string{"command": "readconfig-node", "ping": fmt.Sprintf("userping-%v-node", triggermetadata["phrase"]), "node": triggermetadata["node"]}
-		server.meshorchestrator.CommandQueue <- command
+	// Parse the node ID from the metadata for node pings
+	var node int64
+	if scope == pb.PingScope_PING_SCOPE_NODE {
+		if node, err = strconv.ParseInt(triggermetadata["node"], 0, 64); err != nil {
+			return &pb.Acknowledge{Success: false, Error: fmt.Sprintf("invalid node ID '%v'", triggermetadata["node"])}, nil
+		}
+	}
 
-	case "ping-control":
-		// Send a command to ping the control node for config data to the server's command queue
-		command := map[string]string{"command": "readconfig-control"}
-		server.meshorchestrator.CommandQueue <- command
+	// Construct the ping command with a ping ID built from the phrase and scope
+	pingid := fmt.Sprintf("userping-%v-%v", triggermetadata["phrase"], pingScopeSuffix(scope))
+	command, err := newPingCommand(scope, pingtype, node, pingid)
+	if err != nil {
+		return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
+	}
 
-	default:
-		// Default to returning a fail Acknowledge because of an unsupported command message.
-		return &pb.Acknowledge{Success: false, Error: "unsupported trigger command"}, nil
+	// Send the ping command to the server's command queue
+	if _, err := server.meshorchestrator.SendCommand(ctx, command); err != nil {
+		return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
 	}
 
 	// Return an success Acknowledge with no error
 	return &pb.Acknowledge{Success: true, Error: "nil"}, nil
 }
 
+// A function that implements the 'PingStream' method of the Orchestrator service.
+// Accepts a Trigger and returns a stream of PingResponse. The responses of every node
+// to the ping are streamed as they arrive until all the expected nodes have responded
+// or the timeout (in seconds) set in the trigger metadata expires. The stream always
+// ends with a complete PingResponse that lists the nodes that did not respond.
+func (server *OrchestratorServer) PingStream(trigger *pb.Trigger, stream pb.Orchestrator_PingStreamServer) error {
+	// Retrieve the trigger message and metadata from the Trigger proto
+	triggermessage := trigger.GetTriggermessage()
+	triggermetadata := trigger.GetMetadata()
+
+	// Parse the timeout from the metadata if it has been set
+	timeout := defaultPingTimeout
+	if value := triggermetadata["timeout"]; value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			return fmt.Errorf("invalid ping timeout '%v'", value)
+		}
+		timeout = time.Second * time.Duration(seconds)
+	}
+
+	// Parse the scope and type of the ping from the trigger message
+	scope, pingtype, err := parsePingTrigger(triggermessage)
+	if err != nil || scope == pb.PingScope_PING_SCOPE_CONTROL {
+		// Return an error because of an unsupported trigger message.
+		return fmt.Errorf("unsupported trigger command")
+	}
+
+	// Parse the node ID from the metadata for node pings
+	var node int64
+	if scope == pb.PingScope_PING_SCOPE_NODE {
+		if node, err = strconv.ParseInt(triggermetadata["node"], 0, 64); err != nil {
+			return fmt.Errorf("invalid node ID '%v'", triggermetadata["node"])
+		}
+	}
+
+	// Stream the responses to the ping
+	return streamPing(stream.Context(), server.meshorchestrator, scope, pingtype, node, triggermetadata["phrase"], timeout, stream.Send)
+}
+
 // A function that implements the 'Command' method of the Orchestrator service.
 // Accepts a ControlCommand and returns an Acknowledge
 func (server *OrchestratorServer) Command(ctx context.Context, controlcommand *pb.ControlCommand) (*pb.Acknowledge, error) {
-	// Retrieve the command message and metadata from the ControlCommand object
-	commandmessage := controlcommand.GetCommand()
-	commandmetadata := controlcommand.GetMetadata()
-
-	// Set the command message as the 'command' key in a new map
-	command := map[string]string{"command": commandmessage}
-	// Collect the metadata values into the same command map
-	for key, value := range commandmetadata {
-		command[key] = value
+	// Validate the command against the command registry
+	if err := server.meshorchestrator.CommandRegistry.Validate(controlcommand.GetCommand(), controlcommand.GetMetadata()); err != nil {
+		return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
 	}
 
-	// Send the command over the CommandQueue
-	server.meshorchestrator.CommandQueue <- command
+	// Send the command message and metadata over the CommandQueue
+	if _, err := dispatchCommand(ctx, server.meshorchestrator, controlcommand.GetCommand(), controlcommand.GetMetadata()); err != nil {
+		return &pb.Acknowledge{Success: false, Error: err.Error()}, nil
+	}
 	// Return an success Acknowledge with no error
 	return &pb.Acknowledge{Success: true, Error: "nil"}, nil
 }
@@ -220,7 +217,8 @@ func (server *OrchestratorServer) Command(ctx context.Context, controlcommand *p
 // Accepts a Trigger and returns a NodeList.
 func (server *OrchestratorServer) Nodelist(ctx context.Context, trigger *pb.Trigger) (*pb.NodeList, error) {
 	// Return the list of nodes currently on the mesh as a NodeList proto
-	return &pb.NodeList{Nodes: server.meshorchestrator.GetSimpleNodeList()}, nil
+	meshstate := server.meshorchestrator.Snapshot()
+	return NewNodeList(&meshstate, server.meshorchestrator.Liveness, server.meshorchestrator.Registry), nil
 }
 
 // A function that implements the 'SchedulerToggle' method of the Orchestrator service.
@@ -232,16 +230,12 @@ func (server *OrchestratorServer) SchedulerToggle(ctx context.Context, trigger *
 	// Check the value of the command message
 	switch triggermessage {
 	case "setscheduler-on":
-		// Set the schedulerOn value to True
-		server.meshorchestrator.SchedulerOn = true
-		// Log the start of the scheduled pinging to the LogQueue
-		server.meshorchestrator.LogQueue <- tools.NewOrchSchedlog("(start) scheduler has started")
+		// Set the scheduler on
+		setScheduler(server.meshorchestrator, true)
 
 	case "setscheduler-off":
-		// Set the schedulerOn value to True
-		server.meshorchestrator.SchedulerOn = false
-		// Log the stop of the scheduled pinging to the LogQueue
-		server.meshorchestrator.LogQueue <- tools.NewOrchSchedlog("(stop) scheduler has stopped")
+		// Set the scheduler off
+		setScheduler(server.meshorchestrator, false)
 
 	default:
 		// Default to returning a fail Acknowledge because of an unsupported trigger message
@@ -252,59 +246,412 @@ func (server *OrchestratorServer) SchedulerToggle(ctx context.Context, trigger *
 	return &pb.Acknowledge{Success: true, Error: "nil"}, nil
 }
 
+// A function that implements the 'Simulate' method of the Orchestrator service.
+// Accepts a Trigger and returns an Acknowledge.
 func (server *OrchestratorServer) Simulate(ctx context.Context, trigger *pb.Trigger) (*pb.Acknowledge, error) {
-	// Activate the orchestrator's simulator
-	server.meshorchestrator.Simulator.SimulationOn = true
-
-	// Start the fire event
-	go server.meshorchestrator.Simulator.StartFireEvent(server.meshorchestrator.LogQueue)
-
+	// Start a fire event on the simulator
+	startSimulation(server.meshorchestrator)
 	// Return an success Acknowledge with no error
 	return &pb.Acknowledge{Success: true, Error: "nil"}, nil
 }
 
+// A function that parses a v1 ping trigger message such as 'ping-sensor-node'
+// into the scope and type of the ping. The 'ping-control' trigger has no type.
+func parsePingTrigger(triggermessage string) (pb.PingScope, pb.PingType, error) {
+	// Check the value of the trigger message
+	switch triggermessage {
+	case "ping-sensor-mesh":
+		return pb.PingScope_PING_SCOPE_MESH, pb.PingType_PING_TYPE_SENSOR, nil
+	case "ping-sensor-node":
+		return pb.PingScope_PING_SCOPE_NODE, pb.PingType_PING_TYPE_SENSOR, nil
+	case "ping-config-mesh":
+		return pb.PingScope_PING_SCOPE_MESH, pb.PingType_PING_TYPE_CONFIG, nil
+	case "ping-config-node":
+		return pb.PingScope_PING_SCOPE_NODE, pb.PingType_PING_TYPE_CONFIG, nil
+	case "ping-control":
+		return pb.PingScope_PING_SCOPE_CONTROL, pb.PingType_PING_TYPE_UNSPECIFIED, nil
+	default:
+		return pb.PingScope_PING_SCOPE_UNSPECIFIED, pb.PingType_PING_TYPE_UNSPECIFIED, fmt.Errorf("unsupported trigger command '%v'", triggermessage)
+	}
+}
+
+// A constructor function that generates and returns a ComplexLog from a Log.
+func NewComplexLog(log tools.Log) *pb.ComplexLog {
+	return &pb.ComplexLog{
+		Logsource:   log.GetLogsource(),
+		Logtype:     log.GetLogtype(),
+		Logtime:     log.GetLogtime(),
+		Logmessage:  log.GetLogmessage(),
+		Logmetadata: log.GetLogmetadata(),
+	}
+}
+
+// A constructor function that generates and returns a CommandCatalog from the CommandSpecs of a CommandRegistry.
+func NewCommandCatalog(registry *tools.CommandRegistry) *pb.CommandCatalog {
+	// Create an empty CommandCatalog
+	catalog := pb.CommandCatalog{}
+
+	// Convert every CommandSpec and its keys into their protos
+	for _, spec := range registry.List() {
+		commandspec := pb.CommandSpec{Command: spec.Command, Description: spec.Description}
+		for _, key := range spec.Keys {
+			commandspec.Keys = append(commandspec.Keys, &pb.CommandKey{Key: key.Key, Type: key.Type, Required: key.Required, Description: key.Description})
+		}
+		catalog.Commands = append(catalog.Commands, &commandspec)
+	}
+
+	// Return the CommandCatalog
+	return &catalog
+}
+
+// A mapping of the states of the CommandTracker to their proto enum values.
+var commandStates = map[tools.CommandState]pb.CommandState{
+	tools.CommandQueued:   pb.CommandState_COMMAND_STATE_QUEUED,
+	tools.CommandSent:     pb.CommandState_COMMAND_STATE_SENT,
+	tools.CommandAcked:    pb.CommandState_COMMAND_STATE_ACKED,
+	tools.CommandFailed:   pb.CommandState_COMMAND_STATE_FAILED,
+	tools.CommandObserved: pb.CommandState_COMMAND_STATE_OBSERVED,
+	tools.CommandRetrying: pb.CommandState_COMMAND_STATE_RETRYING,
+	tools.CommandExpired:  pb.CommandState_COMMAND_STATE_EXPIRED,
+}
+
+// A constructor function that generates and returns a CommandRecord proto from a CommandRecord of the CommandTracker.
+func NewCommandRecord(record tools.CommandRecord) *pb.CommandRecord {
+	return &pb.CommandRecord{
+		CommandID: record.CommandID,
+		Command:   record.Command,
+		Metadata:  record.Metadata,
+		State:     commandStates[record.State],
+		Error:     record.Error,
+		Created:   record.Created,
+		Updated:   record.Updated,
+		Responses: int64(record.Responses),
+	}
+}
+
+// A constructor function that generates and returns a MeshOrchStatus from the current state of a MeshOrchestrator.
+// The orchestrator is reported as degraded if any of its components, such as the LINK or the cloud, is unhealthy.
+func NewMeshOrchStatus(meshorchestrator *tools.MeshOrchestrator) *pb.MeshOrchStatus {
+	// Collect the health of the components of the orchestrator
+	components := make([]*pb.ComponentStatus, 0)
+	degraded := false
+	for _, componenthealth := range meshorchestrator.Health.List() {
+		components = append(components, &pb.ComponentStatus{
+			Component: componenthealth.Component,
+			Healthy:   componenthealth.Healthy,
+			Reason:    componenthealth.Reason,
+			Updated:   componenthealth.Updated,
+		})
+		degraded = degraded || !componenthealth.Healthy
+	}
+
+	// Retrieve the number of documents buffered for the cloud
+	cloudpending, _ := meshorchestrator.Cloud.Pending()
+
+	// Collect the statistics of the queues of the orchestrator
+	queues := make([]*pb.QueueStatus, 0)
+	for _, queuestats := range meshorchestrator.QueueStats() {
+		queues = append(queues, NewQueueStatus(queuestats))
+	}
+
+	// Retrieve a snapshot of the state of the mesh
+	meshstate := meshorchestrator.Snapshot()
+
+	// Return values from the server configuration as a MeshOrchStatus object.
+	return &pb.MeshOrchStatus{
+		Connected:     meshstate.MeshConnected,
+		ControllerID:  meshorchestrator.ControllerID,
+		ControlnodeID: int64(meshstate.Controlnode.NodeID),
+		Nodelist:      NewNodeList(&meshstate, meshorchestrator.Liveness, meshorchestrator.Registry),
+		MeshSSID:      meshstate.Controlnode.MeshSSID,
+		MeshPSWD:      meshstate.Controlnode.MeshPSWD,
+		MeshPORT:      int32(meshstate.Controlnode.MeshPORT),
+		Link:          NewLinkStatus(meshorchestrator.Link.Status()),
+		Components:    components,
+		Degraded:      degraded,
+		CloudPending:  int64(cloudpending),
+		Queues:        queues,
+	}
+}
+
+// A constructor function that generates and returns a QueueStatus proto from a tools.QueueStats.
+func NewQueueStatus(queuestats tools.QueueStats) *pb.QueueStatus {
+	return &pb.QueueStatus{
+		Name:      queuestats.Name,
+		Policy:    queuestats.Policy,
+		Capacity:  int64(queuestats.Capacity),
+		Depth:     int64(queuestats.Depth),
+		HighWater: int64(queuestats.HighWater),
+		Enqueued:  queuestats.Enqueued,
+		Dropped:   queuestats.Dropped,
+		Rejected:  queuestats.Rejected,
+		Deferred:  queuestats.Deferred,
+	}
+}
+
+// A constructor function that generates and returns a NodeList proto from the nodelist of a tools.MeshState, the
+// liveness of every node tracked by the tools.LivenessTracker and the records of the nodes in the tools.NodeRegistry.
+func NewNodeList(meshstate *tools.MeshState, liveness *tools.LivenessTracker, registry *tools.NodeRegistry) *pb.NodeList {
+	nodelist := &pb.NodeList{Nodes: meshstate.GetSimpleNodeList(), Liveness: make(map[int64]*pb.NodeLiveness)}
+	for _, node := range liveness.List() {
+		nodelist.Liveness[node.NodeID] = &pb.NodeLiveness{
+			State:       node.State,
+			LastSeen:    tools.FormatISOtime(node.LastSeen),
+			Since:       tools.FormatISOtime(node.Since),
+			MissedPings: int64(node.MissedPings),
+		}
+	}
+
+	// Describe every node that is on the mesh or has sent its config
+	nodelist.Details = make(map[int64]*pb.NodeInfo)
+	for _, nodeid := range meshstate.NodeIDlist {
+		nodelist.Details[nodeid] = NewNodeInfo(meshstate, liveness, registry, nodeid)
+	}
+	for nodeid := range meshstate.Nodelist {
+		nodelist.Details[nodeid] = NewNodeInfo(meshstate, liveness, registry, nodeid)
+	}
+	return nodelist
+}
+
+// A constructor function that generates and returns a NodeInfo proto for a node from its config and latest
+// sensor data in a tools.MeshState, its liveness tracked by the tools.LivenessTracker and its record in the
+// tools.NodeRegistry. The config fields of a node that has not sent its config yet are left unset and its
+// config string is set to 'unknown'.
+func NewNodeInfo(meshstate *tools.MeshState, liveness *tools.LivenessTracker, registry *tools.NodeRegistry, nodeid int64) *pb.NodeInfo {
+	nodeinfo := &pb.NodeInfo{Node: nodeid, Config: "unknown"}
+
+	// Check if the config of the node is known and assign it
+	if node, ok := meshstate.Nodelist[nodeid]; ok {
+		nodeinfo.SerialBaud = int64(node.SerialBaud)
+		nodeinfo.DhtType = int64(node.DHTtype)
+		nodeinfo.DhtPin = int64(node.DHTpin)
+		nodeinfo.FlmType = int64(node.FLMtype)
+		nodeinfo.FlmPin = int64(node.FLMpin)
+		nodeinfo.GasType = int64(node.GAStype)
+		nodeinfo.GasPin = int64(node.GASpin)
+		nodeinfo.Pinger = node.Pinger
+		nodeinfo.PingerPin = int64(node.Pingerpin)
+		nodeinfo.ConnectPin = int64(node.Connectpin)
+		nodeinfo.Config = node.GetConfigString()
+		nodeinfo.Capabilities = node.GetCapabilities()
+	}
+
+	// Check if any sensor data has been received from the node and assign it
+	if reading, ok := meshstate.Readings[nodeid]; ok {
+		nodeinfo.LastReading = &pb.NodeReading{
+			PingID:          reading.PingID,
+			Time:            reading.Pingtime,
+			Sensordata:      reading.Sensordata,
+			FireProbability: reading.Fireprobability,
+		}
+	}
+
+	// Check if the liveness of the node is tracked and assign it
+	if node, ok := liveness.Get(nodeid); ok {
+		nodeinfo.Liveness = &pb.NodeLiveness{
+			State:       node.State,
+			LastSeen:    tools.FormatISOtime(node.LastSeen),
+			Since:       tools.FormatISOtime(node.Since),
+			MissedPings: int64(node.MissedPings),
+		}
+	}
+
+	// Check if the node has a record in the registry and assign it
+	if record, ok := registry.Get(nodeid); ok {
+		nodeinfo.Record = NewNodeRecord(record)
+	}
+
+	return nodeinfo
+}
+
+// A constructor function that generates and returns a NodeRecord proto from a tools.NodeRecord.
+func NewNodeRecord(record tools.NodeRecord) *pb.NodeRecord {
+	noderecord := &pb.NodeRecord{Node: record.NodeID, Name: record.Name, Labels: record.Labels, Zone: record.Zone}
+	if !record.Updated.IsZero() {
+		noderecord.Updated = tools.FormatISOtime(record.Updated)
+	}
+	if record.Location != nil {
+		noderecord.Location = &pb.NodeLocation{Latitude: record.Location.Latitude, Longitude: record.Location.Longitude}
+	}
+	return noderecord
+}
+
+// A function that converts and returns a NodeRecord proto as a tools.NodeRecord. The update time is not converted.
+func readNodeRecord(noderecord *pb.NodeRecord) tools.NodeRecord {
+	record := tools.NodeRecord{
+		NodeID: noderecord.GetNode(),
+		Name:   strings.TrimSpace(noderecord.GetName()),
+		Labels: noderecord.GetLabels(),
+		Zone:   strings.TrimSpace(noderecord.GetZone()),
+	}
+	if location := noderecord.GetLocation(); location != nil {
+		record.Location = &tools.NodeLocation{Latitude: location.GetLatitude(), Longitude: location.GetLongitude()}
+	}
+	return record
+}
+
+// A constructor function that generates and returns a MeshTopology proto from a tools.Topology and its history.
+func NewMeshTopology(topology *tools.Topology, history []tools.TopologyChange) *pb.MeshTopology {
+	// Convert the links of the topology
+	meshtopology := &pb.MeshTopology{Root: topology.Root, Source: topology.Source}
+	if !topology.Updated.IsZero() {
+		meshtopology.Updated = tools.FormatISOtime(topology.Updated)
+	}
+	for _, link := range topology.Links() {
+		meshtopology.Links = append(meshtopology.Links, &pb.TopologyLink{Node: link.NodeID, Parent: link.Parent})
+	}
+
+	// Convert the changes of the topology
+	for _, change := range history {
+		topologychange := &pb.TopologyChange{
+			Time:   tools.FormatISOtime(change.Time),
+			Source: change.Source,
+			Joined: change.Joined,
+			Left:   change.Left,
+		}
+		for _, link := range change.Moved {
+			topologychange.Moved = append(topologychange.Moved, &pb.TopologyLink{Node: link.NodeID, Parent: link.Parent})
+		}
+		meshtopology.History = append(meshtopology.History, topologychange)
+	}
+
+	return meshtopology
+}
+
+// A constructor function that generates and returns a LinkStatus proto from a tools.LinkStatus.
+func NewLinkStatus(linkstatus tools.LinkStatus) *pb.LinkStatus {
+	return &pb.LinkStatus{
+		State:           linkstatus.State.String(),
+		Since:           linkstatus.Since,
+		Reconnects:      int64(linkstatus.Reconnects),
+		Attempts:        int64(linkstatus.Attempts),
+		NextRetry:       linkstatus.NextRetry,
+		LastError:       linkstatus.LastError,
+		OutageStart:     linkstatus.OutageStart,
+		LastOutageStart: linkstatus.LastOutageStart,
+		LastOutageEnd:   linkstatus.LastOutageEnd,
+	}
+}
+
+// A constructor function that generates and returns a PingResponse from a Log of type 'sensordata' or 'configdata'.
+// The sensor readings of a sensordata log are parsed into floats and the config of a configdata log is deserialized.
+// Returns an error if the log is malformed, such as a response of a node with truncated sensor readings.
+func NewPingResponse(log tools.Log) (*pb.PingResponse, error) {
+	// Check the log before parsing its metadata
+	if err := tools.ValidateLog(log); err != nil {
+		return nil, fmt.Errorf("malformed ping response - %v", err)
+	}
+
+	// Retrieve the metadata of the log
+	metadata := log.GetLogmetadata()
+
+	// Create a PingResponse with the values common to both log types
+	response := pb.PingResponse{}
+	response.PingID = metadata["ping"]
+	response.Node, _ = strconv.ParseInt(metadata["node"], 0, 64)
+	response.Pingtype = log.GetLogtype()
+	response.Pingtime = log.GetLogtime()
+
+	// Check the type of the log and assign the data
+	switch log.GetLogtype() {
+	case "sensordata":
+		// Parse each sensor reading into a float
+		response.Sensordata = make(map[string]float64)
+		for sensortype, sensorvalue := range tools.Deepdeserialize(metadata["sensors"]) {
+			response.Sensordata[sensortype], _ = strconv.ParseFloat(sensorvalue, 64)
+		}
+
+	case "configdata":
+		// Assign the deserialized config
+		response.Configdata = tools.Deepdeserialize(metadata["config"])
+	}
+
+	// Return the PingResponse
+	return &response, nil
+}
+
 // A function that handles the output of the commands recieved over a given command queue
 // by passing each recieved command to function that calls the the 'Write' method of the
-// interface LINK server. Iterates infinitely until the commandqueue is closed.
+// interface LINK server. Iterates infinitely until the commandqueue is closed. If the
+// outbox is enabled, the commands are added to the outbox instead and written to the
+// LINK server by the OutboxHandler.
 func CommandHandler(linkclient pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator) {
 	// Log the beginning of the command handler
-	meshorchestrator.LogQueue <- tools.NewOrchSchedlog("(startup) command handler has started")
+	meshorchestrator.SendLog(tools.NewOrchSchedlog("(startup) command handler has started"))
+	// Mark the command handler as healthy until the command queue closes
+	meshorchestrator.Health.Set(tools.ComponentCommandHandler, true, "running")
+	defer meshorchestrator.Health.Set(tools.ComponentCommandHandler, false, "command queue closed")
 
 	for command := range meshorchestrator.CommandQueue {
-		Call_LINK_Write(linkclient, meshorchestrator.LogQueue, command)
+		commandid := command[tools.CommandIDKey]
+
+		// Add the command to the outbox if it is enabled. The command is only marked as failed if it
+		// was not queued, a command that was queued but not persisted is still written to the LINK.
+		if meshorchestrator.Outbox != nil {
+			_, err := meshorchestrator.Outbox.Add(command)
+			switch {
+			case err == nil:
+			case errors.Is(err, tools.ErrOutboxPersist):
+				meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(failure) command was queued but the outbox could not be persisted | cmdid - %v | error - %v", commandid, err)))
+			default:
+				meshorchestrator.CommandTracker.Update(commandid, tools.CommandFailed, err)
+				meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(failure) command could not be added to the outbox | cmdid - %v | error - %v", commandid, err)))
+			}
+			continue
+		}
+
+		// Mark the command as sent and write it to the LINK
+		meshorchestrator.CommandTracker.Update(commandid, tools.CommandSent, nil)
+
+		// Mark the command as acked or failed from the outcome of the write
+		if err := writeCommand(linkclient, meshorchestrator, command); err != nil {
+			meshorchestrator.CommandTracker.Update(commandid, tools.CommandFailed, err)
+		} else {
+			meshorchestrator.CommandTracker.Update(commandid, tools.CommandAcked, nil)
+		}
 	}
 }
 
 // A function that handles the scheduled pinging of the message at a regualar interval
 // The interval is defined in the config file as an integer number of seconds.
 // The scheduler waits 15s before starting the pings to give time for the mesh and
-// orchestrator to initialize when the service first starts.
-func Scheduler(meshorchestrator *tools.MeshOrchestrator, pingrate int) {
+// orchestrator to initialize when the service first starts. Returns when the context is done.
+func Scheduler(ctx context.Context, meshorchestrator *tools.MeshOrchestrator, pingrate int) {
 	// Sleep for 15s to give time for other orchestrator services to initialize
-	time.Sleep(time.Second * 15)
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(time.Second * 15):
+	}
 	// Log the beginning of the scheduled pinging to the LogQueue
-	meshorchestrator.LogQueue <- tools.NewOrchSchedlog(fmt.Sprintf("(startup) scheduler has started | pingrate - %v", pingrate))
+	meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(startup) scheduler has started | pingrate - %v", pingrate)))
 
 	for {
-		if meshorchestrator.SchedulerOn {
+		if meshorchestrator.GetSchedulerOn() {
 			// Generate a ping ID and command to ping the mesh for sensors and push it to the commandQueue
 			pingid := fmt.Sprintf("controlping-scheduler-%v-mesh", tools.CurrentISOtime())
 			command := map[string]string{"command": "readsensors-mesh", "ping": pingid}
-			meshorchestrator.CommandQueue <- command
+			meshorchestrator.SendInternalCommand(command)
 
 			// Log the scheduled ping with the ping ID.
-			meshorchestrator.LogQueue <- tools.NewOrchSchedlog(fmt.Sprintf("(ping) mesh pinged for sensordata | pingID -  %v", pingid))
+			meshorchestrator.SendLog(tools.NewOrchSchedlog(fmt.Sprintf("(ping) mesh pinged for sensordata | pingID -  %v", pingid)))
 		}
 
-		// Sleep for the pingrate number of seconds.
-		time.Sleep(time.Second * time.Duration(pingrate))
+		// Sleep for the pingrate number of seconds or stop if the context is done.
+		select {
+		case <-ctx.Done():
+			meshorchestrator.SendLog(tools.NewOrchSchedlog("(shutdown) scheduler has stopped"))
+			return
+		case <-time.After(time.Second * time.Duration(pingrate)):
+		}
 	}
 }
 
 // A function that creates the gRPC server for the Orchestrator ORCH service
 // and sets it to listen on the appropriate port. Starts a go routine to check
-// the server's command queue
-func Start_ORCH_Server(linkclient pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator) error {
+// the server's command queue. Serves until the root context of the lifecycle
+// is done and then shuts down the orchestrator within the shutdown timeout.
+func Start_ORCH_Server(lifecycle *Lifecycle, linkclient pb.InterfaceClient, meshorchestrator *tools.MeshOrchestrator) error {
 	// Read the config file
 	config, err := tools.ReadConfig()
 	if err != nil {
@@ -319,27 +666,99 @@ func Start_ORCH_Server(linkclient pb.InterfaceClient, meshorchestrator *tools.Me
 		return fmt.Errorf("could not set up listener on the port tcp%v - %v", port, err)
 	}
 
-	// Create the gRPC server and register it with the commandqueue, observerqueue and the logqueue
-	grpcserver := grpc.NewServer()
+	// Setup the listener of the metrics server if it is enabled
+	var metricslistener net.Listener
+	if config.Metrics.Address != "" {
+		if metricslistener, err = net.Listen("tcp", config.Metrics.Address); err != nil {
+			return fmt.Errorf("could not set up metrics listener on the address tcp %v - %v", config.Metrics.Address, err)
+		}
+	}
+
+	// Construct the transport credentials for the ORCH server
+	options, err := tools.NewServerCredentials(config, "ORCH")
+	if err != nil {
+		return fmt.Errorf("could not load TLS credentials - %v", err)
+	}
+
+	// Construct the authenticator
+	authenticator, err := NewAuthenticator(config)
+	if err != nil {
+		return fmt.Errorf("could not set up authentication - %v", err)
+	}
+
+	// Open the audit log and construct the auditor
+	auditlog, err := tools.NewAuditLog(tools.DefaultAuditMaxSize, tools.DefaultAuditMaxFiles)
+	if err != nil {
+		return fmt.Errorf("could not open audit log - %v", err)
+	}
+	defer auditlog.Close()
+	auditor := NewAuditor(auditlog, meshorchestrator.SendLog)
+
+	// Construct the limiter of the calls that send commands to the control node
+	limiter := NewLimiter(config, meshorchestrator.Metrics.CallRateLimited)
+
+	// Add the interceptors of the tracing, the lifecycle, the auditor, the authenticator and the limiter to the server options
+	options = append(options, tools.TraceServerOptions()...)
+	options = append(options,
+		grpc.ChainUnaryInterceptor(auditor.UnaryInterceptor(), authenticator.UnaryInterceptor(), limiter.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(lifecycle.StreamInterceptor(), auditor.StreamInterceptor(), authenticator.StreamInterceptor(), limiter.StreamInterceptor()),
+	)
+
+	// Create the gRPC server and register the v1 and v2 orchestrator services with the meshorchestrator
+	grpcserver := grpc.NewServer(options...)
 	pb.RegisterOrchestratorServer(grpcserver, &OrchestratorServer{meshorchestrator: meshorchestrator})
+	pb.RegisterOrchestratorV2Server(grpcserver, &OrchestratorV2Server{meshorchestrator: meshorchestrator, auditlog: auditlog})
+	// Register the health service with the health of the orchestrator components and the reflection service
+	healthserver := NewHealthServer(meshorchestrator)
+	healthpb.RegisterHealthServer(grpcserver, healthserver)
+	reflection.Register(grpcserver)
 
 	// Start a go-routine to check the server's command queue and push them to LINK server.
-	go CommandHandler(linkclient, meshorchestrator)
+	commandhandler := lifecycle.GoHandler(func() { CommandHandler(linkclient, meshorchestrator) })
 
 	// Start a go-routine to check the servers's accumulation queue and handle the recieved pings.
-	go tools.PingHandler(meshorchestrator)
+	pinghandler := lifecycle.GoHandler(func() { tools.PingHandler(meshorchestrator) })
 
 	// Start a go-routine to send scheduled pings to the mesh
-	go Scheduler(meshorchestrator, config.SchedulerPingRate)
+	lifecycle.Go(func(ctx context.Context) { Scheduler(ctx, meshorchestrator, config.SchedulerPingRate) })
+
+	// Start a go-routine to connect to the cloud if the orchestrator started without it
+	lifecycle.Go(func(ctx context.Context) {
+		tools.CloudConnector(ctx, meshorchestrator, tools.DefaultCloudRetryInterval)
+	})
+
+	// Start a go-routine to check the liveness of the nodes on the mesh
+	lifecycle.Go(func(ctx context.Context) {
+		tools.LivenessMonitor(ctx, meshorchestrator, tools.DefaultLivenessInterval)
+	})
+
+	// Start a go-routine to write the commands in the outbox to the LINK server if it is enabled
+	if meshorchestrator.Outbox != nil {
+		lifecycle.Go(func(ctx context.Context) { OutboxHandler(ctx, linkclient, meshorchestrator) })
+	}
 
-	// Call the Initialize method the meshorchestrator to configure the node list and control node fields.
-	meshorchestrator.Initialize()
-	meshorchestrator.LogQueue <- tools.NewOrchServerlog("(startup) mesh orchestrator has started")
+	// Start a go-routine to serve the metrics of the orchestrator if they are enabled
+	if metricslistener != nil {
+		lifecycle.Go(func(ctx context.Context) { ServeMetrics(ctx, metricslistener, meshorchestrator) })
+	}
+
+	// The meshorchestrator is initialized by the LINK supervisor once the read stream opens.
+	meshorchestrator.SendLog(tools.NewOrchServerlog("(startup) mesh orchestrator has started"))
+
+	// Serve the gRPC server on the listener port in the background
+	served := make(chan error, 1)
+	go func() {
+		served <- grpcserver.Serve(listener)
+	}()
 
-	// Serve the gRPC server on the listener port
-	if err := grpcserver.Serve(listener); err != nil {
+	// Wait for the root context to be done or the server to fail
+	select {
+	case err := <-served:
+		lifecycle.cancel()
 		return fmt.Errorf("could not start the server - %v", err)
-	} else {
-		return nil
+	case <-lifecycle.Context().Done():
 	}
+
+	// Shut down the orchestrator
+	return lifecycle.Shutdown(grpcserver, healthserver, meshorchestrator, commandhandler, pinghandler)
 }
@@ -0,0 +1,577 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh FyrORCH gopkg orch
+===========================================================================
+*/
+package orch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/fyrwatch/fyrmesh/proto"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A struct that defines the OrchestratorV2 gRPC Server.
+// The v2 service accepts typed requests and reports failures with gRPC status codes.
+type OrchestratorV2Server struct {
+	pb.UnimplementedOrchestratorV2Server
+	meshorchestrator *tools.MeshOrchestrator
+	auditlog         *tools.AuditLog
+}
+
+// A function that implements the 'Status' method of the OrchestratorV2 service.
+// Accepts a StatusRequest and returns a MeshOrchStatus
+func (server *OrchestratorV2Server) Status(ctx context.Context, request *pb.StatusRequest) (*pb.MeshOrchStatus, error) {
+	// Return the status of the mesh orchestrator. The mesh password is only returned to admins.
+	return redactStatus(ctx, NewMeshOrchStatus(server.meshorchestrator)), nil
+}
+
+// A function that implements the 'SetConnection' method of the OrchestratorV2 service.
+// Accepts a ConnectionRequest and returns a ConnectionResponse
+func (server *OrchestratorV2Server) SetConnection(ctx context.Context, request *pb.ConnectionRequest) (*pb.ConnectionResponse, error) {
+	// Set the mesh connection state
+	if err := setConnection(ctx, server.meshorchestrator, request.GetConnected()); err != nil {
+		return nil, queueStatus(err)
+	}
+	// Return the new connection state
+	return &pb.ConnectionResponse{Connected: server.meshorchestrator.GetMeshConnected()}, nil
+}
+
+// A function that implements the 'Observe' method of the OrchestratorV2 service.
+// Accepts an ObserveFilter and returns a stream of ComplexLog
+func (server *OrchestratorV2Server) Observe(observefilter *pb.ObserveFilter, stream pb.OrchestratorV2_ObserveServer) error {
+	// Construct an ObserveFilter from the filter spec.
+	filter, err := tools.NewObserveFilter(observefilter.GetSources(), observefilter.GetTypes(), observefilter.GetMetadata(), observefilter.GetSeverity())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid observe filter - %v", err)
+	}
+
+	// Observe the logs and send them as structured logs on the stream.
+	return observeLogs(stream.Context(), server.meshorchestrator, filter, func(log tools.Log) error {
+		return stream.Send(NewComplexLog(log))
+	})
+}
+
+// A function that implements the 'Ping' method of the OrchestratorV2 service.
+// Accepts a PingRequest and returns a PingReceipt with the ID of the ping.
+// The responses of the nodes are not collected and appear in the logs.
+func (server *OrchestratorV2Server) Ping(ctx context.Context, request *pb.PingRequest) (*pb.PingReceipt, error) {
+	// Check the ping request
+	if err := checkPingRequest(request); err != nil {
+		return nil, err
+	}
+
+	// Construct the ping command with a unique ping ID
+	pingid := ""
+	if request.GetScope() != pb.PingScope_PING_SCOPE_CONTROL {
+		pingid = newPingID(request.GetPhrase(), request.GetScope())
+	}
+	command, err := newPingCommand(request.GetScope(), request.GetType(), request.GetNode(), pingid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Send the ping command to the server's command queue
+	if _, err := server.meshorchestrator.SendCommand(ctx, command); err != nil {
+		return nil, queueStatus(err)
+	}
+	// Return the ping ID
+	return &pb.PingReceipt{PingID: pingid}, nil
+}
+
+// A function that implements the 'PingStream' method of the OrchestratorV2 service.
+// Accepts a PingRequest and returns a stream of PingResponse. The responses of every
+// node are streamed as they arrive until all the expected nodes have responded or the
+// timeout expires. The stream always ends with a complete PingResponse.
+func (server *OrchestratorV2Server) PingStream(request *pb.PingRequest, stream pb.OrchestratorV2_PingStreamServer) error {
+	// Check the ping request
+	if err := checkPingRequest(request); err != nil {
+		return err
+	}
+
+	// The control node responds without a ping ID and cannot be streamed
+	if request.GetScope() == pb.PingScope_PING_SCOPE_CONTROL {
+		return status.Error(codes.InvalidArgument, "control pings cannot be streamed")
+	}
+
+	// Use the default timeout if one has not been set
+	timeout := defaultPingTimeout
+	if request.GetTimeout().AsDuration() > 0 {
+		timeout = request.GetTimeout().AsDuration()
+	}
+
+	// Stream the responses to the ping
+	err := streamPing(stream.Context(), server.meshorchestrator, request.GetScope(), request.GetType(), request.GetNode(), request.GetPhrase(), timeout, stream.Send)
+	// Wrap any error that is not already a status or caused by the caller going away
+	if _, ok := status.FromError(err); !ok && stream.Context().Err() == nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return err
+}
+
+// A function that implements the 'Nodelist' method of the OrchestratorV2 service.
+// Accepts a NodelistRequest and returns a NodeList.
+func (server *OrchestratorV2Server) Nodelist(ctx context.Context, request *pb.NodelistRequest) (*pb.NodeList, error) {
+	// Return the list of nodes currently on the mesh as a NodeList proto
+	meshstate := server.meshorchestrator.Snapshot()
+	return NewNodeList(&meshstate, server.meshorchestrator.Liveness, server.meshorchestrator.Registry), nil
+}
+
+// A function that implements the 'Command' method of the OrchestratorV2 service.
+// Accepts a CommandRequest and returns a CommandResponse
+func (server *OrchestratorV2Server) Command(ctx context.Context, request *pb.CommandRequest) (*pb.CommandResponse, error) {
+	// Validate the command against the command registry
+	if err := server.meshorchestrator.CommandRegistry.Validate(request.GetCommand(), request.GetMetadata()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Send the command message and metadata over the CommandQueue
+	commandid, err := dispatchCommand(ctx, server.meshorchestrator, request.GetCommand(), request.GetMetadata())
+	if err != nil {
+		return nil, queueStatus(err)
+	}
+	// Return the command that was sent and its ID
+	return &pb.CommandResponse{Command: request.GetCommand(), CommandID: commandid}, nil
+}
+
+// A function that implements the 'CommandStatus' method of the OrchestratorV2 service.
+// Accepts a CommandStatusRequest and returns the CommandRecord of the command
+func (server *OrchestratorV2Server) CommandStatus(ctx context.Context, request *pb.CommandStatusRequest) (*pb.CommandRecord, error) {
+	// Retrieve the record of the command
+	record, ok := server.meshorchestrator.CommandTracker.Get(request.GetCommandID())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no command with the ID '%v' is being tracked", request.GetCommandID())
+	}
+
+	// Return the record
+	return NewCommandRecord(record), nil
+}
+
+// A function that implements the 'ListCommands' method of the OrchestratorV2 service.
+// Accepts a ListCommandsRequest and returns the most recent CommandRecords, newest first
+func (server *OrchestratorV2Server) ListCommands(ctx context.Context, request *pb.ListCommandsRequest) (*pb.CommandRecordList, error) {
+	// Find the tracker state that corresponds to the requested state
+	var state tools.CommandState
+	if request.GetState() != pb.CommandState_COMMAND_STATE_UNSPECIFIED {
+		for trackerstate, protostate := range commandStates {
+			if protostate == request.GetState() {
+				state = trackerstate
+			}
+		}
+		if state == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown command state '%v'", request.GetState())
+		}
+	}
+
+	// Convert the records into their protos
+	recordlist := pb.CommandRecordList{}
+	for _, record := range server.meshorchestrator.CommandTracker.List(state, int(request.GetLimit())) {
+		recordlist.Commands = append(recordlist.Commands, NewCommandRecord(record))
+	}
+
+	// Return the list of records
+	return &recordlist, nil
+}
+
+// A function that implements the 'DescribeCommands' method of the OrchestratorV2 service.
+// Accepts a DescribeCommandsRequest and returns a CommandCatalog of the registered commands
+func (server *OrchestratorV2Server) DescribeCommands(ctx context.Context, request *pb.DescribeCommandsRequest) (*pb.CommandCatalog, error) {
+	// Return the commands in the command registry
+	return NewCommandCatalog(server.meshorchestrator.CommandRegistry), nil
+}
+
+// A function that implements the 'SetScheduler' method of the OrchestratorV2 service.
+// Accepts a SchedulerRequest and returns a SchedulerResponse
+func (server *OrchestratorV2Server) SetScheduler(ctx context.Context, request *pb.SchedulerRequest) (*pb.SchedulerResponse, error) {
+	// Set the scheduler state
+	setScheduler(server.meshorchestrator, request.GetEnabled())
+	// Return the new scheduler state
+	return &pb.SchedulerResponse{Enabled: server.meshorchestrator.GetSchedulerOn()}, nil
+}
+
+// A function that implements the 'Simulate' method of the OrchestratorV2 service.
+// Accepts a SimulateRequest and returns a SimulateResponse
+func (server *OrchestratorV2Server) Simulate(ctx context.Context, request *pb.SimulateRequest) (*pb.SimulateResponse, error) {
+	// Start a fire event on the simulator
+	startSimulation(server.meshorchestrator)
+	// Return an empty response
+	return &pb.SimulateResponse{}, nil
+}
+
+// A function that implements the 'QueryAudit' method of the OrchestratorV2 service.
+// Accepts an AuditQuery and returns an AuditEntryList with the matching entries, newest first.
+func (server *OrchestratorV2Server) QueryAudit(ctx context.Context, request *pb.AuditQuery) (*pb.AuditEntryList, error) {
+	// Construct the query from the request
+	query := tools.AuditQuery{
+		Since:   request.GetSince(),
+		Until:   request.GetUntil(),
+		Actions: request.GetActions(),
+		Caller:  request.GetCaller(),
+		Limit:   int(request.GetLimit()),
+	}
+	if query.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit '%v'", request.GetLimit())
+	}
+
+	// Query the audit log
+	entries, err := server.auditlog.Query(query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "audit log could not be queried - %v", err)
+	}
+
+	// Convert the entries and return them
+	entrylist := &pb.AuditEntryList{Entries: make([]*pb.AuditEntry, 0, len(entries))}
+	for _, entry := range entries {
+		entrylist.Entries = append(entrylist.Entries, NewAuditEntry(entry))
+	}
+	return entrylist, nil
+}
+
+// A function that implements the 'ListOutbox' method of the OrchestratorV2 service.
+// Accepts a ListOutboxRequest and returns an OutboxEntryList with the pending commands, oldest first.
+func (server *OrchestratorV2Server) ListOutbox(ctx context.Context, request *pb.ListOutboxRequest) (*pb.OutboxEntryList, error) {
+	// Check if the outbox is enabled
+	outbox := server.meshorchestrator.Outbox
+	if outbox == nil {
+		return nil, status.Error(codes.FailedPrecondition, "command outbox is not enabled")
+	}
+
+	// Convert the entries and return them
+	entries := outbox.List()
+	entrylist := &pb.OutboxEntryList{Entries: make([]*pb.OutboxEntry, 0, len(entries))}
+	for _, entry := range entries {
+		entrylist.Entries = append(entrylist.Entries, NewOutboxEntry(entry))
+	}
+	return entrylist, nil
+}
+
+// A function that implements the 'PurgeOutbox' method of the OrchestratorV2 service.
+// Accepts a PurgeOutboxRequest and returns a PurgeOutboxResponse with the IDs of the purged commands.
+func (server *OrchestratorV2Server) PurgeOutbox(ctx context.Context, request *pb.PurgeOutboxRequest) (*pb.PurgeOutboxResponse, error) {
+	// Check if the outbox is enabled
+	outbox := server.meshorchestrator.Outbox
+	if outbox == nil {
+		return nil, status.Error(codes.FailedPrecondition, "command outbox is not enabled")
+	}
+
+	// Check that the request selects the commands to purge
+	cmdids := request.GetCommandIDs()
+	if request.GetAll() {
+		cmdids = nil
+	} else if len(cmdids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no command IDs to purge and 'all' is not set")
+	}
+
+	// Purge the commands from the outbox
+	purged, err := outbox.Purge(cmdids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "outbox could not be persisted - %v", err)
+	}
+
+	// Mark the purged commands as failed and return their IDs
+	response := &pb.PurgeOutboxResponse{CommandIDs: make([]string, 0, len(purged))}
+	for _, entry := range purged {
+		server.meshorchestrator.CommandTracker.Update(entry.CommandID, tools.CommandFailed, errors.New("purged from the outbox"))
+		response.CommandIDs = append(response.CommandIDs, entry.CommandID)
+	}
+	return response, nil
+}
+
+// A function that implements the 'Record' method of the OrchestratorV2 service.
+// Accepts a RecordRequest and returns a stream of the RecordedLogs received from the LINK server
+// for the duration of the request, or until the stream is cancelled if it is not set or 0.
+func (server *OrchestratorV2Server) Record(request *pb.RecordRequest, stream pb.OrchestratorV2_RecordServer) error {
+	// Check the duration of the recording
+	if err := checkDuration(request.GetDuration()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid duration - %v", err)
+	}
+
+	// End the recording after the duration
+	ctx := stream.Context()
+	if duration := request.GetDuration().AsDuration(); duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, duration)
+		defer cancel()
+	}
+
+	// Record the logs from the LINK server and send them on the stream
+	server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(recorder) recording of the LINK stream started | caller - %v", CallerFromContext(ctx).Name)))
+	count := 0
+	err := recordLogs(ctx, server.meshorchestrator, func(log *tools.RecordedLog, dropped uint64) error {
+		count++
+		return stream.Send(&pb.RecordedLog{Arrival: log.Arrival.UnixNano(), Log: NewComplexLog(log.Log), Dropped: dropped})
+	})
+	server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(recorder) recording of the LINK stream ended | logs - %v", count)))
+	return err
+}
+
+// The metadata key with which a caller of the 'Replay' method asks for a live replay.
+const ReplayLiveKey = "replay-live"
+
+// A function that implements the 'Replay' method of the OrchestratorV2 service.
+// Accepts a stream of ComplexLogs and returns a ReplayResponse with the number of logs replayed
+// and their count by log type. The replay is a dry-run unless the caller sets the ReplayLiveKey
+// metadata to 'true', in which case the logs are fed into the LogQueue as though they were received
+// from the LINK server and change the liveness, topology, registry and commands of the mesh.
+// A dry-run only counts the logs. Every log is validated in both modes and the replay fails with
+// InvalidArgument on the first malformed log. The pace of the replay is set by the caller.
+func (server *OrchestratorV2Server) Replay(stream pb.OrchestratorV2_ReplayServer) error {
+	// Check if the caller asked for a live replay
+	live := false
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		for _, value := range md.Get(ReplayLiveKey) {
+			live = live || value == "true"
+		}
+	}
+
+	// Log the start of the replay
+	mode := "dry-run"
+	if live {
+		mode = "live"
+	}
+	server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(replay) replay of a recording started | mode - %v | caller - %v", mode, CallerFromContext(stream.Context()).Name)))
+
+	replayed := int64(0)
+	logtypes := make(map[string]int64)
+	for {
+		// Receive the next log of the replay
+		log, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(replay) replay of a recording broke | mode - %v | logs - %v | error - %v", mode, replayed, err)))
+			return err
+		}
+
+		// Reject a log that the orchestrator could not handle, such as from a truncated recording
+		if err := tools.ValidateLog(log); err != nil {
+			server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(replay) replay of a recording rejected | mode - %v | logs - %v | error - %v", mode, replayed, err)))
+			return status.Errorf(codes.InvalidArgument, "log %v of the replay is invalid - %v", replayed+1, err)
+		}
+
+		// Feed the log into the LogQueue if the replay is live
+		if live {
+			server.meshorchestrator.SendLog(log)
+		}
+		logtypes[log.GetLogtype()]++
+		replayed++
+	}
+
+	// Log the end of the replay and return the number of logs replayed
+	server.meshorchestrator.SendLog(tools.NewOrchServerlog(fmt.Sprintf("(replay) replay of a recording finished | mode - %v | logs - %v", mode, replayed)))
+	return stream.SendAndClose(&pb.ReplayResponse{Replayed: replayed, Live: live, Logtypes: logtypes})
+}
+
+// A function that implements the 'Topology' method of the OrchestratorV2 service.
+// Accepts a TopologyRequest and returns the MeshTopology with the latest changes of
+// the topology up to the number of changes requested, or every change if it is 0.
+func (server *OrchestratorV2Server) Topology(ctx context.Context, request *pb.TopologyRequest) (*pb.MeshTopology, error) {
+	// Check the number of changes requested
+	if request.GetHistory() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid history '%v'", request.GetHistory())
+	}
+
+	// Return the current topology and its history as a MeshTopology proto
+	topology := server.meshorchestrator.Topology.Current()
+	history := server.meshorchestrator.Topology.History(int(request.GetHistory()))
+	return NewMeshTopology(&topology, history), nil
+}
+
+// A function that implements the 'GetNode' method of the OrchestratorV2 service.
+// Accepts a GetNodeRequest and returns the NodeInfo of the requested node.
+func (server *OrchestratorV2Server) GetNode(ctx context.Context, request *pb.GetNodeRequest) (*pb.NodeInfo, error) {
+	// Check the node ID of the request
+	if request.GetNode() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid node ID '%v'", request.GetNode())
+	}
+
+	// Check if the node is known to the orchestrator, either from the mesh, its config, its liveness or its record
+	meshstate := server.meshorchestrator.Snapshot()
+	_, configured := meshstate.Nodelist[request.GetNode()]
+	_, tracked := server.meshorchestrator.Liveness.Get(request.GetNode())
+	_, registered := server.meshorchestrator.Registry.Get(request.GetNode())
+	if !configured && !tracked && !registered && !containsNode(meshstate.NodeIDlist, request.GetNode()) {
+		return nil, status.Errorf(codes.NotFound, "node '%v' is not known to the orchestrator", request.GetNode())
+	}
+
+	// Return the description of the node as a NodeInfo proto
+	return NewNodeInfo(&meshstate, server.meshorchestrator.Liveness, server.meshorchestrator.Registry, request.GetNode()), nil
+}
+
+// A function that implements the 'SetNodeRecord' method of the OrchestratorV2 service.
+// Accepts a SetNodeRecordRequest and returns the updated NodeRecord of the node. The name, zone and
+// location of the record are only changed if they are listed in the fields to update of the request,
+// so that they can be cleared, while the labels are merged into the labels of the record.
+func (server *OrchestratorV2Server) SetNodeRecord(ctx context.Context, request *pb.SetNodeRecordRequest) (*pb.NodeRecord, error) {
+	// Create the update with the labels of the request
+	update := tools.NodeRecordUpdate{NodeID: request.GetNode(), Labels: request.GetLabels(), RemoveLabels: request.GetRemoveLabels()}
+
+	// Assign the fields to update
+	for _, field := range request.GetUpdate() {
+		switch field {
+		case "name":
+			name := request.GetName()
+			update.Name = &name
+		case "zone":
+			zone := request.GetZone()
+			update.Zone = &zone
+		case "location":
+			update.ClearLocation = true
+			if location := request.GetLocation(); location != nil {
+				update.Location = &tools.NodeLocation{Latitude: location.GetLatitude(), Longitude: location.GetLongitude()}
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid field to update '%v' - must be one of 'name', 'zone' or 'location'", field)
+		}
+	}
+
+	// Check the update
+	if err := update.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Apply the update to the registry
+	record, err := server.meshorchestrator.Registry.Set(update, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "node registry could not be persisted - %v", err)
+	}
+	// Return the updated record
+	return NewNodeRecord(record), nil
+}
+
+// A function that implements the 'DeleteNodeRecord' method of the OrchestratorV2 service.
+// Accepts a DeleteNodeRecordRequest and returns the NodeRecord that was removed from the registry.
+func (server *OrchestratorV2Server) DeleteNodeRecord(ctx context.Context, request *pb.DeleteNodeRecordRequest) (*pb.NodeRecord, error) {
+	// Check if the node has a record
+	if _, ok := server.meshorchestrator.Registry.Get(request.GetNode()); !ok {
+		return nil, status.Errorf(codes.NotFound, "node '%v' is not in the registry", request.GetNode())
+	}
+
+	// Remove the record from the registry
+	record, err := server.meshorchestrator.Registry.Delete(request.GetNode())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "node registry could not be persisted - %v", err)
+	}
+	// Return the removed record
+	return NewNodeRecord(record), nil
+}
+
+// A function that implements the 'ListNodeRecords' method of the OrchestratorV2 service.
+// Accepts a ListNodeRecordsRequest and returns a NodeRecordList with the records of the nodes
+// in the requested zone, or of every node if the zone is not set, sorted by their node ID.
+func (server *OrchestratorV2Server) ListNodeRecords(ctx context.Context, request *pb.ListNodeRecordsRequest) (*pb.NodeRecordList, error) {
+	// Convert the records and return them
+	records := server.meshorchestrator.Registry.List(request.GetZone())
+	recordlist := &pb.NodeRecordList{Records: make([]*pb.NodeRecord, 0, len(records))}
+	for _, record := range records {
+		recordlist.Records = append(recordlist.Records, NewNodeRecord(record))
+	}
+	return recordlist, nil
+}
+
+// A function that implements the 'ImportNodeRecords' method of the OrchestratorV2 service.
+// Accepts an ImportNodeRecordsRequest and returns an ImportNodeRecordsResponse with the number of imported
+// records. The records of other nodes are removed from the registry if the replace flag is set.
+func (server *OrchestratorV2Server) ImportNodeRecords(ctx context.Context, request *pb.ImportNodeRecordsRequest) (*pb.ImportNodeRecordsResponse, error) {
+	// Convert and check the records
+	records := make([]tools.NodeRecord, 0, len(request.GetRecords()))
+	for _, noderecord := range request.GetRecords() {
+		records = append(records, readNodeRecord(noderecord))
+	}
+	if err := tools.ValidateImport(records); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Import the records into the registry
+	imported, err := server.meshorchestrator.Registry.Import(records, request.GetReplace(), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "node registry could not be persisted - %v", err)
+	}
+	// Return the number of imported records
+	return &pb.ImportNodeRecordsResponse{Imported: int64(imported)}, nil
+}
+
+// A function that returns whether a slice of node IDs contains a node ID.
+func containsNode(nodeids []int64, nodeid int64) bool {
+	for _, id := range nodeids {
+		if id == nodeid {
+			return true
+		}
+	}
+	return false
+}
+
+// A function that checks the scope, type, node and timeout of a PingRequest
+// and returns an InvalidArgument status error if the request is malformed.
+func checkPingRequest(request *pb.PingRequest) error {
+	// Check the scope and type of the ping
+	switch request.GetScope() {
+	case pb.PingScope_PING_SCOPE_MESH, pb.PingScope_PING_SCOPE_NODE:
+		if request.GetType() == pb.PingType_PING_TYPE_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "ping type must be set for mesh and node pings")
+		}
+	case pb.PingScope_PING_SCOPE_CONTROL:
+	default:
+		return status.Error(codes.InvalidArgument, "ping scope must be set")
+	}
+
+	// Check the node of node pings
+	if request.GetScope() == pb.PingScope_PING_SCOPE_NODE && request.GetNode() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid node ID '%v'", request.GetNode())
+	}
+
+	// Check the timeout of the ping
+	if err := checkDuration(request.GetTimeout()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid ping timeout - %v", err)
+	}
+
+	return nil
+}
+
+// A function that returns an error if a Duration proto is malformed or negative. A Duration that is not set is valid.
+func checkDuration(duration *durationpb.Duration) error {
+	if duration == nil {
+		return nil
+	}
+	if err := duration.CheckValid(); err != nil {
+		return err
+	}
+	if duration.AsDuration() < 0 {
+		return fmt.Errorf("'%v' must not be negative", duration.AsDuration())
+	}
+	return nil
+}
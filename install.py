@@ -46,6 +46,12 @@ os.chdir(orchdir)
 os.system('go install')
 print("[INFO] FyrORCH installation done.")
 
+# FyrFAKE install
+fakedir = os.path.join(currentdir, 'fyrfake')
+os.chdir(fakedir)
+os.system('go install')
+print("[INFO] FyrFAKE installation done.")
+
 # Print console messages
 print("[INFO] FyrLINK installation done.")
 print("[INFO] FyrMesh installation completed.")
@@ -56,3 +62,4 @@ print("FYRMESHSRC - path to directory of FyrMesh source code.")
 print("-- use 'fyrcli help' for the usage of the FyrCLI.")
 print("-- use 'fyrcli boot -s LINK' to start the FyrLINK server.")
 print("-- use 'fyrcli boot -s ORCH' to start the FyrORCH server.")
+print("-- use 'fyrcli boot -s FAKE' to start the fake FyrLINK server without the mesh hardware.")
@@ -176,6 +176,8 @@ type OrchestratorClient interface {
 	Command(ctx context.Context, in *ControlCommand, opts ...grpc.CallOption) (*Acknowledge, error)
 	SchedulerToggle(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Acknowledge, error)
 	Simulate(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Acknowledge, error)
+	PingStream(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (Orchestrator_PingStreamClient, error)
+	ObserveComplex(ctx context.Context, in *ObserveFilter, opts ...grpc.CallOption) (Orchestrator_ObserveComplexClient, error)
 }
 
 type orchestratorClient struct {
@@ -281,6 +283,70 @@ func (c *orchestratorClient) Simulate(ctx context.Context, in *Trigger, opts ...
 	return out, nil
 }
 
+func (c *orchestratorClient) PingStream(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (Orchestrator_PingStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[1], "/main.Orchestrator/PingStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorPingStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Orchestrator_PingStreamClient interface {
+	Recv() (*PingResponse, error)
+	grpc.ClientStream
+}
+
+type orchestratorPingStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorPingStreamClient) Recv() (*PingResponse, error) {
+	m := new(PingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorClient) ObserveComplex(ctx context.Context, in *ObserveFilter, opts ...grpc.CallOption) (Orchestrator_ObserveComplexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[2], "/main.Orchestrator/ObserveComplex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorObserveComplexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Orchestrator_ObserveComplexClient interface {
+	Recv() (*ComplexLog, error)
+	grpc.ClientStream
+}
+
+type orchestratorObserveComplexClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorObserveComplexClient) Recv() (*ComplexLog, error) {
+	m := new(ComplexLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OrchestratorServer is the server API for Orchestrator service.
 // All implementations must embed UnimplementedOrchestratorServer
 // for forward compatibility
@@ -293,6 +359,8 @@ type OrchestratorServer interface {
 	Command(context.Context, *ControlCommand) (*Acknowledge, error)
 	SchedulerToggle(context.Context, *Trigger) (*Acknowledge, error)
 	Simulate(context.Context, *Trigger) (*Acknowledge, error)
+	PingStream(*Trigger, Orchestrator_PingStreamServer) error
+	ObserveComplex(*ObserveFilter, Orchestrator_ObserveComplexServer) error
 	mustEmbedUnimplementedOrchestratorServer()
 }
 
@@ -324,6 +392,12 @@ func (UnimplementedOrchestratorServer) SchedulerToggle(context.Context, *Trigger
 func (UnimplementedOrchestratorServer) Simulate(context.Context, *Trigger) (*Acknowledge, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (UnimplementedOrchestratorServer) PingStream(*Trigger, Orchestrator_PingStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PingStream not implemented")
+}
+func (UnimplementedOrchestratorServer) ObserveComplex(*ObserveFilter, Orchestrator_ObserveComplexServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObserveComplex not implemented")
+}
 func (UnimplementedOrchestratorServer) mustEmbedUnimplementedOrchestratorServer() {}
 
 // UnsafeOrchestratorServer may be embedded to opt out of forward compatibility for this service.
@@ -484,6 +558,48 @@ func _Orchestrator_Simulate_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Orchestrator_PingStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Trigger)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorServer).PingStream(m, &orchestratorPingStreamServer{stream})
+}
+
+type Orchestrator_PingStreamServer interface {
+	Send(*PingResponse) error
+	grpc.ServerStream
+}
+
+type orchestratorPingStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorPingStreamServer) Send(m *PingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Orchestrator_ObserveComplex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorServer).ObserveComplex(m, &orchestratorObserveComplexServer{stream})
+}
+
+type Orchestrator_ObserveComplexServer interface {
+	Send(*ComplexLog) error
+	grpc.ServerStream
+}
+
+type orchestratorObserveComplexServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorObserveComplexServer) Send(m *ComplexLog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Orchestrator_ServiceDesc is the grpc.ServiceDesc for Orchestrator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -526,6 +642,1010 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Orchestrator_Observe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PingStream",
+			Handler:       _Orchestrator_PingStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ObserveComplex",
+			Handler:       _Orchestrator_ObserveComplex_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/fyrmesh.proto",
+}
+
+// OrchestratorV2Client is the client API for OrchestratorV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrchestratorV2Client interface {
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*MeshOrchStatus, error)
+	SetConnection(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*ConnectionResponse, error)
+	Observe(ctx context.Context, in *ObserveFilter, opts ...grpc.CallOption) (OrchestratorV2_ObserveClient, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingReceipt, error)
+	PingStream(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (OrchestratorV2_PingStreamClient, error)
+	Nodelist(ctx context.Context, in *NodelistRequest, opts ...grpc.CallOption) (*NodeList, error)
+	Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DescribeCommands(ctx context.Context, in *DescribeCommandsRequest, opts ...grpc.CallOption) (*CommandCatalog, error)
+	CommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandRecord, error)
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*CommandRecordList, error)
+	SetScheduler(ctx context.Context, in *SchedulerRequest, opts ...grpc.CallOption) (*SchedulerResponse, error)
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	QueryAudit(ctx context.Context, in *AuditQuery, opts ...grpc.CallOption) (*AuditEntryList, error)
+	ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*OutboxEntryList, error)
+	PurgeOutbox(ctx context.Context, in *PurgeOutboxRequest, opts ...grpc.CallOption) (*PurgeOutboxResponse, error)
+	Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (OrchestratorV2_RecordClient, error)
+	Replay(ctx context.Context, opts ...grpc.CallOption) (OrchestratorV2_ReplayClient, error)
+	Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*MeshTopology, error)
+	GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeInfo, error)
+	SetNodeRecord(ctx context.Context, in *SetNodeRecordRequest, opts ...grpc.CallOption) (*NodeRecord, error)
+	DeleteNodeRecord(ctx context.Context, in *DeleteNodeRecordRequest, opts ...grpc.CallOption) (*NodeRecord, error)
+	ListNodeRecords(ctx context.Context, in *ListNodeRecordsRequest, opts ...grpc.CallOption) (*NodeRecordList, error)
+	ImportNodeRecords(ctx context.Context, in *ImportNodeRecordsRequest, opts ...grpc.CallOption) (*ImportNodeRecordsResponse, error)
+}
+
+type orchestratorV2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrchestratorV2Client(cc grpc.ClientConnInterface) OrchestratorV2Client {
+	return &orchestratorV2Client{cc}
+}
+
+func (c *orchestratorV2Client) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*MeshOrchStatus, error) {
+	out := new(MeshOrchStatus)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) SetConnection(ctx context.Context, in *ConnectionRequest, opts ...grpc.CallOption) (*ConnectionResponse, error) {
+	out := new(ConnectionResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/SetConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) Observe(ctx context.Context, in *ObserveFilter, opts ...grpc.CallOption) (OrchestratorV2_ObserveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrchestratorV2_ServiceDesc.Streams[0], "/main.OrchestratorV2/Observe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorV2ObserveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrchestratorV2_ObserveClient interface {
+	Recv() (*ComplexLog, error)
+	grpc.ClientStream
+}
+
+type orchestratorV2ObserveClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorV2ObserveClient) Recv() (*ComplexLog, error) {
+	m := new(ComplexLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorV2Client) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingReceipt, error) {
+	out := new(PingReceipt)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) PingStream(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (OrchestratorV2_PingStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrchestratorV2_ServiceDesc.Streams[1], "/main.OrchestratorV2/PingStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorV2PingStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrchestratorV2_PingStreamClient interface {
+	Recv() (*PingResponse, error)
+	grpc.ClientStream
+}
+
+type orchestratorV2PingStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorV2PingStreamClient) Recv() (*PingResponse, error) {
+	m := new(PingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorV2Client) Nodelist(ctx context.Context, in *NodelistRequest, opts ...grpc.CallOption) (*NodeList, error) {
+	out := new(NodeList)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Nodelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Command", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) DescribeCommands(ctx context.Context, in *DescribeCommandsRequest, opts ...grpc.CallOption) (*CommandCatalog, error) {
+	out := new(CommandCatalog)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/DescribeCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) CommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandRecord, error) {
+	out := new(CommandRecord)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/CommandStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*CommandRecordList, error) {
+	out := new(CommandRecordList)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/ListCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) SetScheduler(ctx context.Context, in *SchedulerRequest, opts ...grpc.CallOption) (*SchedulerResponse, error) {
+	out := new(SchedulerResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/SetScheduler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) QueryAudit(ctx context.Context, in *AuditQuery, opts ...grpc.CallOption) (*AuditEntryList, error) {
+	out := new(AuditEntryList)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/QueryAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*OutboxEntryList, error) {
+	out := new(OutboxEntryList)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/ListOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) PurgeOutbox(ctx context.Context, in *PurgeOutboxRequest, opts ...grpc.CallOption) (*PurgeOutboxResponse, error) {
+	out := new(PurgeOutboxResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/PurgeOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (OrchestratorV2_RecordClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrchestratorV2_ServiceDesc.Streams[2], "/main.OrchestratorV2/Record", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorV2RecordClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrchestratorV2_RecordClient interface {
+	Recv() (*RecordedLog, error)
+	grpc.ClientStream
+}
+
+type orchestratorV2RecordClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorV2RecordClient) Recv() (*RecordedLog, error) {
+	m := new(RecordedLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorV2Client) Replay(ctx context.Context, opts ...grpc.CallOption) (OrchestratorV2_ReplayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrchestratorV2_ServiceDesc.Streams[3], "/main.OrchestratorV2/Replay", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorV2ReplayClient{stream}
+	return x, nil
+}
+
+type OrchestratorV2_ReplayClient interface {
+	Send(*ComplexLog) error
+	CloseAndRecv() (*ReplayResponse, error)
+	grpc.ClientStream
+}
+
+type orchestratorV2ReplayClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorV2ReplayClient) Send(m *ComplexLog) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *orchestratorV2ReplayClient) CloseAndRecv() (*ReplayResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReplayResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorV2Client) Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*MeshTopology, error) {
+	out := new(MeshTopology)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/Topology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/GetNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) SetNodeRecord(ctx context.Context, in *SetNodeRecordRequest, opts ...grpc.CallOption) (*NodeRecord, error) {
+	out := new(NodeRecord)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/SetNodeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) DeleteNodeRecord(ctx context.Context, in *DeleteNodeRecordRequest, opts ...grpc.CallOption) (*NodeRecord, error) {
+	out := new(NodeRecord)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/DeleteNodeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) ListNodeRecords(ctx context.Context, in *ListNodeRecordsRequest, opts ...grpc.CallOption) (*NodeRecordList, error) {
+	out := new(NodeRecordList)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/ListNodeRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorV2Client) ImportNodeRecords(ctx context.Context, in *ImportNodeRecordsRequest, opts ...grpc.CallOption) (*ImportNodeRecordsResponse, error) {
+	out := new(ImportNodeRecordsResponse)
+	err := c.cc.Invoke(ctx, "/main.OrchestratorV2/ImportNodeRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrchestratorV2Server is the server API for OrchestratorV2 service.
+// All implementations must embed UnimplementedOrchestratorV2Server
+// for forward compatibility
+type OrchestratorV2Server interface {
+	Status(context.Context, *StatusRequest) (*MeshOrchStatus, error)
+	SetConnection(context.Context, *ConnectionRequest) (*ConnectionResponse, error)
+	Observe(*ObserveFilter, OrchestratorV2_ObserveServer) error
+	Ping(context.Context, *PingRequest) (*PingReceipt, error)
+	PingStream(*PingRequest, OrchestratorV2_PingStreamServer) error
+	Nodelist(context.Context, *NodelistRequest) (*NodeList, error)
+	Command(context.Context, *CommandRequest) (*CommandResponse, error)
+	DescribeCommands(context.Context, *DescribeCommandsRequest) (*CommandCatalog, error)
+	CommandStatus(context.Context, *CommandStatusRequest) (*CommandRecord, error)
+	ListCommands(context.Context, *ListCommandsRequest) (*CommandRecordList, error)
+	SetScheduler(context.Context, *SchedulerRequest) (*SchedulerResponse, error)
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	QueryAudit(context.Context, *AuditQuery) (*AuditEntryList, error)
+	ListOutbox(context.Context, *ListOutboxRequest) (*OutboxEntryList, error)
+	PurgeOutbox(context.Context, *PurgeOutboxRequest) (*PurgeOutboxResponse, error)
+	Record(*RecordRequest, OrchestratorV2_RecordServer) error
+	Replay(OrchestratorV2_ReplayServer) error
+	Topology(context.Context, *TopologyRequest) (*MeshTopology, error)
+	GetNode(context.Context, *GetNodeRequest) (*NodeInfo, error)
+	SetNodeRecord(context.Context, *SetNodeRecordRequest) (*NodeRecord, error)
+	DeleteNodeRecord(context.Context, *DeleteNodeRecordRequest) (*NodeRecord, error)
+	ListNodeRecords(context.Context, *ListNodeRecordsRequest) (*NodeRecordList, error)
+	ImportNodeRecords(context.Context, *ImportNodeRecordsRequest) (*ImportNodeRecordsResponse, error)
+	mustEmbedUnimplementedOrchestratorV2Server()
+}
+
+// UnimplementedOrchestratorV2Server must be embedded to have forward compatible implementations.
+type UnimplementedOrchestratorV2Server struct {
+}
+
+func (UnimplementedOrchestratorV2Server) Status(context.Context, *StatusRequest) (*MeshOrchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedOrchestratorV2Server) SetConnection(context.Context, *ConnectionRequest) (*ConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnection not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Observe(*ObserveFilter, OrchestratorV2_ObserveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Observe not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Ping(context.Context, *PingRequest) (*PingReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedOrchestratorV2Server) PingStream(*PingRequest, OrchestratorV2_PingStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PingStream not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Nodelist(context.Context, *NodelistRequest) (*NodeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nodelist not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Command(context.Context, *CommandRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Command not implemented")
+}
+func (UnimplementedOrchestratorV2Server) DescribeCommands(context.Context, *DescribeCommandsRequest) (*CommandCatalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCommands not implemented")
+}
+func (UnimplementedOrchestratorV2Server) CommandStatus(context.Context, *CommandStatusRequest) (*CommandRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandStatus not implemented")
+}
+func (UnimplementedOrchestratorV2Server) ListCommands(context.Context, *ListCommandsRequest) (*CommandRecordList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedOrchestratorV2Server) SetScheduler(context.Context, *SchedulerRequest) (*SchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduler not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (UnimplementedOrchestratorV2Server) QueryAudit(context.Context, *AuditQuery) (*AuditEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAudit not implemented")
+}
+func (UnimplementedOrchestratorV2Server) ListOutbox(context.Context, *ListOutboxRequest) (*OutboxEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutbox not implemented")
+}
+func (UnimplementedOrchestratorV2Server) PurgeOutbox(context.Context, *PurgeOutboxRequest) (*PurgeOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOutbox not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Record(*RecordRequest, OrchestratorV2_RecordServer) error {
+	return status.Errorf(codes.Unimplemented, "method Record not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Replay(OrchestratorV2_ReplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+func (UnimplementedOrchestratorV2Server) Topology(context.Context, *TopologyRequest) (*MeshTopology, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Topology not implemented")
+}
+func (UnimplementedOrchestratorV2Server) GetNode(context.Context, *GetNodeRequest) (*NodeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
+}
+func (UnimplementedOrchestratorV2Server) SetNodeRecord(context.Context, *SetNodeRecordRequest) (*NodeRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNodeRecord not implemented")
+}
+func (UnimplementedOrchestratorV2Server) DeleteNodeRecord(context.Context, *DeleteNodeRecordRequest) (*NodeRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNodeRecord not implemented")
+}
+func (UnimplementedOrchestratorV2Server) ListNodeRecords(context.Context, *ListNodeRecordsRequest) (*NodeRecordList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeRecords not implemented")
+}
+func (UnimplementedOrchestratorV2Server) ImportNodeRecords(context.Context, *ImportNodeRecordsRequest) (*ImportNodeRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportNodeRecords not implemented")
+}
+func (UnimplementedOrchestratorV2Server) mustEmbedUnimplementedOrchestratorV2Server() {}
+
+// UnsafeOrchestratorV2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrchestratorV2Server will
+// result in compilation errors.
+type UnsafeOrchestratorV2Server interface {
+	mustEmbedUnimplementedOrchestratorV2Server()
+}
+
+func RegisterOrchestratorV2Server(s grpc.ServiceRegistrar, srv OrchestratorV2Server) {
+	s.RegisterService(&OrchestratorV2_ServiceDesc, srv)
+}
+
+func _OrchestratorV2_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_SetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).SetConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/SetConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).SetConnection(ctx, req.(*ConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_Observe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorV2Server).Observe(m, &orchestratorV2ObserveServer{stream})
+}
+
+type OrchestratorV2_ObserveServer interface {
+	Send(*ComplexLog) error
+	grpc.ServerStream
+}
+
+type orchestratorV2ObserveServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorV2ObserveServer) Send(m *ComplexLog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrchestratorV2_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_PingStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorV2Server).PingStream(m, &orchestratorV2PingStreamServer{stream})
+}
+
+type OrchestratorV2_PingStreamServer interface {
+	Send(*PingResponse) error
+	grpc.ServerStream
+}
+
+type orchestratorV2PingStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorV2PingStreamServer) Send(m *PingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrchestratorV2_Nodelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Nodelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Nodelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Nodelist(ctx, req.(*NodelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_Command_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Command(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Command",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Command(ctx, req.(*CommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_DescribeCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).DescribeCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/DescribeCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).DescribeCommands(ctx, req.(*DescribeCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_CommandStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).CommandStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/CommandStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).CommandStatus(ctx, req.(*CommandStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).ListCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/ListCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).ListCommands(ctx, req.(*ListCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_SetScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).SetScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/SetScheduler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).SetScheduler(ctx, req.(*SchedulerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_QueryAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).QueryAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/QueryAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).QueryAudit(ctx, req.(*AuditQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_ListOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).ListOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/ListOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).ListOutbox(ctx, req.(*ListOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_PurgeOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).PurgeOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/PurgeOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).PurgeOutbox(ctx, req.(*PurgeOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_Record_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RecordRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorV2Server).Record(m, &orchestratorV2RecordServer{stream})
+}
+
+type OrchestratorV2_RecordServer interface {
+	Send(*RecordedLog) error
+	grpc.ServerStream
+}
+
+type orchestratorV2RecordServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorV2RecordServer) Send(m *RecordedLog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrchestratorV2_Replay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrchestratorV2Server).Replay(&orchestratorV2ReplayServer{stream})
+}
+
+type OrchestratorV2_ReplayServer interface {
+	SendAndClose(*ReplayResponse) error
+	Recv() (*ComplexLog, error)
+	grpc.ServerStream
+}
+
+type orchestratorV2ReplayServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorV2ReplayServer) SendAndClose(m *ReplayResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *orchestratorV2ReplayServer) Recv() (*ComplexLog, error) {
+	m := new(ComplexLog)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OrchestratorV2_Topology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).Topology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/Topology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).Topology(ctx, req.(*TopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/GetNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_SetNodeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).SetNodeRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/SetNodeRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).SetNodeRecord(ctx, req.(*SetNodeRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_DeleteNodeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNodeRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).DeleteNodeRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/DeleteNodeRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).DeleteNodeRecord(ctx, req.(*DeleteNodeRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_ListNodeRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).ListNodeRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/ListNodeRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).ListNodeRecords(ctx, req.(*ListNodeRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorV2_ImportNodeRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNodeRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorV2Server).ImportNodeRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrchestratorV2/ImportNodeRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorV2Server).ImportNodeRecords(ctx, req.(*ImportNodeRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrchestratorV2_ServiceDesc is the grpc.ServiceDesc for OrchestratorV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrchestratorV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.OrchestratorV2",
+	HandlerType: (*OrchestratorV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _OrchestratorV2_Status_Handler,
+		},
+		{
+			MethodName: "SetConnection",
+			Handler:    _OrchestratorV2_SetConnection_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _OrchestratorV2_Ping_Handler,
+		},
+		{
+			MethodName: "Nodelist",
+			Handler:    _OrchestratorV2_Nodelist_Handler,
+		},
+		{
+			MethodName: "Command",
+			Handler:    _OrchestratorV2_Command_Handler,
+		},
+		{
+			MethodName: "DescribeCommands",
+			Handler:    _OrchestratorV2_DescribeCommands_Handler,
+		},
+		{
+			MethodName: "CommandStatus",
+			Handler:    _OrchestratorV2_CommandStatus_Handler,
+		},
+		{
+			MethodName: "ListCommands",
+			Handler:    _OrchestratorV2_ListCommands_Handler,
+		},
+		{
+			MethodName: "SetScheduler",
+			Handler:    _OrchestratorV2_SetScheduler_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _OrchestratorV2_Simulate_Handler,
+		},
+		{
+			MethodName: "QueryAudit",
+			Handler:    _OrchestratorV2_QueryAudit_Handler,
+		},
+		{
+			MethodName: "ListOutbox",
+			Handler:    _OrchestratorV2_ListOutbox_Handler,
+		},
+		{
+			MethodName: "PurgeOutbox",
+			Handler:    _OrchestratorV2_PurgeOutbox_Handler,
+		},
+		{
+			MethodName: "Topology",
+			Handler:    _OrchestratorV2_Topology_Handler,
+		},
+		{
+			MethodName: "GetNode",
+			Handler:    _OrchestratorV2_GetNode_Handler,
+		},
+		{
+			MethodName: "SetNodeRecord",
+			Handler:    _OrchestratorV2_SetNodeRecord_Handler,
+		},
+		{
+			MethodName: "DeleteNodeRecord",
+			Handler:    _OrchestratorV2_DeleteNodeRecord_Handler,
+		},
+		{
+			MethodName: "ListNodeRecords",
+			Handler:    _OrchestratorV2_ListNodeRecords_Handler,
+		},
+		{
+			MethodName: "ImportNodeRecords",
+			Handler:    _OrchestratorV2_ImportNodeRecords_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Observe",
+			Handler:       _OrchestratorV2_Observe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PingStream",
+			Handler:       _OrchestratorV2_PingStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Record",
+			Handler:       _OrchestratorV2_Record_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Replay",
+			Handler:       _OrchestratorV2_Replay_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/fyrmesh.proto",
 }
@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: proto/fyrmesh.proto
 """Generated protocol buffer code."""
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -11,6 +12,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -19,9 +21,148 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'Z\006/proto',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x13proto/fyrmesh.proto\x12\x04main\"\x81\x01\n\x07Trigger\x12\x16\n\x0etriggermessage\x18\x01 \x01(\t\x12-\n\x08metadata\x18\x02 \x03(\x0b\x32\x1b.main.Trigger.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"-\n\x0b\x41\x63knowledge\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\xa8\x01\n\x0eMeshOrchStatus\x12\x11\n\tconnected\x18\x01 \x01(\x08\x12\x14\n\x0c\x63ontrollerID\x18\x02 \x01(\t\x12\x15\n\rcontrolnodeID\x18\x03 \x01(\x03\x12 \n\x08nodelist\x18\x04 \x01(\x0b\x32\x0e.main.NodeList\x12\x10\n\x08meshSSID\x18\x05 \x01(\t\x12\x10\n\x08meshPSWD\x18\x06 \x01(\t\x12\x10\n\x08meshPORT\x18\x07 \x01(\x05\"\x1c\n\tSimpleLog\x12\x0f\n\x07message\x18\x01 \x01(\t\"\xc1\x01\n\nComplexLog\x12\x11\n\tlogsource\x18\x01 \x01(\t\x12\x0f\n\x07logtype\x18\x02 \x01(\t\x12\x0f\n\x07logtime\x18\x03 \x01(\t\x12\x12\n\nlogmessage\x18\x04 \x01(\t\x12\x36\n\x0blogmetadata\x18\x05 \x03(\x0b\x32!.main.ComplexLog.LogmetadataEntry\x1a\x32\n\x10LogmetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x88\x01\n\x0e\x43ontrolCommand\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\x12\x34\n\x08metadata\x18\x02 \x03(\x0b\x32\".main.ControlCommand.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"b\n\x08NodeList\x12(\n\x05nodes\x18\x01 \x03(\x0b\x32\x19.main.NodeList.NodesEntry\x1a,\n\nNodesEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x32l\n\tInterface\x12+\n\x04Read\x12\r.main.Trigger\x1a\x10.main.ComplexLog\"\x00\x30\x01\x12\x32\n\x05Write\x12\x14.main.ControlCommand\x1a\x11.main.Acknowledge\"\x00\x32\x96\x03\n\x0cOrchestrator\x12/\n\x06Status\x12\r.main.Trigger\x1a\x14.main.MeshOrchStatus\"\x00\x12\x30\n\nConnection\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12-\n\x07Observe\x12\r.main.Trigger\x1a\x0f.main.SimpleLog\"\x00\x30\x01\x12*\n\x04Ping\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12+\n\x08Nodelist\x12\r.main.Trigger\x1a\x0e.main.NodeList\"\x00\x12\x34\n\x07\x43ommand\x12\x14.main.ControlCommand\x1a\x11.main.Acknowledge\"\x00\x12\x35\n\x0fSchedulerToggle\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12.\n\x08Simulate\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x42\x08Z\x06/protob\x06proto3'
+  serialized_pb=b'\n\x13proto/fyrmesh.proto\x12\x04main\x1a\x1egoogle/protobuf/duration.proto\"\x81\x01\n\x07Trigger\x12\x16\n\x0etriggermessage\x18\x01 \x01(\t\x12-\n\x08metadata\x18\x02 \x03(\x0b\x32\x1b.main.Trigger.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"-\n\x0b\x41\x63knowledge\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\xbe\x02\n\x0eMeshOrchStatus\x12\x11\n\tconnected\x18\x01 \x01(\x08\x12\x14\n\x0c\x63ontrollerID\x18\x02 \x01(\t\x12\x15\n\rcontrolnodeID\x18\x03 \x01(\x03\x12 \n\x08nodelist\x18\x04 \x01(\x0b\x32\x0e.main.NodeList\x12\x10\n\x08meshSSID\x18\x05 \x01(\t\x12\x10\n\x08meshPSWD\x18\x06 \x01(\t\x12\x10\n\x08meshPORT\x18\x07 \x01(\x05\x12\x1e\n\x04link\x18\x08 \x01(\x0b\x32\x10.main.LinkStatus\x12)\n\ncomponents\x18\t \x03(\x0b\x32\x15.main.ComponentStatus\x12\x10\n\x08\x64\x65graded\x18\n \x01(\x08\x12\x14\n\x0c\x63loudPending\x18\x0b \x01(\x03\x12!\n\x06queues\x18\x0c \x03(\x0b\x32\x11.main.QueueStatus\"\xa6\x01\n\x0bQueueStatus\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06policy\x18\x02 \x01(\t\x12\x10\n\x08\x63\x61pacity\x18\x03 \x01(\x03\x12\r\n\x05\x64\x65pth\x18\x04 \x01(\x03\x12\x11\n\thighWater\x18\x05 \x01(\x03\x12\x10\n\x08\x65nqueued\x18\x06 \x01(\x04\x12\x0f\n\x07\x64ropped\x18\x07 \x01(\x04\x12\x10\n\x08rejected\x18\x08 \x01(\x04\x12\x10\n\x08\x64\x65\x66\x65rred\x18\t \x01(\x04\"V\n\x0f\x43omponentStatus\x12\x11\n\tcomponent\x18\x01 \x01(\t\x12\x0f\n\x07healthy\x18\x02 \x01(\x08\x12\x0e\n\x06reason\x18\x03 \x01(\t\x12\x0f\n\x07updated\x18\x04 \x01(\t\"\xbb\x01\n\nLinkStatus\x12\r\n\x05state\x18\x01 \x01(\t\x12\r\n\x05since\x18\x02 \x01(\t\x12\x12\n\nreconnects\x18\x03 \x01(\x03\x12\x10\n\x08\x61ttempts\x18\x04 \x01(\x03\x12\x11\n\tnextRetry\x18\x05 \x01(\t\x12\x11\n\tlastError\x18\x06 \x01(\t\x12\x13\n\x0boutageStart\x18\x07 \x01(\t\x12\x17\n\x0flastOutageStart\x18\x08 \x01(\t\x12\x15\n\rlastOutageEnd\x18\t \x01(\t\"\x1c\n\tSimpleLog\x12\x0f\n\x07message\x18\x01 \x01(\t\"\xc1\x01\n\nComplexLog\x12\x11\n\tlogsource\x18\x01 \x01(\t\x12\x0f\n\x07logtype\x18\x02 \x01(\t\x12\x0f\n\x07logtime\x18\x03 \x01(\t\x12\x12\n\nlogmessage\x18\x04 \x01(\t\x12\x36\n\x0blogmetadata\x18\x05 \x03(\x0b\x32!.main.ComplexLog.LogmetadataEntry\x1a\x32\n\x10LogmetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x88\x01\n\x0e\x43ontrolCommand\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\x12\x34\n\x08metadata\x18\x02 \x03(\x0b\x32\".main.ControlCommand.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"S\n\x0cNodeLiveness\x12\r\n\x05state\x18\x01 \x01(\t\x12\x10\n\x08lastSeen\x18\x02 \x01(\t\x12\r\n\x05since\x18\x03 \x01(\t\x12\x13\n\x0bmissedPings\x18\x04 \x01(\x03\"\xae\x01\n\x0bNodeReading\x12\x0e\n\x06pingID\x18\x01 \x01(\t\x12\x0c\n\x04time\x18\x02 \x01(\t\x12\x35\n\nsensordata\x18\x03 \x03(\x0b\x32!.main.NodeReading.SensordataEntry\x12\x17\n\x0f\x66ireProbability\x18\x04 \x01(\x01\x1a\x31\n\x0fSensordataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\"\xdc\x02\n\x08NodeInfo\x12\x0c\n\x04node\x18\x01 \x01(\x03\x12\x12\n\nserialBaud\x18\x02 \x01(\x03\x12\x0f\n\x07\x64htType\x18\x03 \x01(\x03\x12\x0e\n\x06\x64htPin\x18\x04 \x01(\x03\x12\x0f\n\x07\x66lmType\x18\x05 \x01(\x03\x12\x0e\n\x06\x66lmPin\x18\x06 \x01(\x03\x12\x0f\n\x07gasType\x18\x07 \x01(\x03\x12\x0e\n\x06gasPin\x18\x08 \x01(\x03\x12\x0e\n\x06pinger\x18\t \x01(\x08\x12\x11\n\tpingerPin\x18\n \x01(\x03\x12\x12\n\nconnectPin\x18\x0b \x01(\x03\x12\x0e\n\x06\x63onfig\x18\x0c \x01(\t\x12\x14\n\x0c\x63\x61pabilities\x18\r \x03(\t\x12&\n\x0blastReading\x18\x0e \x01(\x0b\x32\x11.main.NodeReading\x12$\n\x08liveness\x18\x0f \x01(\x0b\x32\x12.main.NodeLiveness\x12 \n\x06record\x18\x10 \x01(\x0b\x32\x10.main.NodeRecord\"3\n\x0cNodeLocation\x12\x10\n\x08latitude\x18\x01 \x01(\x01\x12\x11\n\tlongitude\x18\x02 \x01(\x01\"\xca\x01\n\nNodeRecord\x12\x0c\n\x04node\x18\x01 \x01(\x03\x12\x0c\n\x04name\x18\x02 \x01(\t\x12,\n\x06labels\x18\x03 \x03(\x0b\x32\x1c.main.NodeRecord.LabelsEntry\x12$\n\x08location\x18\x04 \x01(\x0b\x32\x12.main.NodeLocation\x12\x0c\n\x04zone\x18\x05 \x01(\t\x12\x0f\n\x07updated\x18\x06 \x01(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"3\n\x0eNodeRecordList\x12!\n\x07records\x18\x01 \x03(\x0b\x32\x10.main.NodeRecord\"\xc5\x02\n\x08NodeList\x12(\n\x05nodes\x18\x01 \x03(\x0b\x32\x19.main.NodeList.NodesEntry\x12.\n\x08liveness\x18\x02 \x03(\x0b\x32\x1c.main.NodeList.LivenessEntry\x12,\n\x07\x64\x65tails\x18\x03 \x03(\x0b\x32\x1b.main.NodeList.DetailsEntry\x1a,\n\nNodesEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x43\n\rLivenessEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12!\n\x05value\x18\x02 \x01(\x0b\x32\x12.main.NodeLiveness:\x02\x38\x01\x1a>\n\x0c\x44\x65tailsEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12\x1d\n\x05value\x18\x02 \x01(\x0b\x32\x0e.main.NodeInfo:\x02\x38\x01\"\xcc\x02\n\x0cPingResponse\x12\x0e\n\x06pingID\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\x03\x12\x10\n\x08pingtype\x18\x03 \x01(\t\x12\x10\n\x08pingtime\x18\x04 \x01(\t\x12\x36\n\nsensordata\x18\x05 \x03(\x0b\x32\".main.PingResponse.SensordataEntry\x12\x36\n\nconfigdata\x18\x06 \x03(\x0b\x32\".main.PingResponse.ConfigdataEntry\x12\x10\n\x08\x63omplete\x18\x07 \x01(\x08\x12\x12\n\nunanswered\x18\x08 \x03(\x03\x1a\x31\n\x0fSensordataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\x1a\x31\n\x0f\x43onfigdataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa7\x01\n\rObserveFilter\x12\x0f\n\x07sources\x18\x01 \x03(\t\x12\r\n\x05types\x18\x02 \x03(\t\x12\x33\n\x08metadata\x18\x03 \x03(\x0b\x32!.main.ObserveFilter.MetadataEntry\x12\x10\n\x08severity\x18\x04 \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x0f\n\rStatusRequest\"&\n\x11\x43onnectionRequest\x12\x11\n\tconnected\x18\x01 \x01(\x08\"\'\n\x12\x43onnectionResponse\x12\x11\n\tconnected\x18\x01 \x01(\x08\"\xa6\x01\n\x0bPingRequest\x12\x1e\n\x05scope\x18\x01 \x01(\x0e\x32\x0f.main.PingScope\x12\x1c\n\x04type\x18\x02 \x01(\x0e\x32\x0e.main.PingType\x12\x0c\n\x04node\x18\x03 \x01(\x03\x12\x0e\n\x06phrase\x18\x04 \x01(\t\x12*\n\x07timeout\x18\x06 \x01(\x0b\x32\x19.google.protobuf.DurationJ\x04\x08\x05\x10\x06R\ttimeoutMs\"\x1d\n\x0bPingReceipt\x12\x0e\n\x06pingID\x18\x01 \x01(\t\"\x11\n\x0fNodelistRequest\"\x1e\n\x0eGetNodeRequest\x12\x0c\n\x04node\x18\x01 \x01(\x03\"\xf3\x01\n\x14SetNodeRecordRequest\x12\x0c\n\x04node\x18\x01 \x01(\x03\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04zone\x18\x03 \x01(\t\x12$\n\x08location\x18\x04 \x01(\x0b\x32\x12.main.NodeLocation\x12\x36\n\x06labels\x18\x05 \x03(\x0b\x32&.main.SetNodeRecordRequest.LabelsEntry\x12\x14\n\x0cremoveLabels\x18\x06 \x03(\t\x12\x0e\n\x06update\x18\x07 \x03(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\'\n\x17\x44\x65leteNodeRecordRequest\x12\x0c\n\x04node\x18\x01 \x01(\x03\"&\n\x16ListNodeRecordsRequest\x12\x0c\n\x04zone\x18\x01 \x01(\t\"N\n\x18ImportNodeRecordsRequest\x12!\n\x07records\x18\x01 \x03(\x0b\x32\x10.main.NodeRecord\x12\x0f\n\x07replace\x18\x02 \x01(\x08\"-\n\x19ImportNodeRecordsResponse\x12\x10\n\x08imported\x18\x01 \x01(\x03\"\x88\x01\n\x0e\x43ommandRequest\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\x12\x34\n\x08metadata\x18\x02 \x03(\x0b\x32\".main.CommandRequest.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"5\n\x0f\x43ommandResponse\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\x12\x11\n\tcommandID\x18\x02 \x01(\t\")\n\x14\x43ommandStatusRequest\x12\x11\n\tcommandID\x18\x01 \x01(\t\"\x80\x02\n\rCommandRecord\x12\x11\n\tcommandID\x18\x01 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x02 \x01(\t\x12\x33\n\x08metadata\x18\x03 \x03(\x0b\x32!.main.CommandRecord.MetadataEntry\x12!\n\x05state\x18\x04 \x01(\x0e\x32\x12.main.CommandState\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x0f\n\x07\x63reated\x18\x06 \x01(\t\x12\x0f\n\x07updated\x18\x07 \x01(\t\x12\x11\n\tresponses\x18\x08 \x01(\x03\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"G\n\x13ListCommandsRequest\x12!\n\x05state\x18\x01 \x01(\x0e\x32\x12.main.CommandState\x12\r\n\x05limit\x18\x02 \x01(\x03\":\n\x11\x43ommandRecordList\x12%\n\x08\x63ommands\x18\x01 \x03(\x0b\x32\x13.main.CommandRecord\"\x13\n\x11ListOutboxRequest\"\xdb\x01\n\x0bOutboxEntry\x12\x11\n\tcommandID\x18\x01 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x02 \x01(\t\x12\x31\n\x08metadata\x18\x03 \x03(\x0b\x32\x1f.main.OutboxEntry.MetadataEntry\x12\x0e\n\x06queued\x18\x04 \x01(\t\x12\x0f\n\x07\x65xpires\x18\x05 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x06 \x01(\x03\x12\x11\n\tlastError\x18\x07 \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"5\n\x0fOutboxEntryList\x12\"\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x11.main.OutboxEntry\"5\n\x12PurgeOutboxRequest\x12\x12\n\ncommandIDs\x18\x01 \x03(\t\x12\x0b\n\x03\x61ll\x18\x02 \x01(\x08\")\n\x13PurgeOutboxResponse\x12\x12\n\ncommandIDs\x18\x01 \x03(\t\"\x19\n\x17\x44\x65scribeCommandsRequest\"N\n\nCommandKey\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x10\n\x08required\x18\x03 \x01(\x08\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\"S\n\x0b\x43ommandSpec\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x1e\n\x04keys\x18\x03 \x03(\x0b\x32\x10.main.CommandKey\"5\n\x0e\x43ommandCatalog\x12#\n\x08\x63ommands\x18\x01 \x03(\x0b\x32\x11.main.CommandSpec\"#\n\x10SchedulerRequest\x12\x0f\n\x07\x65nabled\x18\x01 \x01(\x08\"$\n\x11SchedulerResponse\x12\x0f\n\x07\x65nabled\x18\x01 \x01(\x08\"\x11\n\x0fSimulateRequest\"\x12\n\x10SimulateResponse\"Z\n\nAuditQuery\x12\r\n\x05since\x18\x01 \x01(\t\x12\r\n\x05until\x18\x02 \x01(\t\x12\x0f\n\x07\x61\x63tions\x18\x03 \x03(\t\x12\x0e\n\x06\x63\x61ller\x18\x04 \x01(\t\x12\r\n\x05limit\x18\x05 \x01(\x03\"\xaa\x01\n\nAuditEntry\x12\x0c\n\x04time\x18\x01 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x02 \x01(\t\x12\x0e\n\x06method\x18\x03 \x01(\t\x12\x0e\n\x06\x63\x61ller\x18\x04 \x01(\t\x12\x0c\n\x04role\x18\x05 \x01(\t\x12\x12\n\nauthMethod\x18\x06 \x01(\t\x12\x0c\n\x04peer\x18\x07 \x01(\t\x12\x0f\n\x07request\x18\x08 \x01(\t\x12\x0e\n\x06result\x18\t \x01(\t\x12\r\n\x05\x65rror\x18\n \x01(\t\"3\n\x0e\x41uditEntryList\x12!\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x10.main.AuditEntry\"B\n\rRecordRequest\x12+\n\x08\x64uration\x18\x02 \x01(\x0b\x32\x19.google.protobuf.DurationJ\x04\x08\x01\x10\x02\"N\n\x0bRecordedLog\x12\x0f\n\x07\x61rrival\x18\x01 \x01(\x03\x12\x1d\n\x03log\x18\x02 \x01(\x0b\x32\x10.main.ComplexLog\x12\x0f\n\x07\x64ropped\x18\x03 \x01(\x04\"\x97\x01\n\x0eReplayResponse\x12\x10\n\x08replayed\x18\x01 \x01(\x03\x12\x0c\n\x04live\x18\x02 \x01(\x08\x12\x34\n\x08logtypes\x18\x03 \x03(\x0b\x32\".main.ReplayResponse.LogtypesEntry\x1a/\n\rLogtypesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"\"\n\x0fTopologyRequest\x12\x0f\n\x07history\x18\x01 \x01(\x03\",\n\x0cTopologyLink\x12\x0c\n\x04node\x18\x01 \x01(\x03\x12\x0e\n\x06parent\x18\x02 \x01(\x03\"o\n\x0eTopologyChange\x12\x0c\n\x04time\x18\x01 \x01(\t\x12\x0e\n\x06source\x18\x02 \x01(\t\x12\x0e\n\x06joined\x18\x03 \x03(\x03\x12\x0c\n\x04left\x18\x04 \x03(\x03\x12!\n\x05moved\x18\x05 \x03(\x0b\x32\x12.main.TopologyLink\"\x87\x01\n\x0cMeshTopology\x12\x0c\n\x04root\x18\x01 \x01(\x03\x12\x0e\n\x06source\x18\x02 \x01(\t\x12\x0f\n\x07updated\x18\x03 \x01(\t\x12!\n\x05links\x18\x04 \x03(\x0b\x32\x12.main.TopologyLink\x12%\n\x07history\x18\x05 \x03(\x0b\x32\x14.main.TopologyChange*i\n\tPingScope\x12\x1a\n\x16PING_SCOPE_UNSPECIFIED\x10\x00\x12\x13\n\x0fPING_SCOPE_MESH\x10\x01\x12\x13\n\x0fPING_SCOPE_NODE\x10\x02\x12\x16\n\x12PING_SCOPE_CONTROL\x10\x03*Q\n\x08PingType\x12\x19\n\x15PING_TYPE_UNSPECIFIED\x10\x00\x12\x14\n\x10PING_TYPE_SENSOR\x10\x01\x12\x14\n\x10PING_TYPE_CONFIG\x10\x02*\xe5\x01\n\x0c\x43ommandState\x12\x1d\n\x19\x43OMMAND_STATE_UNSPECIFIED\x10\x00\x12\x18\n\x14\x43OMMAND_STATE_QUEUED\x10\x01\x12\x16\n\x12\x43OMMAND_STATE_SENT\x10\x02\x12\x17\n\x13\x43OMMAND_STATE_ACKED\x10\x03\x12\x18\n\x14\x43OMMAND_STATE_FAILED\x10\x04\x12\x1a\n\x16\x43OMMAND_STATE_OBSERVED\x10\x05\x12\x1a\n\x16\x43OMMAND_STATE_RETRYING\x10\x06\x12\x19\n\x15\x43OMMAND_STATE_EXPIRED\x10\x07\x32l\n\tInterface\x12+\n\x04Read\x12\r.main.Trigger\x1a\x10.main.ComplexLog\"\x00\x30\x01\x12\x32\n\x05Write\x12\x14.main.ControlCommand\x1a\x11.main.Acknowledge\"\x00\x32\x88\x04\n\x0cOrchestrator\x12/\n\x06Status\x12\r.main.Trigger\x1a\x14.main.MeshOrchStatus\"\x00\x12\x30\n\nConnection\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12-\n\x07Observe\x12\r.main.Trigger\x1a\x0f.main.SimpleLog\"\x00\x30\x01\x12*\n\x04Ping\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12+\n\x08Nodelist\x12\r.main.Trigger\x1a\x0e.main.NodeList\"\x00\x12\x34\n\x07\x43ommand\x12\x14.main.ControlCommand\x1a\x11.main.Acknowledge\"\x00\x12\x35\n\x0fSchedulerToggle\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12.\n\x08Simulate\x12\r.main.Trigger\x1a\x11.main.Acknowledge\"\x00\x12\x33\n\nPingStream\x12\r.main.Trigger\x1a\x12.main.PingResponse\"\x00\x30\x01\x12;\n\x0eObserveComplex\x12\x13.main.ObserveFilter\x1a\x10.main.ComplexLog\"\x00\x30\x01\x32\xaf\x0b\n\x0eOrchestratorV2\x12\x35\n\x06Status\x12\x13.main.StatusRequest\x1a\x14.main.MeshOrchStatus\"\x00\x12\x44\n\rSetConnection\x12\x17.main.ConnectionRequest\x1a\x18.main.ConnectionResponse\"\x00\x12\x34\n\x07Observe\x12\x13.main.ObserveFilter\x1a\x10.main.ComplexLog\"\x00\x30\x01\x12.\n\x04Ping\x12\x11.main.PingRequest\x1a\x11.main.PingReceipt\"\x00\x12\x37\n\nPingStream\x12\x11.main.PingRequest\x1a\x12.main.PingResponse\"\x00\x30\x01\x12\x33\n\x08Nodelist\x12\x15.main.NodelistRequest\x1a\x0e.main.NodeList\"\x00\x12\x38\n\x07\x43ommand\x12\x14.main.CommandRequest\x1a\x15.main.CommandResponse\"\x00\x12I\n\x10\x44\x65scribeCommands\x12\x1d.main.DescribeCommandsRequest\x1a\x14.main.CommandCatalog\"\x00\x12\x42\n\rCommandStatus\x12\x1a.main.CommandStatusRequest\x1a\x13.main.CommandRecord\"\x00\x12\x44\n\x0cListCommands\x12\x19.main.ListCommandsRequest\x1a\x17.main.CommandRecordList\"\x00\x12\x41\n\x0cSetScheduler\x12\x16.main.SchedulerRequest\x1a\x17.main.SchedulerResponse\"\x00\x12;\n\x08Simulate\x12\x15.main.SimulateRequest\x1a\x16.main.SimulateResponse\"\x00\x12\x36\n\nQueryAudit\x12\x10.main.AuditQuery\x1a\x14.main.AuditEntryList\"\x00\x12>\n\nListOutbox\x12\x17.main.ListOutboxRequest\x1a\x15.main.OutboxEntryList\"\x00\x12\x44\n\x0bPurgeOutbox\x12\x18.main.PurgeOutboxRequest\x1a\x19.main.PurgeOutboxResponse\"\x00\x12\x34\n\x06Record\x12\x13.main.RecordRequest\x1a\x11.main.RecordedLog\"\x00\x30\x01\x12\x34\n\x06Replay\x12\x10.main.ComplexLog\x1a\x14.main.ReplayResponse\"\x00(\x01\x12\x37\n\x08Topology\x12\x15.main.TopologyRequest\x1a\x12.main.MeshTopology\"\x00\x12\x31\n\x07GetNode\x12\x14.main.GetNodeRequest\x1a\x0e.main.NodeInfo\"\x00\x12?\n\rSetNodeRecord\x12\x1a.main.SetNodeRecordRequest\x1a\x10.main.NodeRecord\"\x00\x12\x45\n\x10\x44\x65leteNodeRecord\x12\x1d.main.DeleteNodeRecordRequest\x1a\x10.main.NodeRecord\"\x00\x12G\n\x0fListNodeRecords\x12\x1c.main.ListNodeRecordsRequest\x1a\x14.main.NodeRecordList\"\x00\x12V\n\x11ImportNodeRecords\x12\x1e.main.ImportNodeRecordsRequest\x1a\x1f.main.ImportNodeRecordsResponse\"\x00\x42\x08Z\x06/protob\x06proto3'
+  ,
+  dependencies=[google_dot_protobuf_dot_duration__pb2.DESCRIPTOR,])
+
+_PINGSCOPE = _descriptor.EnumDescriptor(
+  name='PingScope',
+  full_name='main.PingScope',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='PING_SCOPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PING_SCOPE_MESH', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PING_SCOPE_NODE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PING_SCOPE_CONTROL', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6272,
+  serialized_end=6377,
+)
+_sym_db.RegisterEnumDescriptor(_PINGSCOPE)
+
+PingScope = enum_type_wrapper.EnumTypeWrapper(_PINGSCOPE)
+_PINGTYPE = _descriptor.EnumDescriptor(
+  name='PingType',
+  full_name='main.PingType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='PING_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PING_TYPE_SENSOR', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PING_TYPE_CONFIG', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6379,
+  serialized_end=6460,
 )
+_sym_db.RegisterEnumDescriptor(_PINGTYPE)
 
+PingType = enum_type_wrapper.EnumTypeWrapper(_PINGTYPE)
+_COMMANDSTATE = _descriptor.EnumDescriptor(
+  name='CommandState',
+  full_name='main.CommandState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_QUEUED', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_SENT', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_ACKED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_FAILED', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_OBSERVED', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_RETRYING', index=6, number=6,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_STATE_EXPIRED', index=7, number=7,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6463,
+  serialized_end=6692,
+)
+_sym_db.RegisterEnumDescriptor(_COMMANDSTATE)
+
+CommandState = enum_type_wrapper.EnumTypeWrapper(_COMMANDSTATE)
+PING_SCOPE_UNSPECIFIED = 0
+PING_SCOPE_MESH = 1
+PING_SCOPE_NODE = 2
+PING_SCOPE_CONTROL = 3
+PING_TYPE_UNSPECIFIED = 0
+PING_TYPE_SENSOR = 1
+PING_TYPE_CONFIG = 2
+COMMAND_STATE_UNSPECIFIED = 0
+COMMAND_STATE_QUEUED = 1
+COMMAND_STATE_SENT = 2
+COMMAND_STATE_ACKED = 3
+COMMAND_STATE_FAILED = 4
+COMMAND_STATE_OBSERVED = 5
+COMMAND_STATE_RETRYING = 6
+COMMAND_STATE_EXPIRED = 7
 
 
 
@@ -59,8 +200,8 @@ _TRIGGER_METADATAENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=112,
-  serialized_end=159,
+  serialized_start=144,
+  serialized_end=191,
 )
 
 _TRIGGER = _descriptor.Descriptor(
@@ -97,8 +238,8 @@ _TRIGGER = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=30,
-  serialized_end=159,
+  serialized_start=62,
+  serialized_end=191,
 )
 
 
@@ -136,8 +277,8 @@ _ACKNOWLEDGE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=161,
-  serialized_end=206,
+  serialized_start=193,
+  serialized_end=238,
 )
 
 
@@ -198,6 +339,270 @@ _MESHORCHSTATUS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='link', full_name='main.MeshOrchStatus.link', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='components', full_name='main.MeshOrchStatus.components', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='degraded', full_name='main.MeshOrchStatus.degraded', index=9,
+      number=10, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cloudPending', full_name='main.MeshOrchStatus.cloudPending', index=10,
+      number=11, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='queues', full_name='main.MeshOrchStatus.queues', index=11,
+      number=12, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=241,
+  serialized_end=559,
+)
+
+
+_QUEUESTATUS = _descriptor.Descriptor(
+  name='QueueStatus',
+  full_name='main.QueueStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='main.QueueStatus.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='policy', full_name='main.QueueStatus.policy', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='capacity', full_name='main.QueueStatus.capacity', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='depth', full_name='main.QueueStatus.depth', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='highWater', full_name='main.QueueStatus.highWater', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='enqueued', full_name='main.QueueStatus.enqueued', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dropped', full_name='main.QueueStatus.dropped', index=6,
+      number=7, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rejected', full_name='main.QueueStatus.rejected', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='deferred', full_name='main.QueueStatus.deferred', index=8,
+      number=9, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=562,
+  serialized_end=728,
+)
+
+
+_COMPONENTSTATUS = _descriptor.Descriptor(
+  name='ComponentStatus',
+  full_name='main.ComponentStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='component', full_name='main.ComponentStatus.component', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='healthy', full_name='main.ComponentStatus.healthy', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='main.ComponentStatus.reason', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='updated', full_name='main.ComponentStatus.updated', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=730,
+  serialized_end=816,
+)
+
+
+_LINKSTATUS = _descriptor.Descriptor(
+  name='LinkStatus',
+  full_name='main.LinkStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='main.LinkStatus.state', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='since', full_name='main.LinkStatus.since', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reconnects', full_name='main.LinkStatus.reconnects', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='attempts', full_name='main.LinkStatus.attempts', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='nextRetry', full_name='main.LinkStatus.nextRetry', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lastError', full_name='main.LinkStatus.lastError', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='outageStart', full_name='main.LinkStatus.outageStart', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lastOutageStart', full_name='main.LinkStatus.lastOutageStart', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lastOutageEnd', full_name='main.LinkStatus.lastOutageEnd', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -210,8 +615,8 @@ _MESHORCHSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=209,
-  serialized_end=377,
+  serialized_start=819,
+  serialized_end=1006,
 )
 
 
@@ -242,8 +647,8 @@ _SIMPLELOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=379,
-  serialized_end=407,
+  serialized_start=1008,
+  serialized_end=1036,
 )
 
 
@@ -281,8 +686,8 @@ _COMPLEXLOG_LOGMETADATAENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=553,
-  serialized_end=603,
+  serialized_start=1182,
+  serialized_end=1232,
 )
 
 _COMPLEXLOG = _descriptor.Descriptor(
@@ -340,8 +745,8 @@ _COMPLEXLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=410,
-  serialized_end=603,
+  serialized_start=1039,
+  serialized_end=1232,
 )
 
 
@@ -379,8 +784,8 @@ _CONTROLCOMMAND_METADATAENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=112,
-  serialized_end=159,
+  serialized_start=144,
+  serialized_end=191,
 )
 
 _CONTROLCOMMAND = _descriptor.Descriptor(
@@ -417,52 +822,585 @@ _CONTROLCOMMAND = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=606,
-  serialized_end=742,
+  serialized_start=1235,
+  serialized_end=1371,
 )
 
 
-_NODELIST_NODESENTRY = _descriptor.Descriptor(
-  name='NodesEntry',
-  full_name='main.NodeList.NodesEntry',
+_NODELIVENESS = _descriptor.Descriptor(
+  name='NodeLiveness',
+  full_name='main.NodeLiveness',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='main.NodeList.NodesEntry.key', index=0,
-      number=1, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='state', full_name='main.NodeLiveness.state', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='value', full_name='main.NodeList.NodesEntry.value', index=1,
+      name='lastSeen', full_name='main.NodeLiveness.lastSeen', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='since', full_name='main.NodeLiveness.since', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='missedPings', full_name='main.NodeLiveness.missedPings', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=b'8\001',
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=798,
-  serialized_end=842,
+  serialized_start=1373,
+  serialized_end=1456,
 )
 
-_NODELIST = _descriptor.Descriptor(
-  name='NodeList',
-  full_name='main.NodeList',
+
+_NODEREADING_SENSORDATAENTRY = _descriptor.Descriptor(
+  name='SensordataEntry',
+  full_name='main.NodeReading.SensordataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.NodeReading.SensordataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.NodeReading.SensordataEntry.value', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1584,
+  serialized_end=1633,
+)
+
+_NODEREADING = _descriptor.Descriptor(
+  name='NodeReading',
+  full_name='main.NodeReading',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pingID', full_name='main.NodeReading.pingID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='main.NodeReading.time', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sensordata', full_name='main.NodeReading.sensordata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='fireProbability', full_name='main.NodeReading.fireProbability', index=3,
+      number=4, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_NODEREADING_SENSORDATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1459,
+  serialized_end=1633,
+)
+
+
+_NODEINFO = _descriptor.Descriptor(
+  name='NodeInfo',
+  full_name='main.NodeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.NodeInfo.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='serialBaud', full_name='main.NodeInfo.serialBaud', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dhtType', full_name='main.NodeInfo.dhtType', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dhtPin', full_name='main.NodeInfo.dhtPin', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='flmType', full_name='main.NodeInfo.flmType', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='flmPin', full_name='main.NodeInfo.flmPin', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='gasType', full_name='main.NodeInfo.gasType', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='gasPin', full_name='main.NodeInfo.gasPin', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pinger', full_name='main.NodeInfo.pinger', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pingerPin', full_name='main.NodeInfo.pingerPin', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='connectPin', full_name='main.NodeInfo.connectPin', index=10,
+      number=11, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='config', full_name='main.NodeInfo.config', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='capabilities', full_name='main.NodeInfo.capabilities', index=12,
+      number=13, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lastReading', full_name='main.NodeInfo.lastReading', index=13,
+      number=14, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='liveness', full_name='main.NodeInfo.liveness', index=14,
+      number=15, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='record', full_name='main.NodeInfo.record', index=15,
+      number=16, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1636,
+  serialized_end=1984,
+)
+
+
+_NODELOCATION = _descriptor.Descriptor(
+  name='NodeLocation',
+  full_name='main.NodeLocation',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='latitude', full_name='main.NodeLocation.latitude', index=0,
+      number=1, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='longitude', full_name='main.NodeLocation.longitude', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1986,
+  serialized_end=2037,
+)
+
+
+_NODERECORD_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='main.NodeRecord.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.NodeRecord.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.NodeRecord.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2197,
+  serialized_end=2242,
+)
+
+_NODERECORD = _descriptor.Descriptor(
+  name='NodeRecord',
+  full_name='main.NodeRecord',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.NodeRecord.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='main.NodeRecord.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='main.NodeRecord.labels', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='location', full_name='main.NodeRecord.location', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='zone', full_name='main.NodeRecord.zone', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='updated', full_name='main.NodeRecord.updated', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_NODERECORD_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2040,
+  serialized_end=2242,
+)
+
+
+_NODERECORDLIST = _descriptor.Descriptor(
+  name='NodeRecordList',
+  full_name='main.NodeRecordList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='records', full_name='main.NodeRecordList.records', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2244,
+  serialized_end=2295,
+)
+
+
+_NODELIST_NODESENTRY = _descriptor.Descriptor(
+  name='NodesEntry',
+  full_name='main.NodeList.NodesEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.NodeList.NodesEntry.key', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.NodeList.NodesEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2446,
+  serialized_end=2490,
+)
+
+_NODELIST_LIVENESSENTRY = _descriptor.Descriptor(
+  name='LivenessEntry',
+  full_name='main.NodeList.LivenessEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.NodeList.LivenessEntry.key', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.NodeList.LivenessEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2492,
+  serialized_end=2559,
+)
+
+_NODELIST_DETAILSENTRY = _descriptor.Descriptor(
+  name='DetailsEntry',
+  full_name='main.NodeList.DetailsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.NodeList.DetailsEntry.key', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.NodeList.DetailsEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2561,
+  serialized_end=2623,
+)
+
+_NODELIST = _descriptor.Descriptor(
+  name='NodeList',
+  full_name='main.NodeList',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -475,10 +1413,2145 @@ _NODELIST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='liveness', full_name='main.NodeList.liveness', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='details', full_name='main.NodeList.details', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_NODELIST_NODESENTRY, _NODELIST_LIVENESSENTRY, _NODELIST_DETAILSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2298,
+  serialized_end=2623,
+)
+
+
+_PINGRESPONSE_SENSORDATAENTRY = _descriptor.Descriptor(
+  name='SensordataEntry',
+  full_name='main.PingResponse.SensordataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.PingResponse.SensordataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.PingResponse.SensordataEntry.value', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1584,
+  serialized_end=1633,
+)
+
+_PINGRESPONSE_CONFIGDATAENTRY = _descriptor.Descriptor(
+  name='ConfigdataEntry',
+  full_name='main.PingResponse.ConfigdataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.PingResponse.ConfigdataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.PingResponse.ConfigdataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2909,
+  serialized_end=2958,
+)
+
+_PINGRESPONSE = _descriptor.Descriptor(
+  name='PingResponse',
+  full_name='main.PingResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pingID', full_name='main.PingResponse.pingID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.PingResponse.node', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pingtype', full_name='main.PingResponse.pingtype', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pingtime', full_name='main.PingResponse.pingtime', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sensordata', full_name='main.PingResponse.sensordata', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='configdata', full_name='main.PingResponse.configdata', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='complete', full_name='main.PingResponse.complete', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='unanswered', full_name='main.PingResponse.unanswered', index=7,
+      number=8, type=3, cpp_type=2, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_PINGRESPONSE_SENSORDATAENTRY, _PINGRESPONSE_CONFIGDATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2626,
+  serialized_end=2958,
+)
+
+
+_OBSERVEFILTER_METADATAENTRY = _descriptor.Descriptor(
+  name='MetadataEntry',
+  full_name='main.ObserveFilter.MetadataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.ObserveFilter.MetadataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.ObserveFilter.MetadataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=144,
+  serialized_end=191,
+)
+
+_OBSERVEFILTER = _descriptor.Descriptor(
+  name='ObserveFilter',
+  full_name='main.ObserveFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sources', full_name='main.ObserveFilter.sources', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='types', full_name='main.ObserveFilter.types', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='main.ObserveFilter.metadata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='severity', full_name='main.ObserveFilter.severity', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_OBSERVEFILTER_METADATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2961,
+  serialized_end=3128,
+)
+
+
+_STATUSREQUEST = _descriptor.Descriptor(
+  name='StatusRequest',
+  full_name='main.StatusRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3130,
+  serialized_end=3145,
+)
+
+
+_CONNECTIONREQUEST = _descriptor.Descriptor(
+  name='ConnectionRequest',
+  full_name='main.ConnectionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='connected', full_name='main.ConnectionRequest.connected', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3147,
+  serialized_end=3185,
+)
+
+
+_CONNECTIONRESPONSE = _descriptor.Descriptor(
+  name='ConnectionResponse',
+  full_name='main.ConnectionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='connected', full_name='main.ConnectionResponse.connected', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3187,
+  serialized_end=3226,
+)
+
+
+_PINGREQUEST = _descriptor.Descriptor(
+  name='PingRequest',
+  full_name='main.PingRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='scope', full_name='main.PingRequest.scope', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='main.PingRequest.type', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.PingRequest.node', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='phrase', full_name='main.PingRequest.phrase', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timeout', full_name='main.PingRequest.timeout', index=4,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3229,
+  serialized_end=3395,
+)
+
+
+_PINGRECEIPT = _descriptor.Descriptor(
+  name='PingReceipt',
+  full_name='main.PingReceipt',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pingID', full_name='main.PingReceipt.pingID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3397,
+  serialized_end=3426,
+)
+
+
+_NODELISTREQUEST = _descriptor.Descriptor(
+  name='NodelistRequest',
+  full_name='main.NodelistRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3428,
+  serialized_end=3445,
+)
+
+
+_GETNODEREQUEST = _descriptor.Descriptor(
+  name='GetNodeRequest',
+  full_name='main.GetNodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.GetNodeRequest.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3447,
+  serialized_end=3477,
+)
+
+
+_SETNODERECORDREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='main.SetNodeRecordRequest.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.SetNodeRecordRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.SetNodeRecordRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2197,
+  serialized_end=2242,
+)
+
+_SETNODERECORDREQUEST = _descriptor.Descriptor(
+  name='SetNodeRecordRequest',
+  full_name='main.SetNodeRecordRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.SetNodeRecordRequest.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='main.SetNodeRecordRequest.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='zone', full_name='main.SetNodeRecordRequest.zone', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='location', full_name='main.SetNodeRecordRequest.location', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='main.SetNodeRecordRequest.labels', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='removeLabels', full_name='main.SetNodeRecordRequest.removeLabels', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='update', full_name='main.SetNodeRecordRequest.update', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SETNODERECORDREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3480,
+  serialized_end=3723,
+)
+
+
+_DELETENODERECORDREQUEST = _descriptor.Descriptor(
+  name='DeleteNodeRecordRequest',
+  full_name='main.DeleteNodeRecordRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.DeleteNodeRecordRequest.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3725,
+  serialized_end=3764,
+)
+
+
+_LISTNODERECORDSREQUEST = _descriptor.Descriptor(
+  name='ListNodeRecordsRequest',
+  full_name='main.ListNodeRecordsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='zone', full_name='main.ListNodeRecordsRequest.zone', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3766,
+  serialized_end=3804,
+)
+
+
+_IMPORTNODERECORDSREQUEST = _descriptor.Descriptor(
+  name='ImportNodeRecordsRequest',
+  full_name='main.ImportNodeRecordsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='records', full_name='main.ImportNodeRecordsRequest.records', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replace', full_name='main.ImportNodeRecordsRequest.replace', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3806,
+  serialized_end=3884,
+)
+
+
+_IMPORTNODERECORDSRESPONSE = _descriptor.Descriptor(
+  name='ImportNodeRecordsResponse',
+  full_name='main.ImportNodeRecordsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='imported', full_name='main.ImportNodeRecordsResponse.imported', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3886,
+  serialized_end=3931,
+)
+
+
+_COMMANDREQUEST_METADATAENTRY = _descriptor.Descriptor(
+  name='MetadataEntry',
+  full_name='main.CommandRequest.MetadataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.CommandRequest.MetadataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.CommandRequest.MetadataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=144,
+  serialized_end=191,
+)
+
+_COMMANDREQUEST = _descriptor.Descriptor(
+  name='CommandRequest',
+  full_name='main.CommandRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='command', full_name='main.CommandRequest.command', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='main.CommandRequest.metadata', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_COMMANDREQUEST_METADATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3934,
+  serialized_end=4070,
+)
+
+
+_COMMANDRESPONSE = _descriptor.Descriptor(
+  name='CommandResponse',
+  full_name='main.CommandResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='command', full_name='main.CommandResponse.command', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='commandID', full_name='main.CommandResponse.commandID', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4072,
+  serialized_end=4125,
+)
+
+
+_COMMANDSTATUSREQUEST = _descriptor.Descriptor(
+  name='CommandStatusRequest',
+  full_name='main.CommandStatusRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commandID', full_name='main.CommandStatusRequest.commandID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4127,
+  serialized_end=4168,
+)
+
+
+_COMMANDRECORD_METADATAENTRY = _descriptor.Descriptor(
+  name='MetadataEntry',
+  full_name='main.CommandRecord.MetadataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.CommandRecord.MetadataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.CommandRecord.MetadataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=144,
+  serialized_end=191,
+)
+
+_COMMANDRECORD = _descriptor.Descriptor(
+  name='CommandRecord',
+  full_name='main.CommandRecord',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commandID', full_name='main.CommandRecord.commandID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='main.CommandRecord.command', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='main.CommandRecord.metadata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='main.CommandRecord.state', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='main.CommandRecord.error', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='created', full_name='main.CommandRecord.created', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='updated', full_name='main.CommandRecord.updated', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='responses', full_name='main.CommandRecord.responses', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_COMMANDRECORD_METADATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4171,
+  serialized_end=4427,
+)
+
+
+_LISTCOMMANDSREQUEST = _descriptor.Descriptor(
+  name='ListCommandsRequest',
+  full_name='main.ListCommandsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='main.ListCommandsRequest.state', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='main.ListCommandsRequest.limit', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4429,
+  serialized_end=4500,
+)
+
+
+_COMMANDRECORDLIST = _descriptor.Descriptor(
+  name='CommandRecordList',
+  full_name='main.CommandRecordList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commands', full_name='main.CommandRecordList.commands', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4502,
+  serialized_end=4560,
+)
+
+
+_LISTOUTBOXREQUEST = _descriptor.Descriptor(
+  name='ListOutboxRequest',
+  full_name='main.ListOutboxRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4562,
+  serialized_end=4581,
+)
+
+
+_OUTBOXENTRY_METADATAENTRY = _descriptor.Descriptor(
+  name='MetadataEntry',
+  full_name='main.OutboxEntry.MetadataEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.OutboxEntry.MetadataEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.OutboxEntry.MetadataEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=144,
+  serialized_end=191,
+)
+
+_OUTBOXENTRY = _descriptor.Descriptor(
+  name='OutboxEntry',
+  full_name='main.OutboxEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commandID', full_name='main.OutboxEntry.commandID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='main.OutboxEntry.command', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='main.OutboxEntry.metadata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='queued', full_name='main.OutboxEntry.queued', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='expires', full_name='main.OutboxEntry.expires', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='attempts', full_name='main.OutboxEntry.attempts', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lastError', full_name='main.OutboxEntry.lastError', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_OUTBOXENTRY_METADATAENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4584,
+  serialized_end=4803,
+)
+
+
+_OUTBOXENTRYLIST = _descriptor.Descriptor(
+  name='OutboxEntryList',
+  full_name='main.OutboxEntryList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='entries', full_name='main.OutboxEntryList.entries', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4805,
+  serialized_end=4858,
+)
+
+
+_PURGEOUTBOXREQUEST = _descriptor.Descriptor(
+  name='PurgeOutboxRequest',
+  full_name='main.PurgeOutboxRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commandIDs', full_name='main.PurgeOutboxRequest.commandIDs', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='all', full_name='main.PurgeOutboxRequest.all', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4860,
+  serialized_end=4913,
+)
+
+
+_PURGEOUTBOXRESPONSE = _descriptor.Descriptor(
+  name='PurgeOutboxResponse',
+  full_name='main.PurgeOutboxResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commandIDs', full_name='main.PurgeOutboxResponse.commandIDs', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4915,
+  serialized_end=4956,
+)
+
+
+_DESCRIBECOMMANDSREQUEST = _descriptor.Descriptor(
+  name='DescribeCommandsRequest',
+  full_name='main.DescribeCommandsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4958,
+  serialized_end=4983,
+)
+
+
+_COMMANDKEY = _descriptor.Descriptor(
+  name='CommandKey',
+  full_name='main.CommandKey',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.CommandKey.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='main.CommandKey.type', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='required', full_name='main.CommandKey.required', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='main.CommandKey.description', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4985,
+  serialized_end=5063,
+)
+
+
+_COMMANDSPEC = _descriptor.Descriptor(
+  name='CommandSpec',
+  full_name='main.CommandSpec',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='command', full_name='main.CommandSpec.command', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='main.CommandSpec.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='keys', full_name='main.CommandSpec.keys', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5065,
+  serialized_end=5148,
+)
+
+
+_COMMANDCATALOG = _descriptor.Descriptor(
+  name='CommandCatalog',
+  full_name='main.CommandCatalog',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='commands', full_name='main.CommandCatalog.commands', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5150,
+  serialized_end=5203,
+)
+
+
+_SCHEDULERREQUEST = _descriptor.Descriptor(
+  name='SchedulerRequest',
+  full_name='main.SchedulerRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='enabled', full_name='main.SchedulerRequest.enabled', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5205,
+  serialized_end=5240,
+)
+
+
+_SCHEDULERRESPONSE = _descriptor.Descriptor(
+  name='SchedulerResponse',
+  full_name='main.SchedulerResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='enabled', full_name='main.SchedulerResponse.enabled', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5242,
+  serialized_end=5278,
+)
+
+
+_SIMULATEREQUEST = _descriptor.Descriptor(
+  name='SimulateRequest',
+  full_name='main.SimulateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5280,
+  serialized_end=5297,
+)
+
+
+_SIMULATERESPONSE = _descriptor.Descriptor(
+  name='SimulateResponse',
+  full_name='main.SimulateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5299,
+  serialized_end=5317,
+)
+
+
+_AUDITQUERY = _descriptor.Descriptor(
+  name='AuditQuery',
+  full_name='main.AuditQuery',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='since', full_name='main.AuditQuery.since', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='until', full_name='main.AuditQuery.until', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='actions', full_name='main.AuditQuery.actions', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='caller', full_name='main.AuditQuery.caller', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='main.AuditQuery.limit', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5319,
+  serialized_end=5409,
+)
+
+
+_AUDITENTRY = _descriptor.Descriptor(
+  name='AuditEntry',
+  full_name='main.AuditEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time', full_name='main.AuditEntry.time', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='action', full_name='main.AuditEntry.action', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='method', full_name='main.AuditEntry.method', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='caller', full_name='main.AuditEntry.caller', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='role', full_name='main.AuditEntry.role', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='authMethod', full_name='main.AuditEntry.authMethod', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='peer', full_name='main.AuditEntry.peer', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='request', full_name='main.AuditEntry.request', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='result', full_name='main.AuditEntry.result', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='main.AuditEntry.error', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5412,
+  serialized_end=5582,
+)
+
+
+_AUDITENTRYLIST = _descriptor.Descriptor(
+  name='AuditEntryList',
+  full_name='main.AuditEntryList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='entries', full_name='main.AuditEntryList.entries', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5584,
+  serialized_end=5635,
+)
+
+
+_RECORDREQUEST = _descriptor.Descriptor(
+  name='RecordRequest',
+  full_name='main.RecordRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='duration', full_name='main.RecordRequest.duration', index=0,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5637,
+  serialized_end=5703,
+)
+
+
+_RECORDEDLOG = _descriptor.Descriptor(
+  name='RecordedLog',
+  full_name='main.RecordedLog',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='arrival', full_name='main.RecordedLog.arrival', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='log', full_name='main.RecordedLog.log', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dropped', full_name='main.RecordedLog.dropped', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5705,
+  serialized_end=5783,
+)
+
+
+_REPLAYRESPONSE_LOGTYPESENTRY = _descriptor.Descriptor(
+  name='LogtypesEntry',
+  full_name='main.ReplayResponse.LogtypesEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='main.ReplayResponse.LogtypesEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='main.ReplayResponse.LogtypesEntry.value', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5890,
+  serialized_end=5937,
+)
+
+_REPLAYRESPONSE = _descriptor.Descriptor(
+  name='ReplayResponse',
+  full_name='main.ReplayResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='replayed', full_name='main.ReplayResponse.replayed', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='live', full_name='main.ReplayResponse.live', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='logtypes', full_name='main.ReplayResponse.logtypes', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_REPLAYRESPONSE_LOGTYPESENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5786,
+  serialized_end=5937,
+)
+
+
+_TOPOLOGYREQUEST = _descriptor.Descriptor(
+  name='TopologyRequest',
+  full_name='main.TopologyRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='history', full_name='main.TopologyRequest.history', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5939,
+  serialized_end=5973,
+)
+
+
+_TOPOLOGYLINK = _descriptor.Descriptor(
+  name='TopologyLink',
+  full_name='main.TopologyLink',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='main.TopologyLink.node', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='parent', full_name='main.TopologyLink.parent', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5975,
+  serialized_end=6019,
+)
+
+
+_TOPOLOGYCHANGE = _descriptor.Descriptor(
+  name='TopologyChange',
+  full_name='main.TopologyChange',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time', full_name='main.TopologyChange.time', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='source', full_name='main.TopologyChange.source', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='joined', full_name='main.TopologyChange.joined', index=2,
+      number=3, type=3, cpp_type=2, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='left', full_name='main.TopologyChange.left', index=3,
+      number=4, type=3, cpp_type=2, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='moved', full_name='main.TopologyChange.moved', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6021,
+  serialized_end=6132,
+)
+
+
+_MESHTOPOLOGY = _descriptor.Descriptor(
+  name='MeshTopology',
+  full_name='main.MeshTopology',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='root', full_name='main.MeshTopology.root', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='source', full_name='main.MeshTopology.source', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='updated', full_name='main.MeshTopology.updated', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='links', full_name='main.MeshTopology.links', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='history', full_name='main.MeshTopology.history', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
-  nested_types=[_NODELIST_NODESENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -487,115 +3560,693 @@ _NODELIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=744,
-  serialized_end=842,
+  serialized_start=6135,
+  serialized_end=6270,
 )
 
 _TRIGGER_METADATAENTRY.containing_type = _TRIGGER
 _TRIGGER.fields_by_name['metadata'].message_type = _TRIGGER_METADATAENTRY
 _MESHORCHSTATUS.fields_by_name['nodelist'].message_type = _NODELIST
+_MESHORCHSTATUS.fields_by_name['link'].message_type = _LINKSTATUS
+_MESHORCHSTATUS.fields_by_name['components'].message_type = _COMPONENTSTATUS
+_MESHORCHSTATUS.fields_by_name['queues'].message_type = _QUEUESTATUS
 _COMPLEXLOG_LOGMETADATAENTRY.containing_type = _COMPLEXLOG
 _COMPLEXLOG.fields_by_name['logmetadata'].message_type = _COMPLEXLOG_LOGMETADATAENTRY
 _CONTROLCOMMAND_METADATAENTRY.containing_type = _CONTROLCOMMAND
 _CONTROLCOMMAND.fields_by_name['metadata'].message_type = _CONTROLCOMMAND_METADATAENTRY
+_NODEREADING_SENSORDATAENTRY.containing_type = _NODEREADING
+_NODEREADING.fields_by_name['sensordata'].message_type = _NODEREADING_SENSORDATAENTRY
+_NODEINFO.fields_by_name['lastReading'].message_type = _NODEREADING
+_NODEINFO.fields_by_name['liveness'].message_type = _NODELIVENESS
+_NODEINFO.fields_by_name['record'].message_type = _NODERECORD
+_NODERECORD_LABELSENTRY.containing_type = _NODERECORD
+_NODERECORD.fields_by_name['labels'].message_type = _NODERECORD_LABELSENTRY
+_NODERECORD.fields_by_name['location'].message_type = _NODELOCATION
+_NODERECORDLIST.fields_by_name['records'].message_type = _NODERECORD
 _NODELIST_NODESENTRY.containing_type = _NODELIST
+_NODELIST_LIVENESSENTRY.fields_by_name['value'].message_type = _NODELIVENESS
+_NODELIST_LIVENESSENTRY.containing_type = _NODELIST
+_NODELIST_DETAILSENTRY.fields_by_name['value'].message_type = _NODEINFO
+_NODELIST_DETAILSENTRY.containing_type = _NODELIST
 _NODELIST.fields_by_name['nodes'].message_type = _NODELIST_NODESENTRY
+_NODELIST.fields_by_name['liveness'].message_type = _NODELIST_LIVENESSENTRY
+_NODELIST.fields_by_name['details'].message_type = _NODELIST_DETAILSENTRY
+_PINGRESPONSE_SENSORDATAENTRY.containing_type = _PINGRESPONSE
+_PINGRESPONSE_CONFIGDATAENTRY.containing_type = _PINGRESPONSE
+_PINGRESPONSE.fields_by_name['sensordata'].message_type = _PINGRESPONSE_SENSORDATAENTRY
+_PINGRESPONSE.fields_by_name['configdata'].message_type = _PINGRESPONSE_CONFIGDATAENTRY
+_OBSERVEFILTER_METADATAENTRY.containing_type = _OBSERVEFILTER
+_OBSERVEFILTER.fields_by_name['metadata'].message_type = _OBSERVEFILTER_METADATAENTRY
+_PINGREQUEST.fields_by_name['scope'].enum_type = _PINGSCOPE
+_PINGREQUEST.fields_by_name['type'].enum_type = _PINGTYPE
+_PINGREQUEST.fields_by_name['timeout'].message_type = google_dot_protobuf_dot_duration__pb2._DURATION
+_SETNODERECORDREQUEST_LABELSENTRY.containing_type = _SETNODERECORDREQUEST
+_SETNODERECORDREQUEST.fields_by_name['location'].message_type = _NODELOCATION
+_SETNODERECORDREQUEST.fields_by_name['labels'].message_type = _SETNODERECORDREQUEST_LABELSENTRY
+_IMPORTNODERECORDSREQUEST.fields_by_name['records'].message_type = _NODERECORD
+_COMMANDREQUEST_METADATAENTRY.containing_type = _COMMANDREQUEST
+_COMMANDREQUEST.fields_by_name['metadata'].message_type = _COMMANDREQUEST_METADATAENTRY
+_COMMANDRECORD_METADATAENTRY.containing_type = _COMMANDRECORD
+_COMMANDRECORD.fields_by_name['metadata'].message_type = _COMMANDRECORD_METADATAENTRY
+_COMMANDRECORD.fields_by_name['state'].enum_type = _COMMANDSTATE
+_LISTCOMMANDSREQUEST.fields_by_name['state'].enum_type = _COMMANDSTATE
+_COMMANDRECORDLIST.fields_by_name['commands'].message_type = _COMMANDRECORD
+_OUTBOXENTRY_METADATAENTRY.containing_type = _OUTBOXENTRY
+_OUTBOXENTRY.fields_by_name['metadata'].message_type = _OUTBOXENTRY_METADATAENTRY
+_OUTBOXENTRYLIST.fields_by_name['entries'].message_type = _OUTBOXENTRY
+_COMMANDSPEC.fields_by_name['keys'].message_type = _COMMANDKEY
+_COMMANDCATALOG.fields_by_name['commands'].message_type = _COMMANDSPEC
+_AUDITENTRYLIST.fields_by_name['entries'].message_type = _AUDITENTRY
+_RECORDREQUEST.fields_by_name['duration'].message_type = google_dot_protobuf_dot_duration__pb2._DURATION
+_RECORDEDLOG.fields_by_name['log'].message_type = _COMPLEXLOG
+_REPLAYRESPONSE_LOGTYPESENTRY.containing_type = _REPLAYRESPONSE
+_REPLAYRESPONSE.fields_by_name['logtypes'].message_type = _REPLAYRESPONSE_LOGTYPESENTRY
+_TOPOLOGYCHANGE.fields_by_name['moved'].message_type = _TOPOLOGYLINK
+_MESHTOPOLOGY.fields_by_name['links'].message_type = _TOPOLOGYLINK
+_MESHTOPOLOGY.fields_by_name['history'].message_type = _TOPOLOGYCHANGE
 DESCRIPTOR.message_types_by_name['Trigger'] = _TRIGGER
 DESCRIPTOR.message_types_by_name['Acknowledge'] = _ACKNOWLEDGE
 DESCRIPTOR.message_types_by_name['MeshOrchStatus'] = _MESHORCHSTATUS
+DESCRIPTOR.message_types_by_name['QueueStatus'] = _QUEUESTATUS
+DESCRIPTOR.message_types_by_name['ComponentStatus'] = _COMPONENTSTATUS
+DESCRIPTOR.message_types_by_name['LinkStatus'] = _LINKSTATUS
 DESCRIPTOR.message_types_by_name['SimpleLog'] = _SIMPLELOG
 DESCRIPTOR.message_types_by_name['ComplexLog'] = _COMPLEXLOG
 DESCRIPTOR.message_types_by_name['ControlCommand'] = _CONTROLCOMMAND
+DESCRIPTOR.message_types_by_name['NodeLiveness'] = _NODELIVENESS
+DESCRIPTOR.message_types_by_name['NodeReading'] = _NODEREADING
+DESCRIPTOR.message_types_by_name['NodeInfo'] = _NODEINFO
+DESCRIPTOR.message_types_by_name['NodeLocation'] = _NODELOCATION
+DESCRIPTOR.message_types_by_name['NodeRecord'] = _NODERECORD
+DESCRIPTOR.message_types_by_name['NodeRecordList'] = _NODERECORDLIST
 DESCRIPTOR.message_types_by_name['NodeList'] = _NODELIST
+DESCRIPTOR.message_types_by_name['PingResponse'] = _PINGRESPONSE
+DESCRIPTOR.message_types_by_name['ObserveFilter'] = _OBSERVEFILTER
+DESCRIPTOR.message_types_by_name['StatusRequest'] = _STATUSREQUEST
+DESCRIPTOR.message_types_by_name['ConnectionRequest'] = _CONNECTIONREQUEST
+DESCRIPTOR.message_types_by_name['ConnectionResponse'] = _CONNECTIONRESPONSE
+DESCRIPTOR.message_types_by_name['PingRequest'] = _PINGREQUEST
+DESCRIPTOR.message_types_by_name['PingReceipt'] = _PINGRECEIPT
+DESCRIPTOR.message_types_by_name['NodelistRequest'] = _NODELISTREQUEST
+DESCRIPTOR.message_types_by_name['GetNodeRequest'] = _GETNODEREQUEST
+DESCRIPTOR.message_types_by_name['SetNodeRecordRequest'] = _SETNODERECORDREQUEST
+DESCRIPTOR.message_types_by_name['DeleteNodeRecordRequest'] = _DELETENODERECORDREQUEST
+DESCRIPTOR.message_types_by_name['ListNodeRecordsRequest'] = _LISTNODERECORDSREQUEST
+DESCRIPTOR.message_types_by_name['ImportNodeRecordsRequest'] = _IMPORTNODERECORDSREQUEST
+DESCRIPTOR.message_types_by_name['ImportNodeRecordsResponse'] = _IMPORTNODERECORDSRESPONSE
+DESCRIPTOR.message_types_by_name['CommandRequest'] = _COMMANDREQUEST
+DESCRIPTOR.message_types_by_name['CommandResponse'] = _COMMANDRESPONSE
+DESCRIPTOR.message_types_by_name['CommandStatusRequest'] = _COMMANDSTATUSREQUEST
+DESCRIPTOR.message_types_by_name['CommandRecord'] = _COMMANDRECORD
+DESCRIPTOR.message_types_by_name['ListCommandsRequest'] = _LISTCOMMANDSREQUEST
+DESCRIPTOR.message_types_by_name['CommandRecordList'] = _COMMANDRECORDLIST
+DESCRIPTOR.message_types_by_name['ListOutboxRequest'] = _LISTOUTBOXREQUEST
+DESCRIPTOR.message_types_by_name['OutboxEntry'] = _OUTBOXENTRY
+DESCRIPTOR.message_types_by_name['OutboxEntryList'] = _OUTBOXENTRYLIST
+DESCRIPTOR.message_types_by_name['PurgeOutboxRequest'] = _PURGEOUTBOXREQUEST
+DESCRIPTOR.message_types_by_name['PurgeOutboxResponse'] = _PURGEOUTBOXRESPONSE
+DESCRIPTOR.message_types_by_name['DescribeCommandsRequest'] = _DESCRIBECOMMANDSREQUEST
+DESCRIPTOR.message_types_by_name['CommandKey'] = _COMMANDKEY
+DESCRIPTOR.message_types_by_name['CommandSpec'] = _COMMANDSPEC
+DESCRIPTOR.message_types_by_name['CommandCatalog'] = _COMMANDCATALOG
+DESCRIPTOR.message_types_by_name['SchedulerRequest'] = _SCHEDULERREQUEST
+DESCRIPTOR.message_types_by_name['SchedulerResponse'] = _SCHEDULERRESPONSE
+DESCRIPTOR.message_types_by_name['SimulateRequest'] = _SIMULATEREQUEST
+DESCRIPTOR.message_types_by_name['SimulateResponse'] = _SIMULATERESPONSE
+DESCRIPTOR.message_types_by_name['AuditQuery'] = _AUDITQUERY
+DESCRIPTOR.message_types_by_name['AuditEntry'] = _AUDITENTRY
+DESCRIPTOR.message_types_by_name['AuditEntryList'] = _AUDITENTRYLIST
+DESCRIPTOR.message_types_by_name['RecordRequest'] = _RECORDREQUEST
+DESCRIPTOR.message_types_by_name['RecordedLog'] = _RECORDEDLOG
+DESCRIPTOR.message_types_by_name['ReplayResponse'] = _REPLAYRESPONSE
+DESCRIPTOR.message_types_by_name['TopologyRequest'] = _TOPOLOGYREQUEST
+DESCRIPTOR.message_types_by_name['TopologyLink'] = _TOPOLOGYLINK
+DESCRIPTOR.message_types_by_name['TopologyChange'] = _TOPOLOGYCHANGE
+DESCRIPTOR.message_types_by_name['MeshTopology'] = _MESHTOPOLOGY
+DESCRIPTOR.enum_types_by_name['PingScope'] = _PINGSCOPE
+DESCRIPTOR.enum_types_by_name['PingType'] = _PINGTYPE
+DESCRIPTOR.enum_types_by_name['CommandState'] = _COMMANDSTATE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
-Trigger = _reflection.GeneratedProtocolMessageType('Trigger', (_message.Message,), {
+Trigger = _reflection.GeneratedProtocolMessageType('Trigger', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _TRIGGER_METADATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.Trigger.MetadataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _TRIGGER,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.Trigger)
+  })
+_sym_db.RegisterMessage(Trigger)
+_sym_db.RegisterMessage(Trigger.MetadataEntry)
+
+Acknowledge = _reflection.GeneratedProtocolMessageType('Acknowledge', (_message.Message,), {
+  'DESCRIPTOR' : _ACKNOWLEDGE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.Acknowledge)
+  })
+_sym_db.RegisterMessage(Acknowledge)
+
+MeshOrchStatus = _reflection.GeneratedProtocolMessageType('MeshOrchStatus', (_message.Message,), {
+  'DESCRIPTOR' : _MESHORCHSTATUS,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.MeshOrchStatus)
+  })
+_sym_db.RegisterMessage(MeshOrchStatus)
+
+QueueStatus = _reflection.GeneratedProtocolMessageType('QueueStatus', (_message.Message,), {
+  'DESCRIPTOR' : _QUEUESTATUS,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.QueueStatus)
+  })
+_sym_db.RegisterMessage(QueueStatus)
+
+ComponentStatus = _reflection.GeneratedProtocolMessageType('ComponentStatus', (_message.Message,), {
+  'DESCRIPTOR' : _COMPONENTSTATUS,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ComponentStatus)
+  })
+_sym_db.RegisterMessage(ComponentStatus)
+
+LinkStatus = _reflection.GeneratedProtocolMessageType('LinkStatus', (_message.Message,), {
+  'DESCRIPTOR' : _LINKSTATUS,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.LinkStatus)
+  })
+_sym_db.RegisterMessage(LinkStatus)
+
+SimpleLog = _reflection.GeneratedProtocolMessageType('SimpleLog', (_message.Message,), {
+  'DESCRIPTOR' : _SIMPLELOG,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SimpleLog)
+  })
+_sym_db.RegisterMessage(SimpleLog)
+
+ComplexLog = _reflection.GeneratedProtocolMessageType('ComplexLog', (_message.Message,), {
+
+  'LogmetadataEntry' : _reflection.GeneratedProtocolMessageType('LogmetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _COMPLEXLOG_LOGMETADATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.ComplexLog.LogmetadataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _COMPLEXLOG,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ComplexLog)
+  })
+_sym_db.RegisterMessage(ComplexLog)
+_sym_db.RegisterMessage(ComplexLog.LogmetadataEntry)
+
+ControlCommand = _reflection.GeneratedProtocolMessageType('ControlCommand', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CONTROLCOMMAND_METADATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.ControlCommand.MetadataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CONTROLCOMMAND,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ControlCommand)
+  })
+_sym_db.RegisterMessage(ControlCommand)
+_sym_db.RegisterMessage(ControlCommand.MetadataEntry)
+
+NodeLiveness = _reflection.GeneratedProtocolMessageType('NodeLiveness', (_message.Message,), {
+  'DESCRIPTOR' : _NODELIVENESS,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeLiveness)
+  })
+_sym_db.RegisterMessage(NodeLiveness)
+
+NodeReading = _reflection.GeneratedProtocolMessageType('NodeReading', (_message.Message,), {
+
+  'SensordataEntry' : _reflection.GeneratedProtocolMessageType('SensordataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODEREADING_SENSORDATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.NodeReading.SensordataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _NODEREADING,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeReading)
+  })
+_sym_db.RegisterMessage(NodeReading)
+_sym_db.RegisterMessage(NodeReading.SensordataEntry)
+
+NodeInfo = _reflection.GeneratedProtocolMessageType('NodeInfo', (_message.Message,), {
+  'DESCRIPTOR' : _NODEINFO,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeInfo)
+  })
+_sym_db.RegisterMessage(NodeInfo)
+
+NodeLocation = _reflection.GeneratedProtocolMessageType('NodeLocation', (_message.Message,), {
+  'DESCRIPTOR' : _NODELOCATION,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeLocation)
+  })
+_sym_db.RegisterMessage(NodeLocation)
+
+NodeRecord = _reflection.GeneratedProtocolMessageType('NodeRecord', (_message.Message,), {
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODERECORD_LABELSENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.NodeRecord.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _NODERECORD,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeRecord)
+  })
+_sym_db.RegisterMessage(NodeRecord)
+_sym_db.RegisterMessage(NodeRecord.LabelsEntry)
+
+NodeRecordList = _reflection.GeneratedProtocolMessageType('NodeRecordList', (_message.Message,), {
+  'DESCRIPTOR' : _NODERECORDLIST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeRecordList)
+  })
+_sym_db.RegisterMessage(NodeRecordList)
+
+NodeList = _reflection.GeneratedProtocolMessageType('NodeList', (_message.Message,), {
+
+  'NodesEntry' : _reflection.GeneratedProtocolMessageType('NodesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODELIST_NODESENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.NodeList.NodesEntry)
+    })
+  ,
+
+  'LivenessEntry' : _reflection.GeneratedProtocolMessageType('LivenessEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODELIST_LIVENESSENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.NodeList.LivenessEntry)
+    })
+  ,
+
+  'DetailsEntry' : _reflection.GeneratedProtocolMessageType('DetailsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODELIST_DETAILSENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.NodeList.DetailsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _NODELIST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodeList)
+  })
+_sym_db.RegisterMessage(NodeList)
+_sym_db.RegisterMessage(NodeList.NodesEntry)
+_sym_db.RegisterMessage(NodeList.LivenessEntry)
+_sym_db.RegisterMessage(NodeList.DetailsEntry)
+
+PingResponse = _reflection.GeneratedProtocolMessageType('PingResponse', (_message.Message,), {
+
+  'SensordataEntry' : _reflection.GeneratedProtocolMessageType('SensordataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _PINGRESPONSE_SENSORDATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.PingResponse.SensordataEntry)
+    })
+  ,
+
+  'ConfigdataEntry' : _reflection.GeneratedProtocolMessageType('ConfigdataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _PINGRESPONSE_CONFIGDATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.PingResponse.ConfigdataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _PINGRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.PingResponse)
+  })
+_sym_db.RegisterMessage(PingResponse)
+_sym_db.RegisterMessage(PingResponse.SensordataEntry)
+_sym_db.RegisterMessage(PingResponse.ConfigdataEntry)
+
+ObserveFilter = _reflection.GeneratedProtocolMessageType('ObserveFilter', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _OBSERVEFILTER_METADATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.ObserveFilter.MetadataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _OBSERVEFILTER,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ObserveFilter)
+  })
+_sym_db.RegisterMessage(ObserveFilter)
+_sym_db.RegisterMessage(ObserveFilter.MetadataEntry)
+
+StatusRequest = _reflection.GeneratedProtocolMessageType('StatusRequest', (_message.Message,), {
+  'DESCRIPTOR' : _STATUSREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.StatusRequest)
+  })
+_sym_db.RegisterMessage(StatusRequest)
+
+ConnectionRequest = _reflection.GeneratedProtocolMessageType('ConnectionRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CONNECTIONREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ConnectionRequest)
+  })
+_sym_db.RegisterMessage(ConnectionRequest)
+
+ConnectionResponse = _reflection.GeneratedProtocolMessageType('ConnectionResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CONNECTIONRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ConnectionResponse)
+  })
+_sym_db.RegisterMessage(ConnectionResponse)
+
+PingRequest = _reflection.GeneratedProtocolMessageType('PingRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PINGREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.PingRequest)
+  })
+_sym_db.RegisterMessage(PingRequest)
+
+PingReceipt = _reflection.GeneratedProtocolMessageType('PingReceipt', (_message.Message,), {
+  'DESCRIPTOR' : _PINGRECEIPT,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.PingReceipt)
+  })
+_sym_db.RegisterMessage(PingReceipt)
+
+NodelistRequest = _reflection.GeneratedProtocolMessageType('NodelistRequest', (_message.Message,), {
+  'DESCRIPTOR' : _NODELISTREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.NodelistRequest)
+  })
+_sym_db.RegisterMessage(NodelistRequest)
+
+GetNodeRequest = _reflection.GeneratedProtocolMessageType('GetNodeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETNODEREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.GetNodeRequest)
+  })
+_sym_db.RegisterMessage(GetNodeRequest)
+
+SetNodeRecordRequest = _reflection.GeneratedProtocolMessageType('SetNodeRecordRequest', (_message.Message,), {
+
+  'LabelsEntry' : _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _SETNODERECORDREQUEST_LABELSENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.SetNodeRecordRequest.LabelsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _SETNODERECORDREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SetNodeRecordRequest)
+  })
+_sym_db.RegisterMessage(SetNodeRecordRequest)
+_sym_db.RegisterMessage(SetNodeRecordRequest.LabelsEntry)
+
+DeleteNodeRecordRequest = _reflection.GeneratedProtocolMessageType('DeleteNodeRecordRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETENODERECORDREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.DeleteNodeRecordRequest)
+  })
+_sym_db.RegisterMessage(DeleteNodeRecordRequest)
+
+ListNodeRecordsRequest = _reflection.GeneratedProtocolMessageType('ListNodeRecordsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTNODERECORDSREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ListNodeRecordsRequest)
+  })
+_sym_db.RegisterMessage(ListNodeRecordsRequest)
+
+ImportNodeRecordsRequest = _reflection.GeneratedProtocolMessageType('ImportNodeRecordsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _IMPORTNODERECORDSREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ImportNodeRecordsRequest)
+  })
+_sym_db.RegisterMessage(ImportNodeRecordsRequest)
+
+ImportNodeRecordsResponse = _reflection.GeneratedProtocolMessageType('ImportNodeRecordsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _IMPORTNODERECORDSRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.ImportNodeRecordsResponse)
+  })
+_sym_db.RegisterMessage(ImportNodeRecordsResponse)
+
+CommandRequest = _reflection.GeneratedProtocolMessageType('CommandRequest', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _COMMANDREQUEST_METADATAENTRY,
+    '__module__' : 'proto.fyrmesh_pb2'
+    # @@protoc_insertion_point(class_scope:main.CommandRequest.MetadataEntry)
+    })
+  ,
+  'DESCRIPTOR' : _COMMANDREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandRequest)
+  })
+_sym_db.RegisterMessage(CommandRequest)
+_sym_db.RegisterMessage(CommandRequest.MetadataEntry)
+
+CommandResponse = _reflection.GeneratedProtocolMessageType('CommandResponse', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandResponse)
+  })
+_sym_db.RegisterMessage(CommandResponse)
+
+CommandStatusRequest = _reflection.GeneratedProtocolMessageType('CommandStatusRequest', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDSTATUSREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandStatusRequest)
+  })
+_sym_db.RegisterMessage(CommandStatusRequest)
+
+CommandRecord = _reflection.GeneratedProtocolMessageType('CommandRecord', (_message.Message,), {
 
   'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
-    'DESCRIPTOR' : _TRIGGER_METADATAENTRY,
+    'DESCRIPTOR' : _COMMANDRECORD_METADATAENTRY,
     '__module__' : 'proto.fyrmesh_pb2'
-    # @@protoc_insertion_point(class_scope:main.Trigger.MetadataEntry)
+    # @@protoc_insertion_point(class_scope:main.CommandRecord.MetadataEntry)
     })
   ,
-  'DESCRIPTOR' : _TRIGGER,
+  'DESCRIPTOR' : _COMMANDRECORD,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.Trigger)
+  # @@protoc_insertion_point(class_scope:main.CommandRecord)
   })
-_sym_db.RegisterMessage(Trigger)
-_sym_db.RegisterMessage(Trigger.MetadataEntry)
+_sym_db.RegisterMessage(CommandRecord)
+_sym_db.RegisterMessage(CommandRecord.MetadataEntry)
 
-Acknowledge = _reflection.GeneratedProtocolMessageType('Acknowledge', (_message.Message,), {
-  'DESCRIPTOR' : _ACKNOWLEDGE,
+ListCommandsRequest = _reflection.GeneratedProtocolMessageType('ListCommandsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTCOMMANDSREQUEST,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.Acknowledge)
+  # @@protoc_insertion_point(class_scope:main.ListCommandsRequest)
   })
-_sym_db.RegisterMessage(Acknowledge)
+_sym_db.RegisterMessage(ListCommandsRequest)
 
-MeshOrchStatus = _reflection.GeneratedProtocolMessageType('MeshOrchStatus', (_message.Message,), {
-  'DESCRIPTOR' : _MESHORCHSTATUS,
+CommandRecordList = _reflection.GeneratedProtocolMessageType('CommandRecordList', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDRECORDLIST,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.MeshOrchStatus)
+  # @@protoc_insertion_point(class_scope:main.CommandRecordList)
   })
-_sym_db.RegisterMessage(MeshOrchStatus)
+_sym_db.RegisterMessage(CommandRecordList)
 
-SimpleLog = _reflection.GeneratedProtocolMessageType('SimpleLog', (_message.Message,), {
-  'DESCRIPTOR' : _SIMPLELOG,
+ListOutboxRequest = _reflection.GeneratedProtocolMessageType('ListOutboxRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTOUTBOXREQUEST,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.SimpleLog)
+  # @@protoc_insertion_point(class_scope:main.ListOutboxRequest)
   })
-_sym_db.RegisterMessage(SimpleLog)
+_sym_db.RegisterMessage(ListOutboxRequest)
 
-ComplexLog = _reflection.GeneratedProtocolMessageType('ComplexLog', (_message.Message,), {
+OutboxEntry = _reflection.GeneratedProtocolMessageType('OutboxEntry', (_message.Message,), {
 
-  'LogmetadataEntry' : _reflection.GeneratedProtocolMessageType('LogmetadataEntry', (_message.Message,), {
-    'DESCRIPTOR' : _COMPLEXLOG_LOGMETADATAENTRY,
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _OUTBOXENTRY_METADATAENTRY,
     '__module__' : 'proto.fyrmesh_pb2'
-    # @@protoc_insertion_point(class_scope:main.ComplexLog.LogmetadataEntry)
+    # @@protoc_insertion_point(class_scope:main.OutboxEntry.MetadataEntry)
     })
   ,
-  'DESCRIPTOR' : _COMPLEXLOG,
+  'DESCRIPTOR' : _OUTBOXENTRY,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.ComplexLog)
+  # @@protoc_insertion_point(class_scope:main.OutboxEntry)
   })
-_sym_db.RegisterMessage(ComplexLog)
-_sym_db.RegisterMessage(ComplexLog.LogmetadataEntry)
+_sym_db.RegisterMessage(OutboxEntry)
+_sym_db.RegisterMessage(OutboxEntry.MetadataEntry)
 
-ControlCommand = _reflection.GeneratedProtocolMessageType('ControlCommand', (_message.Message,), {
+OutboxEntryList = _reflection.GeneratedProtocolMessageType('OutboxEntryList', (_message.Message,), {
+  'DESCRIPTOR' : _OUTBOXENTRYLIST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.OutboxEntryList)
+  })
+_sym_db.RegisterMessage(OutboxEntryList)
 
-  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
-    'DESCRIPTOR' : _CONTROLCOMMAND_METADATAENTRY,
-    '__module__' : 'proto.fyrmesh_pb2'
-    # @@protoc_insertion_point(class_scope:main.ControlCommand.MetadataEntry)
-    })
-  ,
-  'DESCRIPTOR' : _CONTROLCOMMAND,
+PurgeOutboxRequest = _reflection.GeneratedProtocolMessageType('PurgeOutboxRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PURGEOUTBOXREQUEST,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.ControlCommand)
+  # @@protoc_insertion_point(class_scope:main.PurgeOutboxRequest)
   })
-_sym_db.RegisterMessage(ControlCommand)
-_sym_db.RegisterMessage(ControlCommand.MetadataEntry)
+_sym_db.RegisterMessage(PurgeOutboxRequest)
 
-NodeList = _reflection.GeneratedProtocolMessageType('NodeList', (_message.Message,), {
+PurgeOutboxResponse = _reflection.GeneratedProtocolMessageType('PurgeOutboxResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PURGEOUTBOXRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.PurgeOutboxResponse)
+  })
+_sym_db.RegisterMessage(PurgeOutboxResponse)
 
-  'NodesEntry' : _reflection.GeneratedProtocolMessageType('NodesEntry', (_message.Message,), {
-    'DESCRIPTOR' : _NODELIST_NODESENTRY,
+DescribeCommandsRequest = _reflection.GeneratedProtocolMessageType('DescribeCommandsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DESCRIBECOMMANDSREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.DescribeCommandsRequest)
+  })
+_sym_db.RegisterMessage(DescribeCommandsRequest)
+
+CommandKey = _reflection.GeneratedProtocolMessageType('CommandKey', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDKEY,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandKey)
+  })
+_sym_db.RegisterMessage(CommandKey)
+
+CommandSpec = _reflection.GeneratedProtocolMessageType('CommandSpec', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDSPEC,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandSpec)
+  })
+_sym_db.RegisterMessage(CommandSpec)
+
+CommandCatalog = _reflection.GeneratedProtocolMessageType('CommandCatalog', (_message.Message,), {
+  'DESCRIPTOR' : _COMMANDCATALOG,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.CommandCatalog)
+  })
+_sym_db.RegisterMessage(CommandCatalog)
+
+SchedulerRequest = _reflection.GeneratedProtocolMessageType('SchedulerRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SCHEDULERREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SchedulerRequest)
+  })
+_sym_db.RegisterMessage(SchedulerRequest)
+
+SchedulerResponse = _reflection.GeneratedProtocolMessageType('SchedulerResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SCHEDULERRESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SchedulerResponse)
+  })
+_sym_db.RegisterMessage(SchedulerResponse)
+
+SimulateRequest = _reflection.GeneratedProtocolMessageType('SimulateRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SIMULATEREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SimulateRequest)
+  })
+_sym_db.RegisterMessage(SimulateRequest)
+
+SimulateResponse = _reflection.GeneratedProtocolMessageType('SimulateResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SIMULATERESPONSE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.SimulateResponse)
+  })
+_sym_db.RegisterMessage(SimulateResponse)
+
+AuditQuery = _reflection.GeneratedProtocolMessageType('AuditQuery', (_message.Message,), {
+  'DESCRIPTOR' : _AUDITQUERY,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.AuditQuery)
+  })
+_sym_db.RegisterMessage(AuditQuery)
+
+AuditEntry = _reflection.GeneratedProtocolMessageType('AuditEntry', (_message.Message,), {
+  'DESCRIPTOR' : _AUDITENTRY,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.AuditEntry)
+  })
+_sym_db.RegisterMessage(AuditEntry)
+
+AuditEntryList = _reflection.GeneratedProtocolMessageType('AuditEntryList', (_message.Message,), {
+  'DESCRIPTOR' : _AUDITENTRYLIST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.AuditEntryList)
+  })
+_sym_db.RegisterMessage(AuditEntryList)
+
+RecordRequest = _reflection.GeneratedProtocolMessageType('RecordRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RECORDREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.RecordRequest)
+  })
+_sym_db.RegisterMessage(RecordRequest)
+
+RecordedLog = _reflection.GeneratedProtocolMessageType('RecordedLog', (_message.Message,), {
+  'DESCRIPTOR' : _RECORDEDLOG,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.RecordedLog)
+  })
+_sym_db.RegisterMessage(RecordedLog)
+
+ReplayResponse = _reflection.GeneratedProtocolMessageType('ReplayResponse', (_message.Message,), {
+
+  'LogtypesEntry' : _reflection.GeneratedProtocolMessageType('LogtypesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _REPLAYRESPONSE_LOGTYPESENTRY,
     '__module__' : 'proto.fyrmesh_pb2'
-    # @@protoc_insertion_point(class_scope:main.NodeList.NodesEntry)
+    # @@protoc_insertion_point(class_scope:main.ReplayResponse.LogtypesEntry)
     })
   ,
-  'DESCRIPTOR' : _NODELIST,
+  'DESCRIPTOR' : _REPLAYRESPONSE,
   '__module__' : 'proto.fyrmesh_pb2'
-  # @@protoc_insertion_point(class_scope:main.NodeList)
+  # @@protoc_insertion_point(class_scope:main.ReplayResponse)
   })
-_sym_db.RegisterMessage(NodeList)
-_sym_db.RegisterMessage(NodeList.NodesEntry)
+_sym_db.RegisterMessage(ReplayResponse)
+_sym_db.RegisterMessage(ReplayResponse.LogtypesEntry)
+
+TopologyRequest = _reflection.GeneratedProtocolMessageType('TopologyRequest', (_message.Message,), {
+  'DESCRIPTOR' : _TOPOLOGYREQUEST,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.TopologyRequest)
+  })
+_sym_db.RegisterMessage(TopologyRequest)
+
+TopologyLink = _reflection.GeneratedProtocolMessageType('TopologyLink', (_message.Message,), {
+  'DESCRIPTOR' : _TOPOLOGYLINK,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.TopologyLink)
+  })
+_sym_db.RegisterMessage(TopologyLink)
+
+TopologyChange = _reflection.GeneratedProtocolMessageType('TopologyChange', (_message.Message,), {
+  'DESCRIPTOR' : _TOPOLOGYCHANGE,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.TopologyChange)
+  })
+_sym_db.RegisterMessage(TopologyChange)
+
+MeshTopology = _reflection.GeneratedProtocolMessageType('MeshTopology', (_message.Message,), {
+  'DESCRIPTOR' : _MESHTOPOLOGY,
+  '__module__' : 'proto.fyrmesh_pb2'
+  # @@protoc_insertion_point(class_scope:main.MeshTopology)
+  })
+_sym_db.RegisterMessage(MeshTopology)
 
 
 DESCRIPTOR._options = None
 _TRIGGER_METADATAENTRY._options = None
 _COMPLEXLOG_LOGMETADATAENTRY._options = None
 _CONTROLCOMMAND_METADATAENTRY._options = None
+_NODEREADING_SENSORDATAENTRY._options = None
+_NODERECORD_LABELSENTRY._options = None
 _NODELIST_NODESENTRY._options = None
+_NODELIST_LIVENESSENTRY._options = None
+_NODELIST_DETAILSENTRY._options = None
+_PINGRESPONSE_SENSORDATAENTRY._options = None
+_PINGRESPONSE_CONFIGDATAENTRY._options = None
+_OBSERVEFILTER_METADATAENTRY._options = None
+_SETNODERECORDREQUEST_LABELSENTRY._options = None
+_COMMANDREQUEST_METADATAENTRY._options = None
+_COMMANDRECORD_METADATAENTRY._options = None
+_OUTBOXENTRY_METADATAENTRY._options = None
+_REPLAYRESPONSE_LOGTYPESENTRY._options = None
 
 _INTERFACE = _descriptor.ServiceDescriptor(
   name='Interface',
@@ -604,8 +4255,8 @@ _INTERFACE = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=844,
-  serialized_end=952,
+  serialized_start=6694,
+  serialized_end=6802,
   methods=[
   _descriptor.MethodDescriptor(
     name='Read',
@@ -640,8 +4291,8 @@ _ORCHESTRATOR = _descriptor.ServiceDescriptor(
   index=1,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=955,
-  serialized_end=1361,
+  serialized_start=6805,
+  serialized_end=7325,
   methods=[
   _descriptor.MethodDescriptor(
     name='Status',
@@ -723,9 +4374,275 @@ _ORCHESTRATOR = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='PingStream',
+    full_name='main.Orchestrator.PingStream',
+    index=8,
+    containing_service=None,
+    input_type=_TRIGGER,
+    output_type=_PINGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ObserveComplex',
+    full_name='main.Orchestrator.ObserveComplex',
+    index=9,
+    containing_service=None,
+    input_type=_OBSERVEFILTER,
+    output_type=_COMPLEXLOG,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_ORCHESTRATOR)
 
 DESCRIPTOR.services_by_name['Orchestrator'] = _ORCHESTRATOR
 
+
+_ORCHESTRATORV2 = _descriptor.ServiceDescriptor(
+  name='OrchestratorV2',
+  full_name='main.OrchestratorV2',
+  file=DESCRIPTOR,
+  index=2,
+  serialized_options=None,
+  create_key=_descriptor._internal_create_key,
+  serialized_start=7328,
+  serialized_end=8783,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Status',
+    full_name='main.OrchestratorV2.Status',
+    index=0,
+    containing_service=None,
+    input_type=_STATUSREQUEST,
+    output_type=_MESHORCHSTATUS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetConnection',
+    full_name='main.OrchestratorV2.SetConnection',
+    index=1,
+    containing_service=None,
+    input_type=_CONNECTIONREQUEST,
+    output_type=_CONNECTIONRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Observe',
+    full_name='main.OrchestratorV2.Observe',
+    index=2,
+    containing_service=None,
+    input_type=_OBSERVEFILTER,
+    output_type=_COMPLEXLOG,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Ping',
+    full_name='main.OrchestratorV2.Ping',
+    index=3,
+    containing_service=None,
+    input_type=_PINGREQUEST,
+    output_type=_PINGRECEIPT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PingStream',
+    full_name='main.OrchestratorV2.PingStream',
+    index=4,
+    containing_service=None,
+    input_type=_PINGREQUEST,
+    output_type=_PINGRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Nodelist',
+    full_name='main.OrchestratorV2.Nodelist',
+    index=5,
+    containing_service=None,
+    input_type=_NODELISTREQUEST,
+    output_type=_NODELIST,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Command',
+    full_name='main.OrchestratorV2.Command',
+    index=6,
+    containing_service=None,
+    input_type=_COMMANDREQUEST,
+    output_type=_COMMANDRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DescribeCommands',
+    full_name='main.OrchestratorV2.DescribeCommands',
+    index=7,
+    containing_service=None,
+    input_type=_DESCRIBECOMMANDSREQUEST,
+    output_type=_COMMANDCATALOG,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CommandStatus',
+    full_name='main.OrchestratorV2.CommandStatus',
+    index=8,
+    containing_service=None,
+    input_type=_COMMANDSTATUSREQUEST,
+    output_type=_COMMANDRECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListCommands',
+    full_name='main.OrchestratorV2.ListCommands',
+    index=9,
+    containing_service=None,
+    input_type=_LISTCOMMANDSREQUEST,
+    output_type=_COMMANDRECORDLIST,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetScheduler',
+    full_name='main.OrchestratorV2.SetScheduler',
+    index=10,
+    containing_service=None,
+    input_type=_SCHEDULERREQUEST,
+    output_type=_SCHEDULERRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Simulate',
+    full_name='main.OrchestratorV2.Simulate',
+    index=11,
+    containing_service=None,
+    input_type=_SIMULATEREQUEST,
+    output_type=_SIMULATERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='QueryAudit',
+    full_name='main.OrchestratorV2.QueryAudit',
+    index=12,
+    containing_service=None,
+    input_type=_AUDITQUERY,
+    output_type=_AUDITENTRYLIST,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListOutbox',
+    full_name='main.OrchestratorV2.ListOutbox',
+    index=13,
+    containing_service=None,
+    input_type=_LISTOUTBOXREQUEST,
+    output_type=_OUTBOXENTRYLIST,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PurgeOutbox',
+    full_name='main.OrchestratorV2.PurgeOutbox',
+    index=14,
+    containing_service=None,
+    input_type=_PURGEOUTBOXREQUEST,
+    output_type=_PURGEOUTBOXRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Record',
+    full_name='main.OrchestratorV2.Record',
+    index=15,
+    containing_service=None,
+    input_type=_RECORDREQUEST,
+    output_type=_RECORDEDLOG,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Replay',
+    full_name='main.OrchestratorV2.Replay',
+    index=16,
+    containing_service=None,
+    input_type=_COMPLEXLOG,
+    output_type=_REPLAYRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Topology',
+    full_name='main.OrchestratorV2.Topology',
+    index=17,
+    containing_service=None,
+    input_type=_TOPOLOGYREQUEST,
+    output_type=_MESHTOPOLOGY,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetNode',
+    full_name='main.OrchestratorV2.GetNode',
+    index=18,
+    containing_service=None,
+    input_type=_GETNODEREQUEST,
+    output_type=_NODEINFO,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetNodeRecord',
+    full_name='main.OrchestratorV2.SetNodeRecord',
+    index=19,
+    containing_service=None,
+    input_type=_SETNODERECORDREQUEST,
+    output_type=_NODERECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteNodeRecord',
+    full_name='main.OrchestratorV2.DeleteNodeRecord',
+    index=20,
+    containing_service=None,
+    input_type=_DELETENODERECORDREQUEST,
+    output_type=_NODERECORD,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListNodeRecords',
+    full_name='main.OrchestratorV2.ListNodeRecords',
+    index=21,
+    containing_service=None,
+    input_type=_LISTNODERECORDSREQUEST,
+    output_type=_NODERECORDLIST,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ImportNodeRecords',
+    full_name='main.OrchestratorV2.ImportNodeRecords',
+    index=22,
+    containing_service=None,
+    input_type=_IMPORTNODERECORDSREQUEST,
+    output_type=_IMPORTNODERECORDSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_ORCHESTRATORV2)
+
+DESCRIPTOR.services_by_name['OrchestratorV2'] = _ORCHESTRATORV2
+
 # @@protoc_insertion_point(module_scope)
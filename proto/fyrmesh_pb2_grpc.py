@@ -148,6 +148,16 @@ class OrchestratorStub(object):
                 request_serializer=proto_dot_fyrmesh__pb2.Trigger.SerializeToString,
                 response_deserializer=proto_dot_fyrmesh__pb2.Acknowledge.FromString,
                 )
+        self.PingStream = channel.unary_stream(
+                '/main.Orchestrator/PingStream',
+                request_serializer=proto_dot_fyrmesh__pb2.Trigger.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.PingResponse.FromString,
+                )
+        self.ObserveComplex = channel.unary_stream(
+                '/main.Orchestrator/ObserveComplex',
+                request_serializer=proto_dot_fyrmesh__pb2.ObserveFilter.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.ComplexLog.FromString,
+                )
 
 
 class OrchestratorServicer(object):
@@ -201,6 +211,18 @@ class OrchestratorServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def PingStream(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ObserveComplex(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_OrchestratorServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -244,6 +266,16 @@ def add_OrchestratorServicer_to_server(servicer, server):
                     request_deserializer=proto_dot_fyrmesh__pb2.Trigger.FromString,
                     response_serializer=proto_dot_fyrmesh__pb2.Acknowledge.SerializeToString,
             ),
+            'PingStream': grpc.unary_stream_rpc_method_handler(
+                    servicer.PingStream,
+                    request_deserializer=proto_dot_fyrmesh__pb2.Trigger.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.PingResponse.SerializeToString,
+            ),
+            'ObserveComplex': grpc.unary_stream_rpc_method_handler(
+                    servicer.ObserveComplex,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ObserveFilter.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.ComplexLog.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'main.Orchestrator', rpc_method_handlers)
@@ -389,3 +421,824 @@ class Orchestrator(object):
             proto_dot_fyrmesh__pb2.Acknowledge.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PingStream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/main.Orchestrator/PingStream',
+            proto_dot_fyrmesh__pb2.Trigger.SerializeToString,
+            proto_dot_fyrmesh__pb2.PingResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ObserveComplex(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/main.Orchestrator/ObserveComplex',
+            proto_dot_fyrmesh__pb2.ObserveFilter.SerializeToString,
+            proto_dot_fyrmesh__pb2.ComplexLog.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class OrchestratorV2Stub(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.Status = channel.unary_unary(
+                '/main.OrchestratorV2/Status',
+                request_serializer=proto_dot_fyrmesh__pb2.StatusRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.MeshOrchStatus.FromString,
+                )
+        self.SetConnection = channel.unary_unary(
+                '/main.OrchestratorV2/SetConnection',
+                request_serializer=proto_dot_fyrmesh__pb2.ConnectionRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.ConnectionResponse.FromString,
+                )
+        self.Observe = channel.unary_stream(
+                '/main.OrchestratorV2/Observe',
+                request_serializer=proto_dot_fyrmesh__pb2.ObserveFilter.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.ComplexLog.FromString,
+                )
+        self.Ping = channel.unary_unary(
+                '/main.OrchestratorV2/Ping',
+                request_serializer=proto_dot_fyrmesh__pb2.PingRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.PingReceipt.FromString,
+                )
+        self.PingStream = channel.unary_stream(
+                '/main.OrchestratorV2/PingStream',
+                request_serializer=proto_dot_fyrmesh__pb2.PingRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.PingResponse.FromString,
+                )
+        self.Nodelist = channel.unary_unary(
+                '/main.OrchestratorV2/Nodelist',
+                request_serializer=proto_dot_fyrmesh__pb2.NodelistRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.NodeList.FromString,
+                )
+        self.Command = channel.unary_unary(
+                '/main.OrchestratorV2/Command',
+                request_serializer=proto_dot_fyrmesh__pb2.CommandRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.CommandResponse.FromString,
+                )
+        self.DescribeCommands = channel.unary_unary(
+                '/main.OrchestratorV2/DescribeCommands',
+                request_serializer=proto_dot_fyrmesh__pb2.DescribeCommandsRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.CommandCatalog.FromString,
+                )
+        self.CommandStatus = channel.unary_unary(
+                '/main.OrchestratorV2/CommandStatus',
+                request_serializer=proto_dot_fyrmesh__pb2.CommandStatusRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.CommandRecord.FromString,
+                )
+        self.ListCommands = channel.unary_unary(
+                '/main.OrchestratorV2/ListCommands',
+                request_serializer=proto_dot_fyrmesh__pb2.ListCommandsRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.CommandRecordList.FromString,
+                )
+        self.SetScheduler = channel.unary_unary(
+                '/main.OrchestratorV2/SetScheduler',
+                request_serializer=proto_dot_fyrmesh__pb2.SchedulerRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.SchedulerResponse.FromString,
+                )
+        self.Simulate = channel.unary_unary(
+                '/main.OrchestratorV2/Simulate',
+                request_serializer=proto_dot_fyrmesh__pb2.SimulateRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.SimulateResponse.FromString,
+                )
+        self.QueryAudit = channel.unary_unary(
+                '/main.OrchestratorV2/QueryAudit',
+                request_serializer=proto_dot_fyrmesh__pb2.AuditQuery.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.AuditEntryList.FromString,
+                )
+        self.ListOutbox = channel.unary_unary(
+                '/main.OrchestratorV2/ListOutbox',
+                request_serializer=proto_dot_fyrmesh__pb2.ListOutboxRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.OutboxEntryList.FromString,
+                )
+        self.PurgeOutbox = channel.unary_unary(
+                '/main.OrchestratorV2/PurgeOutbox',
+                request_serializer=proto_dot_fyrmesh__pb2.PurgeOutboxRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.PurgeOutboxResponse.FromString,
+                )
+        self.Record = channel.unary_stream(
+                '/main.OrchestratorV2/Record',
+                request_serializer=proto_dot_fyrmesh__pb2.RecordRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.RecordedLog.FromString,
+                )
+        self.Replay = channel.stream_unary(
+                '/main.OrchestratorV2/Replay',
+                request_serializer=proto_dot_fyrmesh__pb2.ComplexLog.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.ReplayResponse.FromString,
+                )
+        self.Topology = channel.unary_unary(
+                '/main.OrchestratorV2/Topology',
+                request_serializer=proto_dot_fyrmesh__pb2.TopologyRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.MeshTopology.FromString,
+                )
+        self.GetNode = channel.unary_unary(
+                '/main.OrchestratorV2/GetNode',
+                request_serializer=proto_dot_fyrmesh__pb2.GetNodeRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.NodeInfo.FromString,
+                )
+        self.SetNodeRecord = channel.unary_unary(
+                '/main.OrchestratorV2/SetNodeRecord',
+                request_serializer=proto_dot_fyrmesh__pb2.SetNodeRecordRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.NodeRecord.FromString,
+                )
+        self.DeleteNodeRecord = channel.unary_unary(
+                '/main.OrchestratorV2/DeleteNodeRecord',
+                request_serializer=proto_dot_fyrmesh__pb2.DeleteNodeRecordRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.NodeRecord.FromString,
+                )
+        self.ListNodeRecords = channel.unary_unary(
+                '/main.OrchestratorV2/ListNodeRecords',
+                request_serializer=proto_dot_fyrmesh__pb2.ListNodeRecordsRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.NodeRecordList.FromString,
+                )
+        self.ImportNodeRecords = channel.unary_unary(
+                '/main.OrchestratorV2/ImportNodeRecords',
+                request_serializer=proto_dot_fyrmesh__pb2.ImportNodeRecordsRequest.SerializeToString,
+                response_deserializer=proto_dot_fyrmesh__pb2.ImportNodeRecordsResponse.FromString,
+                )
+
+
+class OrchestratorV2Servicer(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def Status(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetConnection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Observe(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Ping(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PingStream(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Nodelist(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Command(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DescribeCommands(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CommandStatus(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListCommands(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetScheduler(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Simulate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def QueryAudit(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListOutbox(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PurgeOutbox(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Record(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Replay(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Topology(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetNode(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetNodeRecord(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteNodeRecord(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListNodeRecords(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportNodeRecords(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_OrchestratorV2Servicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'Status': grpc.unary_unary_rpc_method_handler(
+                    servicer.Status,
+                    request_deserializer=proto_dot_fyrmesh__pb2.StatusRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.MeshOrchStatus.SerializeToString,
+            ),
+            'SetConnection': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetConnection,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ConnectionRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.ConnectionResponse.SerializeToString,
+            ),
+            'Observe': grpc.unary_stream_rpc_method_handler(
+                    servicer.Observe,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ObserveFilter.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.ComplexLog.SerializeToString,
+            ),
+            'Ping': grpc.unary_unary_rpc_method_handler(
+                    servicer.Ping,
+                    request_deserializer=proto_dot_fyrmesh__pb2.PingRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.PingReceipt.SerializeToString,
+            ),
+            'PingStream': grpc.unary_stream_rpc_method_handler(
+                    servicer.PingStream,
+                    request_deserializer=proto_dot_fyrmesh__pb2.PingRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.PingResponse.SerializeToString,
+            ),
+            'Nodelist': grpc.unary_unary_rpc_method_handler(
+                    servicer.Nodelist,
+                    request_deserializer=proto_dot_fyrmesh__pb2.NodelistRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.NodeList.SerializeToString,
+            ),
+            'Command': grpc.unary_unary_rpc_method_handler(
+                    servicer.Command,
+                    request_deserializer=proto_dot_fyrmesh__pb2.CommandRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.CommandResponse.SerializeToString,
+            ),
+            'DescribeCommands': grpc.unary_unary_rpc_method_handler(
+                    servicer.DescribeCommands,
+                    request_deserializer=proto_dot_fyrmesh__pb2.DescribeCommandsRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.CommandCatalog.SerializeToString,
+            ),
+            'CommandStatus': grpc.unary_unary_rpc_method_handler(
+                    servicer.CommandStatus,
+                    request_deserializer=proto_dot_fyrmesh__pb2.CommandStatusRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.CommandRecord.SerializeToString,
+            ),
+            'ListCommands': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListCommands,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ListCommandsRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.CommandRecordList.SerializeToString,
+            ),
+            'SetScheduler': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetScheduler,
+                    request_deserializer=proto_dot_fyrmesh__pb2.SchedulerRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.SchedulerResponse.SerializeToString,
+            ),
+            'Simulate': grpc.unary_unary_rpc_method_handler(
+                    servicer.Simulate,
+                    request_deserializer=proto_dot_fyrmesh__pb2.SimulateRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.SimulateResponse.SerializeToString,
+            ),
+            'QueryAudit': grpc.unary_unary_rpc_method_handler(
+                    servicer.QueryAudit,
+                    request_deserializer=proto_dot_fyrmesh__pb2.AuditQuery.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.AuditEntryList.SerializeToString,
+            ),
+            'ListOutbox': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListOutbox,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ListOutboxRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.OutboxEntryList.SerializeToString,
+            ),
+            'PurgeOutbox': grpc.unary_unary_rpc_method_handler(
+                    servicer.PurgeOutbox,
+                    request_deserializer=proto_dot_fyrmesh__pb2.PurgeOutboxRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.PurgeOutboxResponse.SerializeToString,
+            ),
+            'Record': grpc.unary_stream_rpc_method_handler(
+                    servicer.Record,
+                    request_deserializer=proto_dot_fyrmesh__pb2.RecordRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.RecordedLog.SerializeToString,
+            ),
+            'Replay': grpc.stream_unary_rpc_method_handler(
+                    servicer.Replay,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ComplexLog.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.ReplayResponse.SerializeToString,
+            ),
+            'Topology': grpc.unary_unary_rpc_method_handler(
+                    servicer.Topology,
+                    request_deserializer=proto_dot_fyrmesh__pb2.TopologyRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.MeshTopology.SerializeToString,
+            ),
+            'GetNode': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetNode,
+                    request_deserializer=proto_dot_fyrmesh__pb2.GetNodeRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.NodeInfo.SerializeToString,
+            ),
+            'SetNodeRecord': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetNodeRecord,
+                    request_deserializer=proto_dot_fyrmesh__pb2.SetNodeRecordRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.NodeRecord.SerializeToString,
+            ),
+            'DeleteNodeRecord': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteNodeRecord,
+                    request_deserializer=proto_dot_fyrmesh__pb2.DeleteNodeRecordRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.NodeRecord.SerializeToString,
+            ),
+            'ListNodeRecords': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListNodeRecords,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ListNodeRecordsRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.NodeRecordList.SerializeToString,
+            ),
+            'ImportNodeRecords': grpc.unary_unary_rpc_method_handler(
+                    servicer.ImportNodeRecords,
+                    request_deserializer=proto_dot_fyrmesh__pb2.ImportNodeRecordsRequest.FromString,
+                    response_serializer=proto_dot_fyrmesh__pb2.ImportNodeRecordsResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'main.OrchestratorV2', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class OrchestratorV2(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def Status(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Status',
+            proto_dot_fyrmesh__pb2.StatusRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.MeshOrchStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetConnection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/SetConnection',
+            proto_dot_fyrmesh__pb2.ConnectionRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.ConnectionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Observe(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/main.OrchestratorV2/Observe',
+            proto_dot_fyrmesh__pb2.ObserveFilter.SerializeToString,
+            proto_dot_fyrmesh__pb2.ComplexLog.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Ping(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Ping',
+            proto_dot_fyrmesh__pb2.PingRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.PingReceipt.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PingStream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/main.OrchestratorV2/PingStream',
+            proto_dot_fyrmesh__pb2.PingRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.PingResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Nodelist(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Nodelist',
+            proto_dot_fyrmesh__pb2.NodelistRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.NodeList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Command(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Command',
+            proto_dot_fyrmesh__pb2.CommandRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.CommandResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DescribeCommands(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/DescribeCommands',
+            proto_dot_fyrmesh__pb2.DescribeCommandsRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.CommandCatalog.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CommandStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/CommandStatus',
+            proto_dot_fyrmesh__pb2.CommandStatusRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.CommandRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListCommands(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/ListCommands',
+            proto_dot_fyrmesh__pb2.ListCommandsRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.CommandRecordList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetScheduler(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/SetScheduler',
+            proto_dot_fyrmesh__pb2.SchedulerRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.SchedulerResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Simulate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Simulate',
+            proto_dot_fyrmesh__pb2.SimulateRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.SimulateResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def QueryAudit(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/QueryAudit',
+            proto_dot_fyrmesh__pb2.AuditQuery.SerializeToString,
+            proto_dot_fyrmesh__pb2.AuditEntryList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListOutbox(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/ListOutbox',
+            proto_dot_fyrmesh__pb2.ListOutboxRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.OutboxEntryList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PurgeOutbox(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/PurgeOutbox',
+            proto_dot_fyrmesh__pb2.PurgeOutboxRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.PurgeOutboxResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Record(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/main.OrchestratorV2/Record',
+            proto_dot_fyrmesh__pb2.RecordRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.RecordedLog.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Replay(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(request_iterator, target, '/main.OrchestratorV2/Replay',
+            proto_dot_fyrmesh__pb2.ComplexLog.SerializeToString,
+            proto_dot_fyrmesh__pb2.ReplayResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Topology(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/Topology',
+            proto_dot_fyrmesh__pb2.TopologyRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.MeshTopology.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetNode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/GetNode',
+            proto_dot_fyrmesh__pb2.GetNodeRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.NodeInfo.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetNodeRecord(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/SetNodeRecord',
+            proto_dot_fyrmesh__pb2.SetNodeRecordRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.NodeRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteNodeRecord(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/DeleteNodeRecord',
+            proto_dot_fyrmesh__pb2.DeleteNodeRecordRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.NodeRecord.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListNodeRecords(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/ListNodeRecords',
+            proto_dot_fyrmesh__pb2.ListNodeRecordsRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.NodeRecordList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ImportNodeRecords(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/main.OrchestratorV2/ImportNodeRecords',
+            proto_dot_fyrmesh__pb2.ImportNodeRecordsRequest.SerializeToString,
+            proto_dot_fyrmesh__pb2.ImportNodeRecordsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
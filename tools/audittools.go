@@ -0,0 +1,280 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// The name of the file of the audit log in the directory specified by the 'FYRMESHCONFIG' env variable.
+const AuditLogFile = "audit.log"
+
+// The default maximum size in bytes of an audit log file and the default number of rotated files to keep.
+const (
+	DefaultAuditMaxSize  = 1 << 20
+	DefaultAuditMaxFiles = 5
+)
+
+// A struct that defines an entry of the audit log. Each entry records a single mutating call to the orchestrator.
+type AuditEntry struct {
+	// The ISO8601 time of the call
+	Time string `json:"time"`
+
+	// The name of the action, such as 'command' or 'simulate'
+	Action string `json:"action"`
+
+	// The full gRPC method name that was called
+	Method string `json:"method"`
+
+	// The name, role and authentication method of the caller
+	Caller     string `json:"caller"`
+	Role       string `json:"role"`
+	AuthMethod string `json:"authmethod"`
+
+	// The network address of the caller
+	Peer string `json:"peer"`
+
+	// The JSON payload of the request
+	Request string `json:"request"`
+
+	// The result of the call. One of 'success', 'failure', 'denied' or 'error'.
+	Result string `json:"result"`
+
+	// The error of the call if it did not succeed
+	Error string `json:"error,omitempty"`
+}
+
+// A struct that defines a filter for querying the audit log. Since and Until are ISO8601 times.
+// Empty fields match any entry and a Limit of 0 returns every matching entry.
+type AuditQuery struct {
+	Since   string
+	Until   string
+	Actions []string
+	Caller  string
+	Limit   int
+}
+
+// A method of AuditQuery that returns whether an AuditEntry matches the query.
+func (query AuditQuery) Match(entry AuditEntry) bool {
+	if query.Since != "" && entry.Time < query.Since {
+		return false
+	}
+	if query.Until != "" && entry.Time > query.Until {
+		return false
+	}
+	if len(query.Actions) > 0 && !containsString(query.Actions, entry.Action) {
+		return false
+	}
+	if query.Caller != "" && entry.Caller != query.Caller {
+		return false
+	}
+	return true
+}
+
+// A struct that defines an append-only audit log that is written to disk as JSON lines.
+// When the log file grows beyond its maximum size, it is rotated to 'audit.log.1' and
+// the older rotated files are shifted up, keeping at most the configured number of files.
+type AuditLog struct {
+	// A mutex that guards the log file
+	mutex sync.Mutex
+
+	// The path to the current log file
+	filepath string
+
+	// The maximum size in bytes of a log file
+	maxsize int64
+
+	// The number of rotated log files to keep
+	maxfiles int
+
+	// The current log file and its size
+	file *os.File
+	size int64
+}
+
+// A constructor function that generates and returns an AuditLog in the directory specified by the
+// 'FYRMESHCONFIG' env variable. Requires the maximum size of a log file and the number of rotated files to keep.
+func NewAuditLog(maxsize int64, maxfiles int) (*AuditLog, error) {
+	// Read the 'FYRMESHCONFIG' env var
+	filedir := os.Getenv("FYRMESHCONFIG")
+	if filedir == "" {
+		return nil, fmt.Errorf("environment variable 'FYRMESHCONFIG' has not been set")
+	}
+
+	// Create the audit log and open its file
+	auditlog := AuditLog{filepath: filepath.Join(filedir, AuditLogFile), maxsize: maxsize, maxfiles: maxfiles}
+	if err := auditlog.open(); err != nil {
+		return nil, err
+	}
+
+	// Return the audit log
+	return &auditlog, nil
+}
+
+// A method of AuditLog that opens the current log file for appending. Must be called with the mutex held.
+func (auditlog *AuditLog) open() error {
+	// Open the file in append mode so that existing entries are never overwritten
+	file, err := os.OpenFile(auditlog.filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open audit log - %v", err)
+	}
+
+	// Retrieve the current size of the file
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("could not stat audit log - %v", err)
+	}
+
+	auditlog.file = file
+	auditlog.size = info.Size()
+	return nil
+}
+
+// A method of AuditLog that rotates the log files. Must be called with the mutex held.
+func (auditlog *AuditLog) rotate() error {
+	// Close the current log file
+	auditlog.file.Close()
+
+	// Remove the oldest rotated file and shift every other rotated file up by one
+	os.Remove(auditlog.rotatedpath(auditlog.maxfiles))
+	for index := auditlog.maxfiles - 1; index >= 1; index-- {
+		os.Rename(auditlog.rotatedpath(index), auditlog.rotatedpath(index+1))
+	}
+
+	// Move the current log file to the first rotated file and open a new one
+	if err := os.Rename(auditlog.filepath, auditlog.rotatedpath(1)); err != nil {
+		return fmt.Errorf("could not rotate audit log - %v", err)
+	}
+	return auditlog.open()
+}
+
+// A method of AuditLog that returns the path to a rotated log file. Index 0 is the current log file.
+func (auditlog *AuditLog) rotatedpath(index int) string {
+	if index == 0 {
+		return auditlog.filepath
+	}
+	return fmt.Sprintf("%s.%d", auditlog.filepath, index)
+}
+
+// A method of AuditLog that appends an AuditEntry to the log, rotating the log file if it is full.
+func (auditlog *AuditLog) Record(entry AuditEntry) error {
+	// Marshal the entry into a JSON line
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not marshal audit entry - %v", err)
+	}
+	line = append(line, '\n')
+
+	auditlog.mutex.Lock()
+	defer auditlog.mutex.Unlock()
+
+	// Rotate the log file if the entry does not fit
+	if auditlog.size > 0 && auditlog.size+int64(len(line)) > auditlog.maxsize {
+		if err := auditlog.rotate(); err != nil {
+			return err
+		}
+	}
+
+	// Append the entry to the log file
+	written, err := auditlog.file.Write(line)
+	auditlog.size += int64(written)
+	if err != nil {
+		return fmt.Errorf("could not write audit entry - %v", err)
+	}
+	return nil
+}
+
+// A method of AuditLog that returns the AuditEntries that match a query, newest first.
+// Every log file, including the rotated files, is searched.
+func (auditlog *AuditLog) Query(query AuditQuery) ([]AuditEntry, error) {
+	auditlog.mutex.Lock()
+	defer auditlog.mutex.Unlock()
+
+	// Read the log files from the newest to the oldest
+	entries := make([]AuditEntry, 0)
+	for index := 0; index <= auditlog.maxfiles; index++ {
+		fileentries, err := readAuditFile(auditlog.rotatedpath(index))
+		if err != nil {
+			return nil, err
+		}
+
+		// Add the matching entries of the file, newest first
+		for position := len(fileentries) - 1; position >= 0; position-- {
+			if query.Match(fileentries[position]) {
+				entries = append(entries, fileentries[position])
+				if query.Limit > 0 && len(entries) >= query.Limit {
+					return entries, nil
+				}
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// A method of AuditLog that closes the log file.
+func (auditlog *AuditLog) Close() error {
+	auditlog.mutex.Lock()
+	defer auditlog.mutex.Unlock()
+	return auditlog.file.Close()
+}
+
+// A function that reads the AuditEntries of an audit log file in the order they were written.
+// A missing file has no entries and lines that cannot be parsed are skipped.
+func readAuditFile(path string) ([]AuditEntry, error) {
+	// Open the log file
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not open audit log - %v", err)
+	}
+	defer file.Close()
+
+	// Parse each line of the file into an entry
+	entries := make([]AuditEntry, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read audit log - %v", err)
+	}
+
+	return entries, nil
+}
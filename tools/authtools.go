@@ -0,0 +1,351 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A type that defines the role of a caller of the orchestrator.
+// Roles are ordered and every role is allowed everything the roles below it are.
+type Role int
+
+// The roles of the callers of the orchestrator.
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+// The names of the files that hold the API tokens accepted by the orchestrator and the API token of
+// the FyrCLI. Both are in the directory specified by the 'FYRMESHCONFIG' env variable.
+const (
+	TokenStoreFile  = "tokens.json"
+	ClientTokenFile = "token"
+)
+
+// The prefix of every API token
+const tokenPrefix = "fyr_"
+
+// A mapping of the role names to the roles
+var rolenames = map[string]Role{
+	"viewer":   RoleViewer,
+	"operator": RoleOperator,
+	"admin":    RoleAdmin,
+}
+
+// A method of Role that returns the name of the role.
+func (role Role) String() string {
+	for name, value := range rolenames {
+		if value == role {
+			return name
+		}
+	}
+	return "none"
+}
+
+// A function that parses the name of a role into a Role.
+func ParseRole(name string) (Role, error) {
+	role, ok := rolenames[strings.ToLower(name)]
+	if !ok {
+		return RoleNone, fmt.Errorf("unknown role '%v'. must be one of viewer, operator or admin", name)
+	}
+	return role, nil
+}
+
+// A function that returns the Role of a verified client certificate from its organizational unit,
+// which is the device type the certificate was generated for. A mesh controller is an admin, a
+// mesh observer is an operator and a certificate of any other device has no role.
+func CertificateRole(certificate *x509.Certificate) Role {
+	for _, unit := range certificate.Subject.OrganizationalUnit {
+		switch unit {
+		case "mesh-controller":
+			return RoleAdmin
+		case "mesh-observer":
+			return RoleOperator
+		}
+	}
+	return RoleNone
+}
+
+// A struct that defines an API token accepted by the orchestrator.
+// Only the SHA-256 hash of the token is stored.
+type APIToken struct {
+	Name    string `json:"name"`
+	Role    string `json:"role"`
+	Hash    string `json:"hash"`
+	Created string `json:"created"`
+}
+
+// A struct that defines the store of API tokens accepted by the orchestrator. The store is
+// backed by a file that is reloaded whenever it changes, so that tokens can be created and
+// revoked by the FyrCLI while the orchestrator is running.
+type TokenStore struct {
+	// A mutex that guards the tokens
+	mutex sync.Mutex
+
+	// The path to the token file
+	filepath string
+
+	// The modification time of the token file when it was last loaded
+	modified time.Time
+
+	// A slice of the tokens in the store
+	tokens []APIToken
+}
+
+// A constructor function that generates and returns a TokenStore for
+// the token file in the directory specified by the 'FYRMESHCONFIG' env variable.
+func NewTokenStore() (*TokenStore, error) {
+	// Read the 'FYRMESHCONFIG' env var
+	filedir := os.Getenv("FYRMESHCONFIG")
+	if filedir == "" {
+		return nil, fmt.Errorf("environment variable 'FYRMESHCONFIG' has not been set")
+	}
+
+	// Create the store and load the token file
+	store := TokenStore{filepath: filepath.Join(filedir, TokenStoreFile)}
+	if err := store.reload(); err != nil {
+		return nil, err
+	}
+
+	// Return the store
+	return &store, nil
+}
+
+// A method of TokenStore that reloads the token file if it has been modified since it was last loaded.
+// A missing token file is an empty store. Must be called with the mutex held or before the store is shared.
+func (store *TokenStore) reload() error {
+	// Check the modification time of the token file
+	info, err := os.Stat(store.filepath)
+	if os.IsNotExist(err) {
+		store.tokens = nil
+		store.modified = time.Time{}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not stat token file - %v", err)
+	}
+	if info.ModTime().Equal(store.modified) {
+		return nil
+	}
+
+	// Read and unmarshal the token file
+	data, err := ioutil.ReadFile(store.filepath)
+	if err != nil {
+		return fmt.Errorf("could not read token file - %v", err)
+	}
+	var tokens []APIToken
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return fmt.Errorf("could not parse token file - %v", err)
+	}
+
+	// Replace the tokens of the store
+	store.tokens = tokens
+	store.modified = info.ModTime()
+	return nil
+}
+
+// A method of TokenStore that writes the tokens of the store to the token file.
+// Must be called with the mutex held.
+func (store *TokenStore) save() error {
+	// Marshal the tokens
+	data, err := json.MarshalIndent(store.tokens, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not marshal tokens - %v", err)
+	}
+
+	// Write the token file so that it is only readable by its owner
+	if err := ioutil.WriteFile(store.filepath, data, 0600); err != nil {
+		return fmt.Errorf("could not write token file - %v", err)
+	}
+
+	// Record the modification time so that the write is not reloaded
+	if info, err := os.Stat(store.filepath); err == nil {
+		store.modified = info.ModTime()
+	}
+	return nil
+}
+
+// A method of TokenStore that authenticates an API token and returns the name and role of
+// its owner. Returns false if the token does not belong to any token in the store.
+func (store *TokenStore) Authenticate(token string) (string, Role, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	// Reload the token file if it has changed. A failed reload keeps the last good tokens.
+	store.reload()
+
+	// Hash the token and compare it with every token in the store
+	hash := hashToken(token)
+	for _, apitoken := range store.tokens {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(apitoken.Hash)) == 1 {
+			role, err := ParseRole(apitoken.Role)
+			if err != nil {
+				return apitoken.Name, RoleNone, false
+			}
+			return apitoken.Name, role, true
+		}
+	}
+
+	return "", RoleNone, false
+}
+
+// A method of TokenStore that creates a new API token with a name and a role and returns the token.
+// The token is only ever returned here, the store keeps its hash. Returns an error if the name is taken.
+func (store *TokenStore) Create(name string, role Role) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	// Reload the token file
+	if err := store.reload(); err != nil {
+		return "", err
+	}
+
+	// Check the name and the role
+	if name == "" {
+		return "", fmt.Errorf("token name cannot be empty")
+	}
+	if role == RoleNone {
+		return "", fmt.Errorf("token must have a role")
+	}
+	for _, apitoken := range store.tokens {
+		if apitoken.Name == name {
+			return "", fmt.Errorf("a token with the name '%v' already exists", name)
+		}
+	}
+
+	// Generate a random token
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("could not generate token - %v", err)
+	}
+	token := tokenPrefix + hex.EncodeToString(secret)
+
+	// Add the token to the store and save it
+	store.tokens = append(store.tokens, APIToken{Name: name, Role: role.String(), Hash: hashToken(token), Created: CurrentISOtime()})
+	if err := store.save(); err != nil {
+		return "", err
+	}
+
+	// Return the token
+	return token, nil
+}
+
+// A method of TokenStore that revokes the API token with a name. Returns an error if there is no such token.
+func (store *TokenStore) Revoke(name string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	// Reload the token file
+	if err := store.reload(); err != nil {
+		return err
+	}
+
+	// Remove the token with the name from the store and save it
+	for index, apitoken := range store.tokens {
+		if apitoken.Name == name {
+			store.tokens = append(store.tokens[:index], store.tokens[index+1:]...)
+			return store.save()
+		}
+	}
+
+	return fmt.Errorf("no token with the name '%v' exists", name)
+}
+
+// A method of TokenStore that returns the tokens in the store sorted by their name.
+func (store *TokenStore) List() ([]APIToken, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	// Reload the token file
+	if err := store.reload(); err != nil {
+		return nil, err
+	}
+
+	// Copy and sort the tokens
+	tokens := make([]APIToken, len(store.tokens))
+	copy(tokens, store.tokens)
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Name < tokens[j].Name })
+	return tokens, nil
+}
+
+// A function that returns the hex encoded SHA-256 hash of an API token.
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// A function that returns the API token used by the FyrCLI. The token is read from the 'FYRMESHTOKEN'
+// env variable if it is set, otherwise from the client token file. Returns an empty string if neither is set.
+func ReadClientToken() string {
+	// Check the 'FYRMESHTOKEN' env var
+	if token := os.Getenv("FYRMESHTOKEN"); token != "" {
+		return token
+	}
+
+	// Read the client token file
+	data, err := ioutil.ReadFile(filepath.Join(os.Getenv("FYRMESHCONFIG"), ClientTokenFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// A function that writes the API token used by the FyrCLI into the client token file.
+func WriteClientToken(token string) error {
+	// Read the 'FYRMESHCONFIG' env var
+	filedir := os.Getenv("FYRMESHCONFIG")
+	if filedir == "" {
+		return fmt.Errorf("environment variable 'FYRMESHCONFIG' has not been set")
+	}
+
+	// Check the format of the token
+	if !strings.HasPrefix(token, tokenPrefix) {
+		return fmt.Errorf("invalid token. tokens start with '%v'", tokenPrefix)
+	}
+
+	// Write the token file so that it is only readable by its owner
+	if err := ioutil.WriteFile(filepath.Join(filedir, ClientTokenFile), []byte(token+"\n"), 0600); err != nil {
+		return fmt.Errorf("could not write token file - %v", err)
+	}
+	return nil
+}
@@ -35,6 +35,8 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"google.golang.org/api/option"
@@ -120,6 +122,212 @@ func NewCloudInterface(meshid string) (*CloudInterface, error) {
 	return &cloudinterface, nil
 }
 
+// The default number of ping documents buffered while the cloud is unavailable
+// and the default interval between attempts to connect to the cloud.
+const (
+	DefaultCloudBufferSize    = 256
+	DefaultCloudRetryInterval = 30 * time.Second
+)
+
+// A struct that represents the sink of the documents that the orchestrator writes to the cloud.
+// The sink holds the CloudInterface once the cloud credentials are available. Until then, the
+// latest MeshDocument and a bounded number of PingDocuments are buffered, dropping the oldest
+// PingDocument when the buffer is full, and are written once the sink connects to the cloud.
+type CloudSink struct {
+	// A mutex that guards the interface and the buffers
+	mutex sync.Mutex
+
+	// The ID of the mesh whose documents are written
+	meshid string
+
+	// The CloudInterface of the mesh, nil until the sink connects
+	cloudinterface *CloudInterface
+
+	// The buffered PingDocuments, the latest buffered MeshDocument and the capacity of the buffer
+	pendingpings []*PingDocument
+	pendingmesh  *MeshDocument
+	capacity     int
+
+	// The number of PingDocuments dropped from the buffer
+	dropped int
+}
+
+// A constructor function that generates and returns a CloudSink for a mesh ID that buffers
+// up to a capacity of PingDocuments. The sink is not connected until Connect succeeds.
+func NewCloudSink(meshid string, capacity int) *CloudSink {
+	return &CloudSink{meshid: meshid, capacity: capacity, pendingpings: make([]*PingDocument, 0)}
+}
+
+// A method of CloudSink that connects the sink to the cloud by constructing a CloudInterface
+// for its mesh ID. Returns true if the sink is connected by this call and nil if it already was.
+func (sink *CloudSink) Connect() (bool, error) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	// Check if the sink is already connected
+	if sink.cloudinterface != nil {
+		return false, nil
+	}
+
+	// Construct the CloudInterface for the mesh ID
+	cloudinterface, err := NewCloudInterface(sink.meshid)
+	if err != nil {
+		return false, err
+	}
+
+	sink.cloudinterface = cloudinterface
+	return true, nil
+}
+
+// A method of CloudSink that returns whether the sink is connected to the cloud.
+func (sink *CloudSink) Connected() bool {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	return sink.cloudinterface != nil
+}
+
+// A method of CloudSink that returns the number of buffered documents and the number of dropped PingDocuments.
+func (sink *CloudSink) Pending() (int, int) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	pending := len(sink.pendingpings)
+	if sink.pendingmesh != nil {
+		pending++
+	}
+	return pending, sink.dropped
+}
+
+// A method of CloudSink that writes a PingDocument to the cloud.
+// Returns true if the document was buffered because the sink is not connected.
+func (sink *CloudSink) PushPing(pingdoc *PingDocument) (bool, error) {
+	sink.mutex.Lock()
+	cloudinterface := sink.cloudinterface
+	if cloudinterface == nil {
+		// Drop the oldest document if the buffer is full and buffer the document
+		if len(sink.pendingpings) >= sink.capacity {
+			sink.pendingpings = sink.pendingpings[1:]
+			sink.dropped++
+		}
+		sink.pendingpings = append(sink.pendingpings, pingdoc)
+		sink.mutex.Unlock()
+		return true, nil
+	}
+	sink.mutex.Unlock()
+
+	// Write the document to the cloud
+	return false, pingdoc.Push(cloudinterface)
+}
+
+// A method of CloudSink that writes a MeshDocument to the cloud.
+// Returns true if the document was buffered because the sink is not connected.
+func (sink *CloudSink) PushMesh(meshdoc *MeshDocument) (bool, error) {
+	sink.mutex.Lock()
+	cloudinterface := sink.cloudinterface
+	if cloudinterface == nil {
+		// Replace the buffered document, only the latest state of the mesh is useful
+		sink.pendingmesh = meshdoc
+		sink.mutex.Unlock()
+		return true, nil
+	}
+	sink.mutex.Unlock()
+
+	// Write the document to the cloud
+	return false, meshdoc.Push(cloudinterface)
+}
+
+// A method of CloudSink that writes the buffered documents to the cloud once the sink is connected.
+// Documents that fail to be written are kept in the buffer. Returns the number of documents written.
+func (sink *CloudSink) FlushPending() (int, error) {
+	// Take the buffered documents
+	sink.mutex.Lock()
+	cloudinterface := sink.cloudinterface
+	if cloudinterface == nil {
+		sink.mutex.Unlock()
+		return 0, fmt.Errorf("cloud sink is not connected")
+	}
+	pendingpings, pendingmesh := sink.pendingpings, sink.pendingmesh
+	sink.pendingpings, sink.pendingmesh = make([]*PingDocument, 0), nil
+	sink.mutex.Unlock()
+
+	// Write the mesh document and the ping documents
+	written := 0
+	var failedpings []*PingDocument
+	var failedmesh *MeshDocument
+	var lasterr error
+	if pendingmesh != nil {
+		if err := pendingmesh.Push(cloudinterface); err != nil {
+			failedmesh, lasterr = pendingmesh, err
+		} else {
+			written++
+		}
+	}
+	for _, pingdoc := range pendingpings {
+		if err := pingdoc.Push(cloudinterface); err != nil {
+			failedpings, lasterr = append(failedpings, pingdoc), err
+		} else {
+			written++
+		}
+	}
+
+	// Return the failed documents to the buffer, behind any that were buffered meanwhile
+	if len(failedpings) > 0 || failedmesh != nil {
+		sink.mutex.Lock()
+		sink.pendingpings = append(failedpings, sink.pendingpings...)
+		if sink.pendingmesh == nil {
+			sink.pendingmesh = failedmesh
+		}
+		sink.mutex.Unlock()
+	}
+
+	return written, lasterr
+}
+
+// A method of CloudSink that closes the Firestore client of the sink if it is connected.
+func (sink *CloudSink) Close() {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	if sink.cloudinterface != nil {
+		sink.cloudinterface.FirestoreClient.Close()
+	}
+}
+
+// A function that connects the CloudSink of the meshorchestrator to the cloud in the background.
+// An attempt to connect is made at every interval until the cloud credentials become available,
+// after which the buffered documents are written to the cloud. Returns when the context is done
+// or the sink has connected and written its buffer.
+func CloudConnector(ctx context.Context, meshorchestrator *MeshOrchestrator, interval time.Duration) {
+	for {
+		// Attempt to connect the sink
+		connected, err := meshorchestrator.Cloud.Connect()
+		if err != nil {
+			meshorchestrator.Health.Set(ComponentCloud, false, fmt.Sprintf("cloud credentials unavailable - %v", err))
+		} else {
+			if connected {
+				meshorchestrator.SendLog(NewOrchCloudlog("(success) cloud interface connected"))
+				meshorchestrator.Health.Set(ComponentCloud, true, "firestore client created")
+			}
+
+			// Write the buffered documents to the cloud
+			written, err := meshorchestrator.Cloud.FlushPending()
+			if err == nil {
+				if written > 0 {
+					meshorchestrator.SendLog(NewOrchCloudlog(fmt.Sprintf("(success) buffered documents flushed | count - %v", written)))
+				}
+				return
+			}
+			meshorchestrator.SendLog(NewOrchCloudlog(fmt.Sprintf("(failure) buffered documents could not be flushed | written - %v | error - %v", written, err)))
+		}
+
+		// Wait for the interval before the next attempt
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
 // A struct that represents the Firestore Document
 // that contains the values that make up a MeshPing
 type PingDocument struct {
@@ -129,6 +337,7 @@ type PingDocument struct {
 	Sensordata      map[string]map[string]float64 `firestore:"sensordata"`
 	Probabilitydata map[string]float64            `firestore:"probability"`
 	AvgProbability  float64                       `firestore:"avgprobability"`
+	Noderecords     map[string]NodeRecord         `firestore:"noderecords"`
 }
 
 // A constructor function that generates and returns a PingDocument object from a given MeshPing.
@@ -144,6 +353,8 @@ func NewPingDocument(meshping *MeshPing) *PingDocument {
 	pingdoc.Sensordata = meshping.GenerateSensordatamap()
 	pingdoc.Probabilitydata = meshping.GenerateProbabilitydatamap()
 	pingdoc.AvgProbability = meshping.GenerateAvgProbability()
+	// Generate and assign the Noderecords of the nodes that responded
+	pingdoc.Noderecords = meshping.GenerateRecordmap()
 
 	// Return the PingDocument
 	return &pingdoc
@@ -179,11 +390,14 @@ type MeshDocument struct {
 	ControlnodeConfig ControlNode           `firestore:"controlnode"`
 	Nodelist          map[string]SensorNode `firestore:"nodes"`
 	NodeIDlist        []int64               `firestore:"nodeids"`
+	Registry          map[string]NodeRecord `firestore:"registry"`
 }
 
 // A constructor function that generates and returns a
 // MeshDocument object from a given MeshOrchestrator
 func NewMeshDocument(meshorchestrator *MeshOrchestrator) *MeshDocument {
+	// Retrieve a snapshot of the state of the MeshOrchestrator
+	meshstate := meshorchestrator.Snapshot()
 	// Create an empty MeshDocument
 	meshdoc := MeshDocument{}
 
@@ -195,27 +409,33 @@ func NewMeshDocument(meshorchestrator *MeshOrchestrator) *MeshDocument {
 
 	// Create and assign the mesh configuration.
 	meshdoc.MeshConfiguration = MeshConfiguration{
-		Meshssid: meshorchestrator.Controlnode.MeshSSID,
-		Meshpswd: meshorchestrator.Controlnode.MeshPSWD,
-		Meshport: meshorchestrator.Controlnode.MeshPORT,
+		Meshssid: meshstate.Controlnode.MeshSSID,
+		Meshpswd: meshstate.Controlnode.MeshPSWD,
+		Meshport: meshstate.Controlnode.MeshPORT,
 	}
 
 	// Assign the Controller ID
 	meshdoc.ControllerID = meshorchestrator.ControllerID
 	// Assign the ControlnodeID after converting to a string
-	meshdoc.ControlnodeID = strconv.FormatInt(meshorchestrator.Controlnode.NodeID, 10)
+	meshdoc.ControlnodeID = strconv.FormatInt(meshstate.Controlnode.NodeID, 10)
 	// Assign the ControlnodeConfig to the ControlNode object
-	meshdoc.ControlnodeConfig = meshorchestrator.Controlnode
+	meshdoc.ControlnodeConfig = meshstate.Controlnode
 	// Assign the NodeIDlist
-	meshdoc.NodeIDlist = meshorchestrator.NodeIDlist
+	meshdoc.NodeIDlist = meshstate.NodeIDlist
 
 	// Generate and assign a Nodelist from the MeshOrchestrator. Needs to have string keys for Firestore.
 	meshdoc.Nodelist = make(map[string]SensorNode)
-	for nodeid, node := range meshorchestrator.Nodelist {
+	for nodeid, node := range meshstate.Nodelist {
 		strnodeid := strconv.FormatInt(nodeid, 10)
 		meshdoc.Nodelist[strnodeid] = node
 	}
 
+	// Generate and assign the Registry from the node registry. Needs to have string keys for Firestore.
+	meshdoc.Registry = make(map[string]NodeRecord)
+	for _, record := range meshorchestrator.Registry.List("") {
+		meshdoc.Registry[strconv.FormatInt(record.NodeID, 10)] = record
+	}
+
 	// Return the MeshDocument
 	return &meshdoc
 }
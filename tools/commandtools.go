@@ -0,0 +1,189 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The value types supported for the metadata keys of control node commands.
+const (
+	// A value that can be any non-empty string
+	CommandValueString = "string"
+	// A value that must be a base 10 integer, such as a node ID
+	CommandValueInt = "int"
+)
+
+// A struct that defines a metadata key accepted by a control node command.
+type CommandKey struct {
+	// The name of the metadata key
+	Key string
+	// The type of the value of the metadata key
+	Type string
+	// A bool indicating whether the key must be set
+	Required bool
+	// A description of the metadata key
+	Description string
+}
+
+// A struct that defines a control node command along with the metadata keys it accepts.
+type CommandSpec struct {
+	// The command message sent to the control node
+	Command string
+	// A description of the command
+	Description string
+	// A slice of metadata keys accepted by the command
+	Keys []CommandKey
+}
+
+// A struct that defines the registry of all the commands supported by the control node.
+type CommandRegistry struct {
+	// A mapping of command messages to their CommandSpecs
+	commands map[string]CommandSpec
+}
+
+// A constructor function that generates and returns a CommandRegistry
+// with all the commands supported by the control node registered.
+func NewCommandRegistry() *CommandRegistry {
+	// Create an empty CommandRegistry
+	registry := CommandRegistry{commands: make(map[string]CommandSpec)}
+
+	// Declare the common metadata keys
+	pingkey := CommandKey{Key: "ping", Type: CommandValueString, Required: true, Description: "ID of the ping that the responses are tagged with"}
+	nodekey := CommandKey{Key: "node", Type: CommandValueInt, Required: true, Description: "ID of the node to read from"}
+
+	// Register the commands of the control node
+	registry.Register(CommandSpec{Command: "readsensors-mesh", Description: "reads the sensors of every node on the mesh", Keys: []CommandKey{pingkey}})
+	registry.Register(CommandSpec{Command: "readsensors-node", Description: "reads the sensors of a node", Keys: []CommandKey{pingkey, nodekey}})
+	registry.Register(CommandSpec{Command: "readconfig-mesh", Description: "reads the config of every node on the mesh", Keys: []CommandKey{pingkey}})
+	registry.Register(CommandSpec{Command: "readconfig-node", Description: "reads the config of a node", Keys: []CommandKey{pingkey, nodekey}})
+	registry.Register(CommandSpec{Command: "readconfig-control", Description: "reads the config of the control node"})
+	registry.Register(CommandSpec{Command: "readnodelist-control", Description: "reads the list of nodes connected to the control node"})
+	registry.Register(CommandSpec{Command: "readtopology-control", Description: "reads the connections between the nodes of the mesh, if the firmware of the control node reports them"})
+	registry.Register(CommandSpec{Command: "connection-on", Description: "sets the mesh connection of the control node on"})
+	registry.Register(CommandSpec{Command: "connection-off", Description: "sets the mesh connection of the control node off"})
+
+	// Return the registry
+	return &registry
+}
+
+// A method of CommandRegistry that registers a CommandSpec. Returns an error if
+// the command is already registered or if any of its keys has an unknown type.
+func (registry *CommandRegistry) Register(spec CommandSpec) error {
+	// Check if the command is already registered
+	if _, exists := registry.commands[spec.Command]; exists {
+		return fmt.Errorf("command '%v' is already registered", spec.Command)
+	}
+
+	// Check the value type of every key
+	for _, key := range spec.Keys {
+		switch key.Type {
+		case CommandValueString, CommandValueInt:
+		default:
+			return fmt.Errorf("key '%v' of command '%v' has an unknown type '%v'", key.Key, spec.Command, key.Type)
+		}
+	}
+
+	// Add the command to the registry
+	registry.commands[spec.Command] = spec
+	return nil
+}
+
+// A method of CommandRegistry that returns the CommandSpec of a command and whether it is registered.
+func (registry *CommandRegistry) Lookup(command string) (CommandSpec, bool) {
+	spec, ok := registry.commands[command]
+	return spec, ok
+}
+
+// A method of CommandRegistry that returns all the registered CommandSpecs sorted by their command.
+func (registry *CommandRegistry) List() []CommandSpec {
+	// Collect the specs into a slice
+	specs := make([]CommandSpec, 0, len(registry.commands))
+	for _, spec := range registry.commands {
+		specs = append(specs, spec)
+	}
+
+	// Sort the specs by command and return them
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Command < specs[j].Command })
+	return specs
+}
+
+// A method of CommandRegistry that validates a command message and its metadata.
+// Returns an error that describes the problem if the command is not registered, a
+// required key is missing, an unknown key is set or a value does not match its type.
+func (registry *CommandRegistry) Validate(command string, metadata map[string]string) error {
+	// Check that the command is registered
+	spec, ok := registry.Lookup(command)
+	if !ok {
+		return fmt.Errorf("unknown command '%v'", command)
+	}
+
+	// Collect the keys of the command into a map
+	keys := make(map[string]CommandKey)
+	for _, key := range spec.Keys {
+		keys[key.Key] = key
+
+		// Check that every required key has been set
+		if _, set := metadata[key.Key]; key.Required && !set {
+			return fmt.Errorf("command '%v' requires the metadata key '%v'", command, key.Key)
+		}
+	}
+
+	// Collect and sort the metadata keys so that errors are reported consistently
+	metadatakeys := make([]string, 0, len(metadata))
+	for metadatakey := range metadata {
+		metadatakeys = append(metadatakeys, metadatakey)
+	}
+	sort.Strings(metadatakeys)
+
+	// Check every metadata value against its key
+	for _, metadatakey := range metadatakeys {
+		value := metadata[metadatakey]
+		key, ok := keys[metadatakey]
+		if !ok {
+			return fmt.Errorf("command '%v' does not accept the metadata key '%v'", command, metadatakey)
+		}
+
+		switch key.Type {
+		case CommandValueString:
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("metadata key '%v' of command '%v' must not be empty", metadatakey, command)
+			}
+
+		case CommandValueInt:
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return fmt.Errorf("metadata key '%v' of command '%v' must be an integer - got '%v'", metadatakey, command, value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -36,6 +36,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 )
 
 // A struct that defines the configuration of the FyrMesh service
@@ -44,13 +45,37 @@ type Config struct {
 	DeviceType        string                   `json:"deviceType"`
 	Services          map[string]ServiceConfig `json:"services"`
 	SchedulerPingRate int                      `json:"pingrate"`
+	ShutdownTimeout   int                      `json:"shutdowntimeout,omitempty"`
+	Queues            map[string]QueueConfig   `json:"queues,omitempty"`
+	Outbox            OutboxConfig             `json:"outbox"`
+	Metrics           MetricsConfig            `json:"metrics"`
+	Tracing           TracingConfig            `json:"tracing"`
+	RateLimit         RateLimitConfig          `json:"ratelimit"`
+	Liveness          LivenessConfig           `json:"liveness"`
+	TLS               TLSConfig                `json:"tls"`
+}
+
+// The number of seconds the orchestrator waits for a graceful shutdown
+// when the ShutdownTimeout is not set in the config file.
+const DefaultShutdownTimeout = 30
+
+// A method of Config that returns the duration that the orchestrator waits for a
+// graceful shutdown. Falls back to the DefaultShutdownTimeout if it is not set.
+func (config Config) GetShutdownTimeout() time.Duration {
+	if config.ShutdownTimeout <= 0 {
+		return time.Second * DefaultShutdownTimeout
+	}
+	return time.Second * time.Duration(config.ShutdownTimeout)
 }
 
 // A struct that defines the configuration of an individual
 // service that is a part of the FyrMesh service
 type ServiceConfig struct {
-	Host string `json:"host"`
-	Port int    `json:"port"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	TLS       bool   `json:"tls"`
+	MutualTLS bool   `json:"mtls"`
+	Auth      bool   `json:"auth,omitempty"`
 }
 
 // A function that reads the config file that is located in the path specified
@@ -135,6 +160,42 @@ func WriteConfig(config Config) error {
 	return nil
 }
 
+// A function that durably replaces the file at path with data. The data is written
+// and synced to a temporary file which is then renamed over path, after which the
+// parent directory is synced so that the rename itself survives a power loss.
+func writeFileSynced(path string, data []byte, perm os.FileMode) error {
+	// Write the data to a temporary file and flush it to the disk
+	temppath := path + ".tmp"
+	file, err := os.OpenFile(temppath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	// Move the temporary file over the target file
+	if err := os.Rename(temppath, path); err != nil {
+		return err
+	}
+
+	// Sync the parent directory to persist the rename
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
 // A function that extracts the Serial ID from the byte array
 // output generated by the bash command to retrieve the CPU info
 func extractserial(serialbytes []byte) string {
@@ -165,6 +226,7 @@ func GenerateConfig() error {
 			"LINK": {Host: "localhost", Port: 50000},
 		},
 		SchedulerPingRate: 15,
+		ShutdownTimeout:   DefaultShutdownTimeout,
 	}
 
 	// Test the runtime environment and generate device values.
@@ -0,0 +1,138 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"sort"
+	"sync"
+)
+
+// The names of the components of the orchestrator whose health is tracked.
+const (
+	// The log stream from the LINK server
+	ComponentLINK = "link"
+	// The handler that writes commands to the LINK server
+	ComponentCommandHandler = "commandhandler"
+	// The handler that accumulates pings from the mesh
+	ComponentPingHandler = "pinghandler"
+	// The Firestore client of the cloud interface
+	ComponentCloud = "cloud"
+)
+
+// A struct that defines the health of a component of the orchestrator.
+type ComponentHealth struct {
+	// The name of the component
+	Component string
+	// A bool indicating whether the component is working
+	Healthy bool
+	// A short description of the reason for the health of the component
+	Reason string
+	// The ISO time at which the health of the component last changed
+	Updated string
+}
+
+// A struct that defines a registry of the health of the components of the orchestrator.
+// Watchers registered on the registry are called whenever the health of a component is set.
+type HealthRegistry struct {
+	// A mutex that guards the components and the watchers
+	mutex sync.Mutex
+
+	// A mapping of component names to their health
+	components map[string]ComponentHealth
+
+	// A slice of functions that are called with the health of a component when it is set
+	watchers []func(ComponentHealth)
+}
+
+// A constructor function that generates and returns a HealthRegistry.
+// Every component is registered as unhealthy until it reports otherwise.
+func NewHealthRegistry() *HealthRegistry {
+	// Create an empty HealthRegistry
+	registry := HealthRegistry{components: make(map[string]ComponentHealth)}
+
+	// Register every component as not started
+	for _, component := range []string{ComponentLINK, ComponentCommandHandler, ComponentPingHandler, ComponentCloud} {
+		registry.components[component] = ComponentHealth{Component: component, Healthy: false, Reason: "not started", Updated: CurrentISOtime()}
+	}
+
+	// Return the registry
+	return &registry
+}
+
+// A method of HealthRegistry that sets the health of a component along with
+// the reason for it and calls every watcher with the new health of the component.
+func (registry *HealthRegistry) Set(component string, healthy bool, reason string) {
+	// Update the health of the component
+	registry.mutex.Lock()
+	health := ComponentHealth{Component: component, Healthy: healthy, Reason: reason, Updated: CurrentISOtime()}
+	registry.components[component] = health
+	watchers := append([]func(ComponentHealth){}, registry.watchers...)
+	registry.mutex.Unlock()
+
+	// Call the watchers outside the lock
+	for _, watcher := range watchers {
+		watcher(health)
+	}
+}
+
+// A method of HealthRegistry that returns the health of a component and whether it is registered.
+func (registry *HealthRegistry) Get(component string) (ComponentHealth, bool) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	health, ok := registry.components[component]
+	return health, ok
+}
+
+// A method of HealthRegistry that returns the health of every component sorted by their name.
+func (registry *HealthRegistry) List() []ComponentHealth {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Collect the health of the components into a slice
+	components := make([]ComponentHealth, 0, len(registry.components))
+	for _, health := range registry.components {
+		components = append(components, health)
+	}
+
+	// Sort the components by name and return them
+	sort.Slice(components, func(i, j int) bool { return components[i].Component < components[j].Component })
+	return components
+}
+
+// A method of HealthRegistry that registers a watcher and calls it with the current health of every component.
+func (registry *HealthRegistry) Watch(watcher func(ComponentHealth)) {
+	registry.mutex.Lock()
+	registry.watchers = append(registry.watchers, watcher)
+	registry.mutex.Unlock()
+
+	// Call the watcher with the current health of every component
+	for _, health := range registry.List() {
+		watcher(health)
+	}
+}
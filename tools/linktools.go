@@ -0,0 +1,185 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"sync"
+	"time"
+)
+
+// A type that defines the state of the read stream from the LINK server.
+type LinkState int
+
+// The states of the read stream from the LINK server.
+const (
+	LinkConnecting LinkState = iota + 1
+	LinkConnected
+	LinkBackoff
+	LinkStopped
+)
+
+// A method of LinkState that returns the name of the state.
+func (state LinkState) String() string {
+	switch state {
+	case LinkConnecting:
+		return "connecting"
+	case LinkConnected:
+		return "connected"
+	case LinkBackoff:
+		return "backoff"
+	case LinkStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// A struct that defines the status of the read stream from the LINK server.
+// All times are ISO8601 strings and are empty if the event has not occurred.
+type LinkStatus struct {
+	// The current state of the stream and the time it was entered
+	State LinkState
+	Since string
+
+	// The number of times the stream has been reopened after it was first opened
+	Reconnects int
+
+	// The number of consecutive failed attempts to open the stream and the time of the next attempt
+	Attempts  int
+	NextRetry string
+
+	// The last error that broke the stream or failed an attempt to open it
+	LastError string
+
+	// The start of the current outage, empty if the stream is open
+	OutageStart string
+
+	// The start and end of the last outage that has ended
+	LastOutageStart string
+	LastOutageEnd   string
+}
+
+// A struct that defines the monitor that records the status of the read stream from the LINK server.
+// The monitor is updated by the supervisor of the stream and read by the status of the orchestrator.
+type LinkMonitor struct {
+	// A mutex that guards the status
+	mutex sync.Mutex
+
+	// The status of the stream
+	status LinkStatus
+
+	// A bool that indicates whether the stream has been opened before
+	opened bool
+}
+
+// A constructor function that generates and returns a LinkMonitor in the connecting state.
+func NewLinkMonitor() *LinkMonitor {
+	return &LinkMonitor{status: LinkStatus{State: LinkConnecting, Since: CurrentISOtime()}}
+}
+
+// A method of LinkMonitor that records an attempt to open the stream.
+func (monitor *LinkMonitor) Connecting() {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	monitor.status.State = LinkConnecting
+	monitor.status.Since = CurrentISOtime()
+	monitor.status.NextRetry = ""
+}
+
+// A method of LinkMonitor that records the stream being opened and ends the current outage.
+// Returns whether the stream was reopened and the start of the outage that ended, if any.
+func (monitor *LinkMonitor) Connected() (bool, string) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	now := CurrentISOtime()
+	reopened := monitor.opened
+	outagestart := monitor.status.OutageStart
+
+	// Count the reconnect and mark the stream as opened
+	if reopened {
+		monitor.status.Reconnects++
+	}
+	monitor.opened = true
+
+	// End the current outage
+	if outagestart != "" {
+		monitor.status.LastOutageStart = outagestart
+		monitor.status.LastOutageEnd = now
+		monitor.status.OutageStart = ""
+	}
+
+	// Set the state of the stream
+	monitor.status.State = LinkConnected
+	monitor.status.Since = now
+	monitor.status.Attempts = 0
+	monitor.status.NextRetry = ""
+
+	return reopened, outagestart
+}
+
+// A method of LinkMonitor that records the stream breaking or an attempt to open it failing,
+// starting an outage if one is not in progress, and the time of the next attempt.
+func (monitor *LinkMonitor) Backoff(err error, next time.Time) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	now := CurrentISOtime()
+
+	// Start an outage if one is not in progress
+	if monitor.status.OutageStart == "" {
+		monitor.status.OutageStart = now
+	}
+
+	// Set the state of the stream
+	monitor.status.State = LinkBackoff
+	monitor.status.Since = now
+	monitor.status.Attempts++
+	monitor.status.NextRetry = next.UTC().Format("2006-01-02T15:04:05")
+	if err != nil {
+		monitor.status.LastError = err.Error()
+	}
+}
+
+// A method of LinkMonitor that records the supervisor of the stream stopping.
+func (monitor *LinkMonitor) Stopped() {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	monitor.status.State = LinkStopped
+	monitor.status.Since = CurrentISOtime()
+	monitor.status.NextRetry = ""
+}
+
+// A method of LinkMonitor that returns the current status of the stream.
+func (monitor *LinkMonitor) Status() LinkStatus {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	return monitor.status
+}
@@ -0,0 +1,314 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The liveness states of a node on the mesh.
+const (
+	// The node has been heard from within the stale threshold
+	NodeOnline = "online"
+	// The node has not been heard from within the stale threshold
+	NodeStale = "stale"
+	// The node has not been heard from within the offline threshold
+	NodeOffline = "offline"
+)
+
+// The default number of seconds after which a node that has not been heard from is stale or offline.
+const (
+	DefaultLivenessStale   = 120
+	DefaultLivenessOffline = 600
+)
+
+// The interval at which the liveness of the nodes is checked against the thresholds.
+const DefaultLivenessInterval = time.Second * 10
+
+// A struct that defines the configuration of the liveness tracking of the nodes.
+// The thresholds are the number of seconds since a node was last heard from.
+type LivenessConfig struct {
+	Stale   int `json:"stale,omitempty"`
+	Offline int `json:"offline,omitempty"`
+}
+
+// A method of LivenessConfig that returns the duration after which a node that has
+// not been heard from is stale. Falls back to the DefaultLivenessStale if it is not set.
+func (config LivenessConfig) GetStale() time.Duration {
+	if config.Stale <= 0 {
+		return time.Second * DefaultLivenessStale
+	}
+	return time.Second * time.Duration(config.Stale)
+}
+
+// A method of LivenessConfig that returns the duration after which a node that has not
+// been heard from is offline. Falls back to the DefaultLivenessOffline if it is not set.
+func (config LivenessConfig) GetOffline() time.Duration {
+	if config.Offline <= 0 {
+		return time.Second * DefaultLivenessOffline
+	}
+	return time.Second * time.Duration(config.Offline)
+}
+
+// A struct that defines the liveness of a node on the mesh.
+type NodeLiveness struct {
+	// The identifier of the node
+	NodeID int64
+	// The liveness state of the node
+	State string
+	// The time at which the node was last heard from
+	LastSeen time.Time
+	// The time at which the node entered its liveness state
+	Since time.Time
+	// The number of consecutive pings the node has not responded to
+	MissedPings int
+
+	// The time at which the node was last pinged
+	pinged time.Time
+}
+
+// A struct that defines a change of the liveness state of a node.
+type NodeTransition struct {
+	// The liveness of the node after the change
+	NodeLiveness
+	// The liveness state of the node before the change
+	Previous string
+}
+
+// A struct that defines a tracker of the liveness of the nodes on the mesh. Nodes are online
+// while they are heard from and become stale and then offline as the time since they were
+// last heard from crosses the thresholds. Watchers registered on the tracker are called
+// whenever the liveness state of a node changes.
+type LivenessTracker struct {
+	// A mutex that guards the nodes and the watchers
+	mutex sync.Mutex
+
+	// The durations after which a node that has not been heard from is stale and offline
+	stale, offline time.Duration
+
+	// A mapping of node IDs to their liveness
+	nodes map[int64]NodeLiveness
+
+	// A slice of functions that are called with every change of the liveness state of a node
+	watchers []func(NodeTransition)
+}
+
+// A constructor function that generates and returns a LivenessTracker with no nodes.
+// Returns an error if the offline threshold is not greater than the stale threshold.
+func NewLivenessTracker(config LivenessConfig) (*LivenessTracker, error) {
+	stale, offline := config.GetStale(), config.GetOffline()
+	if offline <= stale {
+		return nil, fmt.Errorf("offline threshold (%v) must be greater than the stale threshold (%v)", offline, stale)
+	}
+
+	return &LivenessTracker{stale: stale, offline: offline, nodes: make(map[int64]NodeLiveness)}, nil
+}
+
+// A method of LivenessTracker that registers a watcher that is
+// called with every change of the liveness state of a node.
+func (tracker *LivenessTracker) Watch(watcher func(NodeTransition)) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.watchers = append(tracker.watchers, watcher)
+}
+
+// A method of LivenessTracker that records a node being heard from at the given time.
+// The node is tracked if it is unknown, its missed pings are reset and it is set online.
+func (tracker *LivenessTracker) Seen(nodeid int64, now time.Time) {
+	tracker.mutex.Lock()
+	node, ok := tracker.nodes[nodeid]
+	if !ok {
+		node = NodeLiveness{NodeID: nodeid, State: NodeOnline, Since: now}
+	}
+	node.LastSeen = now
+	node.MissedPings = 0
+	transition, changed := tracker.setState(&node, NodeOnline, now)
+	tracker.nodes[nodeid] = node
+	watchers := append([]func(NodeTransition){}, tracker.watchers...)
+	tracker.mutex.Unlock()
+
+	// Call the watchers outside the lock
+	if changed {
+		for _, watcher := range watchers {
+			watcher(transition)
+		}
+	}
+}
+
+// A method of LivenessTracker that tracks the nodes in a list of node IDs reported by the control node.
+// Unknown nodes are tracked as online from the given time. The liveness of known nodes is unchanged.
+func (tracker *LivenessTracker) Track(nodeids []int64, now time.Time) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	for _, nodeid := range nodeids {
+		if _, ok := tracker.nodes[nodeid]; !ok {
+			tracker.nodes[nodeid] = NodeLiveness{NodeID: nodeid, State: NodeOnline, LastSeen: now, Since: now}
+		}
+	}
+}
+
+// A method of LivenessTracker that records the tracked nodes in a list of node IDs being pinged at the given
+// time. A node that has not been heard from since it was last pinged has missed that ping, which is counted.
+func (tracker *LivenessTracker) Pinged(nodeids []int64, now time.Time) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	for _, nodeid := range nodeids {
+		node, ok := tracker.nodes[nodeid]
+		if !ok {
+			continue
+		}
+
+		// Count the previous ping as missed if the node has not responded to it
+		if !node.pinged.IsZero() && node.LastSeen.Before(node.pinged) {
+			node.MissedPings++
+		}
+		node.pinged = now
+		tracker.nodes[nodeid] = node
+	}
+}
+
+// A method of LivenessTracker that checks the time since each node was last heard from
+// against the thresholds at the given time and updates the liveness state of the nodes.
+func (tracker *LivenessTracker) Sweep(now time.Time) {
+	tracker.mutex.Lock()
+	transitions := make([]NodeTransition, 0)
+	for nodeid, node := range tracker.nodes {
+		// Determine the state of the node from the time since it was last heard from
+		state := NodeOnline
+		switch age := now.Sub(node.LastSeen); {
+		case age >= tracker.offline:
+			state = NodeOffline
+		case age >= tracker.stale:
+			state = NodeStale
+		}
+
+		if transition, changed := tracker.setState(&node, state, now); changed {
+			tracker.nodes[nodeid] = node
+			transitions = append(transitions, transition)
+		}
+	}
+	watchers := append([]func(NodeTransition){}, tracker.watchers...)
+	tracker.mutex.Unlock()
+
+	// Sort the transitions by node ID and call the watchers outside the lock
+	sort.Slice(transitions, func(i, j int) bool { return transitions[i].NodeID < transitions[j].NodeID })
+	for _, transition := range transitions {
+		for _, watcher := range watchers {
+			watcher(transition)
+		}
+	}
+}
+
+// A method of LivenessTracker that sets the liveness state of a node and returns
+// the transition and whether the state changed. Must be called while holding the mutex.
+func (tracker *LivenessTracker) setState(node *NodeLiveness, state string, now time.Time) (NodeTransition, bool) {
+	if node.State == state {
+		return NodeTransition{}, false
+	}
+
+	previous := node.State
+	node.State = state
+	node.Since = now
+	return NodeTransition{NodeLiveness: *node, Previous: previous}, true
+}
+
+// A method of LivenessTracker that returns the liveness of a node and whether it is tracked.
+func (tracker *LivenessTracker) Get(nodeid int64) (NodeLiveness, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	node, ok := tracker.nodes[nodeid]
+	return node, ok
+}
+
+// A method of LivenessTracker that returns the liveness of every tracked node sorted by their node ID.
+func (tracker *LivenessTracker) List() []NodeLiveness {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	// Collect the liveness of the nodes into a slice
+	nodes := make([]NodeLiveness, 0, len(tracker.nodes))
+	for _, node := range tracker.nodes {
+		nodes = append(nodes, node)
+	}
+
+	// Sort the nodes by their ID and return them
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeID < nodes[j].NodeID })
+	return nodes
+}
+
+// A function that runs the liveness checks of the nodes of the meshorchestrator at every interval.
+// Returns when the context is done.
+func LivenessMonitor(ctx context.Context, meshorchestrator *MeshOrchestrator, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			meshorchestrator.Liveness.Sweep(now)
+		}
+	}
+}
+
+// A method of MeshOrchestrator that records the node of a
+// 'sensordata', 'configdata' or 'handshake' log being heard from.
+func (meshorchestrator *MeshOrchestrator) nodeSeen(log Log) {
+	nodeid, _ := strconv.ParseInt(log.GetLogmetadata()["node"], 0, 64)
+	meshorchestrator.Metrics.NodeSeen(nodeid)
+	meshorchestrator.Liveness.Seen(nodeid, time.Now())
+}
+
+// A method of MeshOrchestrator that records the nodes addressed by a command as pinged.
+// Mesh pings address every node on the mesh and node pings address the node of the command.
+// Commands that do not carry a ping ID are ignored.
+func (meshorchestrator *MeshOrchestrator) TrackPing(command map[string]string) {
+	if command["ping"] == "" {
+		return
+	}
+
+	switch {
+	case strings.HasSuffix(command["command"], "-mesh"):
+		meshorchestrator.Liveness.Pinged(meshorchestrator.GetNodeIDlist(), time.Now())
+	case strings.HasSuffix(command["command"], "-node"):
+		nodeid, err := strconv.ParseInt(command["node"], 0, 64)
+		if err == nil {
+			meshorchestrator.Liveness.Pinged([]int64{nodeid}, time.Now())
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"testing"
+	"time"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that returns a LivenessTracker with a stale threshold of 2 minutes and an offline threshold of 10 minutes.
+func newTestLivenessTracker(t *testing.T) *tools.LivenessTracker {
+	tracker, err := tools.NewLivenessTracker(tools.LivenessConfig{Stale: 120, Offline: 600})
+	if err != nil {
+		t.Fatalf("could not create liveness tracker - %v", err)
+	}
+	return tracker
+}
+
+// A test that checks that the thresholds of the liveness config are validated.
+func TestNewLivenessTracker(t *testing.T) {
+	tests := []struct {
+		config tools.LivenessConfig
+		valid  bool
+	}{
+		{tools.LivenessConfig{}, true},
+		{tools.LivenessConfig{Stale: 10, Offline: 20}, true},
+		{tools.LivenessConfig{Stale: 20, Offline: 20}, false},
+		{tools.LivenessConfig{Stale: 30, Offline: 20}, false},
+		{tools.LivenessConfig{Stale: 900}, false},
+	}
+
+	for _, test := range tests {
+		if _, err := tools.NewLivenessTracker(test.config); (err == nil) != test.valid {
+			t.Errorf("%+v: expected valid %v, got error %v", test.config, test.valid, err)
+		}
+	}
+}
+
+// A test that sweeps a node that was last heard from some time ago and checks its liveness state
+// and the transitions reported to the watchers.
+func TestLivenessTrackerSweep(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		sweeps      []time.Duration
+		state       string
+		transitions []string
+	}{
+		{"recently seen", []time.Duration{time.Minute}, tools.NodeOnline, []string{}},
+		{"stale", []time.Duration{2 * time.Minute}, tools.NodeStale, []string{"online>stale"}},
+		{"offline", []time.Duration{3 * time.Minute, 10 * time.Minute}, tools.NodeOffline, []string{"online>stale", "stale>offline"}},
+		{"offline at once", []time.Duration{time.Hour}, tools.NodeOffline, []string{"online>offline"}},
+		{"swept again", []time.Duration{3 * time.Minute, 4 * time.Minute, 5 * time.Minute}, tools.NodeStale, []string{"online>stale"}},
+	}
+
+	for _, test := range tests {
+		tracker := newTestLivenessTracker(t)
+		transitions := make([]string, 0)
+		tracker.Watch(func(transition tools.NodeTransition) {
+			transitions = append(transitions, transition.Previous+">"+transition.State)
+		})
+
+		tracker.Seen(1, start)
+		for _, elapsed := range test.sweeps {
+			tracker.Sweep(start.Add(elapsed))
+		}
+
+		node, _ := tracker.Get(1)
+		if node.State != test.state {
+			t.Errorf("%v: expected state %v, got %v", test.name, test.state, node.State)
+		}
+		if len(transitions) != len(test.transitions) {
+			t.Errorf("%v: expected transitions %v, got %v", test.name, test.transitions, transitions)
+			continue
+		}
+		for index := range transitions {
+			if transitions[index] != test.transitions[index] {
+				t.Errorf("%v: expected transitions %v, got %v", test.name, test.transitions, transitions)
+				break
+			}
+		}
+	}
+}
+
+// A test that checks that a node that is heard from again after it went offline is set online.
+func TestLivenessTrackerSeenAfterOffline(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestLivenessTracker(t)
+
+	var last tools.NodeTransition
+	tracker.Watch(func(transition tools.NodeTransition) { last = transition })
+
+	tracker.Seen(1, start)
+	tracker.Sweep(start.Add(time.Hour))
+	tracker.Seen(1, start.Add(time.Hour+time.Minute))
+
+	node, _ := tracker.Get(1)
+	if node.State != tools.NodeOnline || !node.Since.Equal(start.Add(time.Hour+time.Minute)) {
+		t.Errorf("expected the node to be online since it was seen, got %+v", node)
+	}
+	if last.Previous != tools.NodeOffline || last.State != tools.NodeOnline {
+		t.Errorf("expected an offline to online transition, got %v to %v", last.Previous, last.State)
+	}
+}
+
+// A test that pings the nodes and checks the number of consecutive pings each node missed.
+func TestLivenessTrackerPinged(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// The events in order, 'p' is a ping of the node and 's' is a response from the node
+		events string
+		missed int
+	}{
+		{"never pinged", "", 0},
+		{"one ping unanswered", "p", 0},
+		{"answered pings", "psps", 0},
+		{"missed pings", "ppp", 2},
+		{"answered after missing", "pppsp", 0},
+		{"missed after answering", "pspp", 1},
+	}
+
+	for _, test := range tests {
+		tracker := newTestLivenessTracker(t)
+		tracker.Track([]int64{1}, start)
+
+		now := start
+		for _, event := range test.events {
+			now = now.Add(time.Second)
+			switch event {
+			case 'p':
+				tracker.Pinged([]int64{1}, now)
+			case 's':
+				tracker.Seen(1, now)
+			}
+		}
+
+		node, _ := tracker.Get(1)
+		if node.MissedPings != test.missed {
+			t.Errorf("%v: expected %v missed pings, got %v", test.name, test.missed, node.MissedPings)
+		}
+	}
+}
+
+// A test that checks that pings of nodes that are not tracked are ignored
+// and that tracking the nodes again leaves the liveness of known nodes unchanged.
+func TestLivenessTrackerTrack(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestLivenessTracker(t)
+
+	tracker.Pinged([]int64{1}, start)
+	if _, ok := tracker.Get(1); ok {
+		t.Errorf("expected a ping to leave an unknown node untracked")
+	}
+
+	tracker.Track([]int64{2, 1}, start)
+	tracker.Sweep(start.Add(time.Hour))
+	tracker.Track([]int64{1, 2, 3}, start.Add(time.Hour))
+
+	nodes := tracker.List()
+	expected := []string{tools.NodeOffline, tools.NodeOffline, tools.NodeOnline}
+	if len(nodes) != len(expected) {
+		t.Fatalf("expected %v nodes, got %+v", len(expected), nodes)
+	}
+	for index, node := range nodes {
+		if node.NodeID != int64(index+1) || node.State != expected[index] {
+			t.Errorf("expected node %v to be %v, got node %v %v", index+1, expected[index], node.NodeID, node.State)
+		}
+	}
+}
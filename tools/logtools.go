@@ -29,17 +29,24 @@ package tools
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
 
 // A function that returns the current time as an ISO8601 string without the timezone.
 func CurrentISOtime() string {
-	return time.Now().UTC().Format("2006-01-02T15:04:05")
+	return FormatISOtime(time.Now())
+}
+
+// A function that returns a given time as an ISO time string in UTC.
+func FormatISOtime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05")
 }
 
 // A function that deserializes a a string with a format akin
 // to 'key1-value1=key2-value2..' into a map[string]string """
+// Pairs without a key or a separator are skipped, so that a truncated string never panics.
 func Deepdeserialize(str string) map[string]string {
 	// Split the string into individual key-value pairs
 	pairs := strings.Split(str, "=")
@@ -48,8 +55,11 @@ func Deepdeserialize(str string) map[string]string {
 
 	// Iterate over the key-value pairs
 	for _, pair := range pairs {
-		// Split each key-value pair
-		set := strings.Split(pair, "-")
+		// Split each key-value pair, skipping the malformed pairs
+		set := strings.SplitN(pair, "-", 2)
+		if len(set) != 2 || set[0] == "" {
+			continue
+		}
 		// Add the key value pair into the map
 		dict[set[0]] = set[1]
 	}
@@ -58,6 +68,97 @@ func Deepdeserialize(str string) map[string]string {
 	return dict
 }
 
+// A function that checks that a string with a format akin to 'key1-value1=key2-value2..' is well
+// formed and carries the required keys. Returns an error for a pair without a key or a separator.
+func checkDeepserialized(str string, required ...string) error {
+	if str == "" {
+		return fmt.Errorf("is empty")
+	}
+	for _, pair := range strings.Split(str, "=") {
+		if set := strings.SplitN(pair, "-", 2); len(set) != 2 || set[0] == "" {
+			return fmt.Errorf("has a malformed pair '%v'", pair)
+		}
+	}
+
+	dict := Deepdeserialize(str)
+	for _, key := range required {
+		if _, ok := dict[key]; !ok {
+			return fmt.Errorf("is missing the key '%v'", key)
+		}
+	}
+	return nil
+}
+
+// A function that checks that a log carries a known log type and the metadata that the LogHandler
+// requires for its type, such as a log from a recording that is fed into the orchestrator.
+// Returns an error that describes the first problem with the log.
+func ValidateLog(log Log) error {
+	metadata := log.GetLogmetadata()
+
+	// A function that checks that a metadata key holds a positive node ID
+	checknode := func(key string) error {
+		if nodeid, err := strconv.ParseInt(metadata[key], 0, 64); err != nil || nodeid <= 0 {
+			return fmt.Errorf("invalid '%v' metadata - '%v'", key, metadata[key])
+		}
+		return nil
+	}
+
+	switch logtype := log.GetLogtype(); logtype {
+	case "serverlog", "protolog", "cloudlog", "schedlog", "message", "nodesync", "nodestate", "meshsync":
+		return nil
+
+	case "handshake":
+		return checknode("node")
+
+	case "sensordata":
+		if err := checknode("node"); err != nil {
+			return err
+		}
+		if err := checkDeepserialized(metadata["sensors"]); err != nil {
+			return fmt.Errorf("invalid 'sensors' metadata - %v", err)
+		}
+		for sensortype, sensorvalue := range Deepdeserialize(metadata["sensors"]) {
+			if _, err := strconv.ParseFloat(sensorvalue, 64); err != nil {
+				return fmt.Errorf("invalid 'sensors' metadata - reading of '%v' is not a number", sensortype)
+			}
+		}
+		return nil
+
+	case "configdata":
+		if err := checknode("node"); err != nil {
+			return err
+		}
+		if err := checkDeepserialized(metadata["config"], "NODEID"); err != nil {
+			return fmt.Errorf("invalid 'config' metadata - %v", err)
+		}
+		return nil
+
+	case "ctrldata":
+		if err := checkDeepserialized(metadata["config"], "NODEID"); err != nil {
+			return fmt.Errorf("invalid 'config' metadata - %v", err)
+		}
+		return nil
+
+	case "nodelist":
+		if _, ok := metadata["nodelist"]; !ok {
+			return fmt.Errorf("missing 'nodelist' metadata")
+		}
+		for _, strnode := range strings.Split(strings.TrimSuffix(metadata["nodelist"], "-"), "-") {
+			if _, err := strconv.ParseInt(strnode, 0, 64); strnode != "" && err != nil {
+				return fmt.Errorf("invalid 'nodelist' metadata - '%v' is not a node ID", strnode)
+			}
+		}
+		return nil
+
+	case "topology":
+		_, err := ParseTopology(metadata["topology"], time.Now())
+		return err
+
+	default:
+		return fmt.Errorf("unknown log type '%v'", logtype)
+	}
+}
+
 // A struct that defines a log that is
 // generated within the orchestrator.
 type OrchLog struct {
@@ -68,16 +169,6 @@ type OrchLog struct {
 	Logmetadata map[string]string
 }
 
-// A struct that defines a log that is
-// sent out of the server for observation.
-// The Logmessage here is a fully stringified Log.
-// The Logsource and Logtype are used for filtering.
-type ObserverLog struct {
-	Logsource  string
-	Logtype    string
-	Logmessage string
-}
-
 // An interface that defines a common interface that
 // can be used by OrchLog and the ComplexLog proto
 type Log interface {
@@ -193,34 +284,117 @@ func NewOrchSchedlog(message string) *OrchLog {
 	return &orchlog
 }
 
-// A constructor function that generates and returns an OrchLog with
-// the 'obstoggle' type. The command passed is set as the Logmessage.
-func NewObsCommand(command string) *OrchLog {
+// A constructor function that generates and returns an OrchLog with the 'nodestate'
+// type for a change of the liveness state of a node. The node, its previous and new state,
+// the time it was last heard from and its missed pings are set in the Logmetadata map.
+func NewOrchNodestatelog(transition NodeTransition) *OrchLog {
 	// Construct a new OrchLog
 	orchlog := OrchLog{}
 	// Set the values of the OrchLog
-	orchlog.Logsource = "OBS"
-	orchlog.Logtype = "toggle"
+	orchlog.Logsource = "ORCH"
+	orchlog.Logtype = "nodestate"
 	orchlog.Logtime = CurrentISOtime()
-	orchlog.Logmessage = command
+	orchlog.Logmessage = fmt.Sprintf("node is %v", transition.State)
 	orchlog.Logmetadata = make(map[string]string)
+	// Set the values of the OrchLog Metadata
+	orchlog.Logmetadata["node"] = fmt.Sprint(transition.NodeID)
+	orchlog.Logmetadata["state"] = transition.State
+	orchlog.Logmetadata["previous"] = transition.Previous
+	orchlog.Logmetadata["lastseen"] = FormatISOtime(transition.LastSeen)
+	orchlog.Logmetadata["missed"] = fmt.Sprint(transition.MissedPings)
 	// Return the OrchLog
 	return &orchlog
 }
 
-// A constructore function that generates and returns an ObserverLog that is
-// built using an existing Log. The Logmessage is a stringified version of the
-// Log object being used. The Logsource and Logtype are taken from the Log struct.
-func NewObserverLog(log Log) *ObserverLog {
-	// Construct a new ObserverLog
-	obslog := ObserverLog{}
-	// Set the value of Logsource and Logtype
-	obslog.Logsource = log.GetLogsource()
-	obslog.Logtype = log.GetLogtype()
-	// Stringify and set the Logmessage
-	obslog.Logmessage = FormatLog(log)
-	// Return the ObserverLog
-	return &obslog
+// A set of constants that define the severity levels of logs in increasing order.
+const (
+	SeverityDebug = iota
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+)
+
+// A slice of the names of the severity levels indexed by their level.
+var severitynames = []string{"debug", "info", "warning", "error"}
+
+// A function that parses the name of a severity level and returns the level.
+// An empty name is parsed as the lowest severity level.
+func ParseSeverity(name string) (int, error) {
+	// Treat an empty name as the lowest level
+	if name == "" {
+		return SeverityDebug, nil
+	}
+
+	// Iterate over the severity names and find a match
+	for level, severityname := range severitynames {
+		if strings.ToLower(name) == severityname {
+			return level, nil
+		}
+	}
+
+	// Return an error for an unknown severity name
+	return SeverityDebug, fmt.Errorf("unknown severity '%v'. must be one of %v", name, severitynames)
+}
+
+// A function that returns the name of a severity level.
+func SeverityName(level int) string {
+	if level < SeverityDebug || level > SeverityError {
+		return "unknown"
+	}
+	return severitynames[level]
+}
+
+// A function that returns the severity level of a Log. An explicit 'severity' key in the
+// metadata of the Log takes precedence. Otherwise the severity is derived from its type,
+// its metadata and the '(failure)'/'(error)' tags that prefix the orchestrator log messages.
+func LogSeverity(log Log) int {
+	logmetadata := log.GetLogmetadata()
+
+	// Use the explicit severity of the log if it is set and valid
+	if name, ok := logmetadata["severity"]; ok {
+		if level, err := ParseSeverity(name); err == nil {
+			return level
+		}
+	}
+
+	// Check the logtype and derive the severity
+	switch log.GetLogtype() {
+	case "protolog":
+		// Protocol logs with a non-nil error are errors
+		if protoerror := logmetadata["error"]; protoerror != "" && protoerror != "nil" && protoerror != "<nil>" {
+			return SeverityError
+		}
+		return SeverityInfo
+
+	case "serverlog", "cloudlog", "schedlog":
+		// Orchestrator logs tagged as failures or errors are errors
+		logmessage := log.GetLogmessage()
+		if strings.HasPrefix(logmessage, "(failure)") || strings.HasPrefix(logmessage, "(error)") {
+			return SeverityError
+		}
+		return SeverityInfo
+
+	case "message":
+		// Messages that could not be parsed by the LINK are warnings
+		if logmetadata["type"] == "unknown" {
+			return SeverityWarning
+		}
+		return SeverityInfo
+
+	case "nodesync":
+		// Time adjustments of the nodes are routine mesh noise
+		return SeverityDebug
+
+	case "nodestate":
+		// Nodes that are no longer heard from are warnings
+		if logmetadata["state"] != NodeOnline {
+			return SeverityWarning
+		}
+		return SeverityInfo
+
+	default:
+		return SeverityInfo
+	}
 }
 
 // A function that simplifies and formats a Log into a string.
@@ -269,106 +443,119 @@ func FormatLog(log Log) string {
 	case "ctrldata":
 		strlog = fmt.Sprintf("%v || (data) %v | node - %v |", logprefix, logmessage, logmetadata["nodeID"])
 
-	case "nodelist":
+	case "nodelist", "topology":
 		strlog = fmt.Sprintf("%v || (data) %v", logprefix, logmessage)
+
+	case "nodestate":
+		strlog = fmt.Sprintf("%v || (liveness) %v | node - %v | previous - %v | lastseen - %v | missed - %v |", logprefix, logmessage, logmetadata["node"], logmetadata["previous"], logmetadata["lastseen"], logmetadata["missed"])
 	}
 	// Return the string log
 	return strlog
 }
 
-// A function that handles the output of the logs recieved
-// over a given logqueue. Currently only prints to stdout.
+// A function that handles the output of the logs recieved over a given logqueue. Prints the logs
+// to stdout and applies the logs of the mesh to the state of the orchestrator. The state is applied
+// in the order of the logs without starting a task per log. None of this work waits on the LogQueue:
+// its logs are published directly, the internal commands are sent by a background task and the
+// flushes of the mesh document are coalesced.
 func LogHandler(meshorchestrator *MeshOrchestrator) {
-	// Declare the observer toggle
-	observertoggle := false
 	// log the beginning of the loghandler
 	fmt.Println(FormatLog(NewOrchServerlog("(startup) log handler has started")))
 
 	// Iterate over the logqueue until it closes.
 	for log := range meshorchestrator.LogQueue {
-
 		// Check the source of the log
 		logtype := log.GetLogtype()
 		switch logtype {
-		case "serverlog", "protolog", "cloudlog", "schedlog", "message", "nodesync":
+		case "serverlog", "protolog", "cloudlog", "schedlog", "message", "nodesync", "nodestate":
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 
 		case "handshake", "meshsync":
+			// Record the node that handshaked being heard from and add it to the topology,
+			// or read the topology of the mesh again when the connections have changed
+			if logtype == "handshake" {
+				meshorchestrator.nodeSeen(log)
+				meshorchestrator.SetHandshake(log)
+			} else {
+				meshorchestrator.UpdateTopology()
+			}
 			// Call the method to update the meshorchestrator's NodeIDlist
-			go meshorchestrator.UpdateNodeIDlist()
+			meshorchestrator.UpdateNodeIDlist()
 
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 
 		case "sensordata":
+			// Record the node being heard from
+			meshorchestrator.nodeSeen(log)
+			// Trace the response to the span that wrote its ping
+			meshorchestrator.PingTraces.TraceResponse(log)
 			// Set the sensor node data to be added into the accumulation queue
-			go meshorchestrator.SetSensorData(log)
+			meshorchestrator.SetSensorData(log)
+			// Dispatch the log to the collector of its ping, if any
+			meshorchestrator.PingRegistry.Dispatch(log)
+			// Mark the command that requested the data as observed
+			meshorchestrator.CommandTracker.Observe(log)
 
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 
 		case "configdata":
+			// Record the node being heard from
+			meshorchestrator.nodeSeen(log)
+			// Trace the response to the span that wrote its ping
+			meshorchestrator.PingTraces.TraceResponse(log)
 			// Set the node configuration on the meshorchestrator's Nodelist
-			go meshorchestrator.SetNode(log)
+			meshorchestrator.SetNode(log)
+			// Dispatch the log to the collector of its ping, if any
+			meshorchestrator.PingRegistry.Dispatch(log)
+			// Mark the command that requested the data as observed
+			meshorchestrator.CommandTracker.Observe(log)
 
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 
 		case "ctrldata":
 			// Set the meshorchestrator's Controlnode
-			go meshorchestrator.SetControlnode(log)
+			meshorchestrator.SetControlnode(log)
+			// Mark the command that requested the data as observed
+			meshorchestrator.CommandTracker.Observe(log)
 
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
+
+		case "topology":
+			// Set the meshorchestrator's topology
+			meshorchestrator.SetTopology(log)
+			// Mark the command that requested the data as observed
+			meshorchestrator.CommandTracker.Observe(log)
+
+			// Stringify and print
+			fmt.Println(FormatLog(log))
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 
 		case "nodelist":
 			// Set the meshorchestrator's NodeIDlist
-			go meshorchestrator.SetNodeIDlist(log)
+			meshorchestrator.SetNodeIDlist(log)
+			// Mark the command that requested the data as observed
+			meshorchestrator.CommandTracker.Observe(log)
 
 			// Stringify and print
 			fmt.Println(FormatLog(log))
-			// Send into observer queue if toggle is set
-			if observertoggle {
-				meshorchestrator.ObserverQueue <- *NewObserverLog(log)
-			}
-
-		case "toggle":
-			// Check the toggle command
-			observertogglecommand := log.GetLogmessage()
-			switch observertogglecommand {
-			case "enable-observe":
-				// Enable the observerqueue
-				observertoggle = true
-				// Generate a server log
-				fmt.Println(FormatLog(NewOrchServerlog("(toggle) observer queue enabled")))
-
-			case "disable-observe":
-				// Disable the observerqueue
-				observertoggle = false
-				// Generate a server log
-				fmt.Println(FormatLog(NewOrchServerlog("(toggle) observer queue disabled")))
-			}
+			// Publish the log to the observers of the orchestrator
+			meshorchestrator.ObserverBroker.Publish(log)
 		}
 	}
 }
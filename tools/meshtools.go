@@ -28,9 +28,12 @@ FyrMesh gopkg tools
 package tools
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // A function that compares if two integer slices are equal regardless of order.
@@ -121,6 +124,28 @@ func (sensornode *SensorNode) GetConfigString() string {
 	return config
 }
 
+// A method of SensorNode that returns the sensor types that the node
+// reports in its sensor data, based on the sensors attached to it.
+func (sensornode *SensorNode) GetCapabilities() []string {
+	// Declare a new slice of strings
+	capabilities := make([]string, 0)
+
+	if sensornode.DHTtype > 0 {
+		// A DHT sensor reports both the humidity and the temperature
+		capabilities = append(capabilities, "HUM", "TEM")
+	}
+
+	if sensornode.FLMtype > 0 {
+		capabilities = append(capabilities, "FLM")
+	}
+
+	if sensornode.GAStype > 0 {
+		capabilities = append(capabilities, "GAS")
+	}
+
+	return capabilities
+}
+
 // A constructor function that generates and returns a SensorNode.
 // Only accepts a Log of type 'configdata'.
 func NewSensorNode(log Log) (*SensorNode, error) {
@@ -181,6 +206,9 @@ type ControlNode struct {
 
 	// The port which the mesh network nodes communicate
 	MeshPORT int `firestore:"MESHPORT"`
+
+	// The bool indicating if the firmware of the node reports the topology of the mesh
+	Topology bool `firestore:"topology"`
 }
 
 // A constructor function that generates and returns a ControlNode.
@@ -209,19 +237,21 @@ func NewControlNode(log Log) (*ControlNode, error) {
 	controlnode.MeshSSID = logconfig["MESH_SSID"]
 	controlnode.MeshPSWD = logconfig["MESH_PSWD"]
 	controlnode.MeshPORT, _ = strconv.Atoi(logconfig["MESH_PORT"])
+	// Parse the capabilities of the firmware, which are absent from firmware without them
+	controlnode.Topology, _ = strconv.ParseBool(logconfig["TOPOLOGY"])
 
 	// Return the pointer of the control node and a nil error
 	return &controlnode, nil
 }
 
-// A struct that defines a mesh orchestrator. It contains all the
-// core structures that are shared among its various sub-routines.
-type MeshOrchestrator struct {
+// A struct that defines a snapshot of the mutable state of a MeshOrchestrator.
+// The snapshot holds copies of the state and is safe to read without locking.
+type MeshState struct {
 	// A bool indicating if the connection state to the control node has been set
 	MeshConnected bool
 
-	// A string identifier of the controller that is running the orchestrator
-	ControllerID string
+	// A bool indicating if the scheduler is on or not.
+	SchedulerOn bool
 
 	// A ControlNode object that contains the configuration of the mesh control node
 	Controlnode ControlNode
@@ -232,40 +262,140 @@ type MeshOrchestrator struct {
 	// A slice of int that contains the list of all node IDs on the mesh
 	NodeIDlist []int64
 
-	// A map of string keys and MeshPing values. Maps the Pings to their respective pingIDs
-	Accumulation map[string]MeshPing
+	// A map of int keys and SensorPing values that contains the latest sensor data of each node.
+	// The Sensordata maps of the SensorPings are shared with the orchestrator and must not be modified.
+	Readings map[int64]SensorPing
+}
 
-	// A CloudInterface object for the mesh to connect to the database.
-	Cloudinterface CloudInterface
+// A method of MeshState that returns a simplified nodelist.
+// The simplified nodelist is mapping of the nodeIDs to their config strings.
+func (meshstate *MeshState) GetSimpleNodeList() map[int64]string {
+	// Create a null map
+	simplenodelist := make(map[int64]string)
 
-	// A MeshDocument object that represents the mesh.
-	MeshDoc MeshDocument
+	// Iterate over the nodelist and accumulate into the simple map
+	for nodeid, node := range meshstate.Nodelist {
+		simplenodelist[nodeid] = node.GetConfigString()
+	}
+
+	// Return the simple nodelist
+	return simplenodelist
+}
+
+// A struct that defines a mesh orchestrator. It contains all the
+// core structures that are shared among its various sub-routines.
+// The mutable state of the mesh is guarded by the statemutex and must
+// only be accessed with the getters, setters and the Snapshot method.
+type MeshOrchestrator struct {
+	// A string identifier of the controller that is running the orchestrator
+	ControllerID string
+
+	// A CloudSink object for the mesh to write to the database. Documents are buffered
+	// by the sink while the cloud credentials are unavailable.
+	Cloud *CloudSink
 
 	// A Simulator object that exists in the background of the orchestrator.
-	Simulator FireEventSimulator
+	Simulator *FireEventSimulator
 
-	// A bool indicating if the scheduler is on or not.
-	SchedulerOn bool
+	// A PingRegistry object that collects the responses of pings for their callers.
+	PingRegistry *PingRegistry
+
+	// An ObserverBroker object that publishes logs to the observers of the orchestrator.
+	ObserverBroker *ObserverBroker
+
+	// An ObserverBroker object that publishes the logs received from the LINK server to the recorders.
+	LinkTap *ObserverBroker
+
+	// A CommandRegistry object that declares the commands supported by the control node.
+	CommandRegistry *CommandRegistry
+
+	// A CommandTracker object that tracks the state of the commands sent to the control node.
+	CommandTracker *CommandTracker
+
+	// An Outbox object that holds the commands until they are written to the LINK. Nil if the outbox is disabled.
+	Outbox *Outbox
+
+	// A HealthRegistry object that tracks the health of the components of the orchestrator.
+	Health *HealthRegistry
+
+	// A LinkMonitor object that records the status of the read stream from the LINK server.
+	Link *LinkMonitor
+
+	// A LivenessTracker object that tracks whether the nodes on the mesh are still heard from.
+	Liveness *LivenessTracker
+
+	// A TopologyTracker object that tracks the connections between the nodes of the mesh.
+	Topology *TopologyTracker
+
+	// A NodeRegistry object that holds the names, labels, locations and zones of the nodes.
+	Registry *NodeRegistry
+
+	// A PingTraces object that links the responses of the mesh to the spans that wrote the pings.
+	PingTraces *PingTraces
+
+	// A Metrics object that exports the metrics of the orchestrator to Prometheus.
+	Metrics *Metrics
 
 	// A channel of Logs that is used by all components to communicate between each other and to the console
 	LogQueue chan Log
 
-	// A channel of ObserverLogs thats is streamed to observers of the orchestrator
-	ObserverQueue chan ObserverLog
-
 	// A channel of string maps that are used to send commands to the control node
 	CommandQueue chan map[string]string
 
 	// A channel of SensorPings that are used to accumulate MeshPings
 	AccumulatorQueue chan SensorPing
+
+	// A mutex that guards the state of the mesh
+	statemutex sync.RWMutex
+	// A bool indicating if the connection state to the control node has been set
+	meshconnected bool
+	// A bool indicating if the scheduler is on or not
+	scheduleron bool
+	// A ControlNode object that contains the configuration of the mesh control node
+	controlnode ControlNode
+	// A map of int keys and SensorNode values that contains the list of sensor nodes on the mesh
+	nodelist map[int64]SensorNode
+	// A slice of int that contains the list of all node IDs on the mesh
+	nodeidlist []int64
+	// A map of int keys and SensorPing values that contains the latest sensor data of each node
+	readings map[int64]SensorPing
+
+	// A map of string keys and MeshPing values. Maps the Pings to their respective pingIDs. Owned by
+	// the PingHandler and only accessed by FlushAccumulation once the PingHandler has returned.
+	accumulation map[string]MeshPing
+
+	// A mutex that guards the shutdown state of the background tasks
+	taskmutex sync.Mutex
+	// A mutex that guards the shutdown state of the CommandQueue and the AccumulatorQueue
+	queuemutex sync.RWMutex
+	// A WaitGroup that tracks the background tasks started by the orchestrator
+	tasks sync.WaitGroup
+	// A bool indicating if the orchestrator has stopped starting background tasks
+	tasksstopped bool
+	// A bool indicating if the CommandQueue has been closed
+	commandsclosed bool
+	// A bool indicating if the AccumulatorQueue has been closed
+	accumulatorclosed bool
+
+	// The QueueMonitors that apply the overflow policies of the LogQueue, CommandQueue and AccumulatorQueue
+	logmonitor, commandmonitor, accumulatormonitor *QueueMonitor
+
+	// A mutex that guards the internal commands and the flushes of the mesh document
+	internalmutex sync.Mutex
+	// The internal commands waiting to be sent to the CommandQueue, in the order they were issued
+	internalcommands []map[string]string
+	// A bool indicating if a background task is sending the internal commands
+	internalsending bool
+	// Bools indicating if a background task is flushing the mesh document and if it must flush it again
+	flushing, reflush bool
 }
 
 // A constructor function that generates and returns a MeshOrchestrator.
 // All the channels are made with the 'make' function.
-// The value of MeshConnected is false by default.
-// The value of the Controlnode is set to null ControlNode until it is updated.
+// The mesh is not connected and the scheduler is off by default.
+// The control node is set to null ControlNode until it is updated.
 // The value of the ControllerID is retrieved from the config file's DeviceID.
-// The value of the NodeList and NodeIDlist are set as empty slices.
+// The nodelist and the list of node IDs are set as empty.
 func NewMeshOrchestrator() (*MeshOrchestrator, error) {
 	// Create a null MeshOrchestrator
 	meshorchestrator := MeshOrchestrator{}
@@ -276,96 +406,391 @@ func NewMeshOrchestrator() (*MeshOrchestrator, error) {
 		return nil, fmt.Errorf("could not read config file - %v", err)
 	}
 
-	// Construct a new CloudInterface for the deviceID
-	cloudinterface, err := NewCloudInterface(meshconfig.DeviceID)
-	if err != nil {
-		return nil, fmt.Errorf("could not contruct cloud interface - %v", err)
-	}
-
 	// Set connection state and scheduler toggle to false by default
-	meshorchestrator.MeshConnected = false
-	meshorchestrator.SchedulerOn = false
+	meshorchestrator.meshconnected = false
+	meshorchestrator.scheduleron = false
 	// Set the ControllerID to the DeviceID from the config
 	meshorchestrator.ControllerID = meshconfig.DeviceID
 	// Set the control node of the mesh
-	meshorchestrator.Controlnode = ControlNode{}
-	// Set the cloud interface object to the newly generated interface
-	meshorchestrator.Cloudinterface = *cloudinterface
+	meshorchestrator.controlnode = ControlNode{}
+	// Set the cloud sink to a sink for the deviceID that is not yet connected
+	meshorchestrator.Cloud = NewCloudSink(meshconfig.DeviceID, DefaultCloudBufferSize)
 	// Set the simulator object to a fire event simulator
-	meshorchestrator.Simulator = *NewFireEventSimulator()
+	meshorchestrator.Simulator = NewFireEventSimulator()
+	// Set the ping registry to an empty registry
+	meshorchestrator.PingRegistry = NewPingRegistry()
+	// Set the observer broker to a broker with no subscriptions and the configured observer buffer size
+	observerconfig := meshconfig.GetQueueConfig(QueueObserver)
+	if observerconfig.Policy != QueueDropOldest.String() {
+		return nil, fmt.Errorf("invalid config for the %v queue - only the %v policy is supported", QueueObserver, QueueDropOldest)
+	}
+	meshorchestrator.ObserverBroker = NewObserverBroker(observerconfig.Capacity)
+	// Set the LINK tap to a broker with no recorders
+	meshorchestrator.LinkTap = NewObserverBroker(DefaultRecorderBufferSize)
+	// Set the command registry to the registry of control node commands
+	meshorchestrator.CommandRegistry = NewCommandRegistry()
+	// Set the command tracker to an empty tracker
+	meshorchestrator.CommandTracker = NewCommandTracker(DefaultCommandHistory)
+	// Set the outbox to the persisted outbox if it is enabled and track the commands that are pending in it
+	if meshconfig.Outbox.Enabled {
+		if meshorchestrator.Outbox, err = NewOutbox(meshconfig.Outbox); err != nil {
+			return nil, fmt.Errorf("could not open outbox - %v", err)
+		}
+		for _, entry := range meshorchestrator.Outbox.List() {
+			meshorchestrator.CommandTracker.Track(entry.CopyCommand())
+			var lasterr error
+			if entry.LastError != "" {
+				lasterr = fmt.Errorf("%v", entry.LastError)
+			}
+			meshorchestrator.CommandTracker.Update(entry.CommandID, CommandRetrying, lasterr)
+		}
+	}
+	// Set the health registry to an empty registry and connect the cloud sink. If the cloud
+	// credentials are unavailable, the orchestrator starts without the cloud and the
+	// CloudConnector connects the sink once they become available.
+	meshorchestrator.Health = NewHealthRegistry()
+	if _, err := meshorchestrator.Cloud.Connect(); err != nil {
+		meshorchestrator.Health.Set(ComponentCloud, false, fmt.Sprintf("cloud credentials unavailable - %v", err))
+	} else {
+		meshorchestrator.Health.Set(ComponentCloud, true, "firestore client created")
+	}
+	// Set the link monitor to a monitor that is connecting
+	meshorchestrator.Link = NewLinkMonitor()
+	// Set the liveness tracker to a tracker with no nodes that logs the changes of the liveness of the nodes
+	if meshorchestrator.Liveness, err = NewLivenessTracker(meshconfig.Liveness); err != nil {
+		return nil, fmt.Errorf("invalid config for the node liveness - %v", err)
+	}
+	meshorchestrator.Liveness.Watch(func(transition NodeTransition) {
+		meshorchestrator.emitLog(NewOrchNodestatelog(transition))
+	})
+	// Set the topology tracker to an empty topology
+	meshorchestrator.Topology = NewTopologyTracker(DefaultTopologyHistory)
+	// Set the node registry to the persisted registry
+	if meshorchestrator.Registry, err = NewNodeRegistry(); err != nil {
+		return nil, fmt.Errorf("could not open node registry - %v", err)
+	}
+	// Set the ping traces to an empty registry
+	meshorchestrator.PingTraces = NewPingTraces(DefaultPingTraceTTL)
 
 	// Set the list of node IDs on the mesh to an emtpy slice of int
-	meshorchestrator.NodeIDlist = make([]int64, 0)
+	meshorchestrator.nodeidlist = make([]int64, 0)
 	// Set the list of nodes on the mesh to an empty map of int -> SensorNode
-	meshorchestrator.Nodelist = make(map[int64]SensorNode)
+	meshorchestrator.nodelist = make(map[int64]SensorNode)
+	// Set the latest sensor data of the nodes to an empty map of int -> SensorPing
+	meshorchestrator.readings = make(map[int64]SensorPing)
 	// Set the list of accumulating pings to an empty map of string -> MeshPing
-	meshorchestrator.Accumulation = make(map[string]MeshPing)
+	meshorchestrator.accumulation = make(map[string]MeshPing)
 
 	// Create a log channel that will be used to pass all logs within the server.
-	meshorchestrator.LogQueue = make(chan Log)
-	// Create an observer channel that will be used to pass observation logs.
-	meshorchestrator.ObserverQueue = make(chan ObserverLog)
+	logconfig := meshconfig.GetQueueConfig(QueueLog)
+	meshorchestrator.LogQueue = make(chan Log, logconfig.Capacity)
 	// Create a command queue that will be passed into the Orchestrator to siphon commands to the LINK.
-	meshorchestrator.CommandQueue = make(chan map[string]string)
+	commandconfig := meshconfig.GetQueueConfig(QueueCommand)
+	meshorchestrator.CommandQueue = make(chan map[string]string, commandconfig.Capacity)
 	// Create an accumulator queue that will be passed into the PingHandler to collect pings.
-	meshorchestrator.AccumulatorQueue = make(chan SensorPing)
+	accumulatorconfig := meshconfig.GetQueueConfig(QueueAccumulator)
+	if accumulatorconfig.Policy == QueueBlock.String() {
+		return nil, fmt.Errorf("invalid config for the %v queue - the %v policy is not supported, since the queue is fed by the log handler", QueueAccumulator, QueueBlock)
+	}
+	meshorchestrator.AccumulatorQueue = make(chan SensorPing, accumulatorconfig.Capacity)
+
+	// Create the monitors that apply the overflow policies of the queues
+	if meshorchestrator.logmonitor, err = NewQueueMonitor(QueueLog, logconfig, func() int { return len(meshorchestrator.LogQueue) }); err != nil {
+		return nil, err
+	}
+	if meshorchestrator.commandmonitor, err = NewQueueMonitor(QueueCommand, commandconfig, func() int { return len(meshorchestrator.CommandQueue) }); err != nil {
+		return nil, err
+	}
+	if meshorchestrator.accumulatormonitor, err = NewQueueMonitor(QueueAccumulator, accumulatorconfig, func() int { return len(meshorchestrator.AccumulatorQueue) }); err != nil {
+		return nil, err
+	}
 
-	// Create and assign the current state of the MeshOrchestrator to the MeshDoc
-	meshorchestrator.MeshDoc = *NewMeshDocument(&meshorchestrator)
+	// Create the metrics of the orchestrator
+	meshorchestrator.Metrics = NewMetrics(&meshorchestrator)
 
 	// Return the meshorchestrator and nill error
 	return &meshorchestrator, nil
 }
 
 // A method of MeshOrchestrator that closes all the channels and clients within it.
+// The CommandQueue and AccumulatorQueue are closed if they have not been closed yet.
+// Must only be called once every background task that sends logs has returned.
 func (meshorchestrator *MeshOrchestrator) Close() {
-	// Close the CloudInterface client
-	meshorchestrator.Cloudinterface.FirestoreClient.Close()
-
-	// Close all the channels within the MeshOrchestrator
-	close(meshorchestrator.AccumulatorQueue)
-	close(meshorchestrator.ObserverQueue)
-	close(meshorchestrator.CommandQueue)
+	// Close the queues that have not been closed during the shutdown
+	meshorchestrator.CloseCommandQueue()
+	meshorchestrator.CloseAccumulatorQueue()
 	close(meshorchestrator.LogQueue)
+
+	// Close the CloudSink client
+	meshorchestrator.Cloud.Close()
+}
+
+// A method of MeshOrchestrator that runs a task in the background and tracks it until it returns.
+// The task is dropped if the orchestrator has been stopped from starting background tasks.
+// Returns whether the task was started.
+func (meshorchestrator *MeshOrchestrator) Go(task func()) bool {
+	meshorchestrator.taskmutex.Lock()
+	defer meshorchestrator.taskmutex.Unlock()
+
+	// Drop the task if the background tasks have been stopped
+	if meshorchestrator.tasksstopped {
+		return false
+	}
+
+	// Track and start the task
+	meshorchestrator.tasks.Add(1)
+	go func() {
+		defer meshorchestrator.tasks.Done()
+		task()
+	}()
+	return true
+}
+
+// A method of MeshOrchestrator that stops the orchestrator from starting background
+// tasks and waits for the tasks that have already been started to return.
+func (meshorchestrator *MeshOrchestrator) StopTasks() {
+	meshorchestrator.taskmutex.Lock()
+	meshorchestrator.tasksstopped = true
+	meshorchestrator.taskmutex.Unlock()
+
+	// Wait for the running tasks
+	meshorchestrator.tasks.Wait()
+}
+
+// A method of MeshOrchestrator that closes the CommandQueue. The CommandHandler writes
+// the commands remaining on the queue before it returns and any command sent after
+// the queue has been closed is marked as failed. Does nothing if it is already closed.
+func (meshorchestrator *MeshOrchestrator) CloseCommandQueue() {
+	meshorchestrator.queuemutex.Lock()
+	defer meshorchestrator.queuemutex.Unlock()
+
+	if !meshorchestrator.commandsclosed {
+		meshorchestrator.commandsclosed = true
+		close(meshorchestrator.CommandQueue)
+	}
+}
+
+// A method of MeshOrchestrator that closes the AccumulatorQueue. Any SensorPing
+// accumulated after the queue has been closed is dropped. Does nothing if it is already closed.
+func (meshorchestrator *MeshOrchestrator) CloseAccumulatorQueue() {
+	meshorchestrator.queuemutex.Lock()
+	defer meshorchestrator.queuemutex.Unlock()
+
+	if !meshorchestrator.accumulatorclosed {
+		meshorchestrator.accumulatorclosed = true
+		close(meshorchestrator.AccumulatorQueue)
+	}
+}
+
+// A method of MeshOrchestrator that flushes every MeshPing left on the accumulation to the cloud,
+// regardless of whether it is complete. Must only be called once the PingHandler has returned.
+// Returns the number of MeshPings that were flushed.
+func (meshorchestrator *MeshOrchestrator) FlushAccumulation() int {
+	// Collect the pending meshpings, since flushing them deletes them from the accumulation
+	pending := make([]MeshPing, 0, len(meshorchestrator.accumulation))
+	for _, meshping := range meshorchestrator.accumulation {
+		pending = append(pending, meshping)
+	}
+
+	// Flush each pending meshping
+	for index := range pending {
+		pending[index].Flush(meshorchestrator)
+	}
+
+	return len(pending)
+}
+
+// A method of MeshOrchestrator that tracks a command with the CommandTracker and sends it to the
+// CommandQueue according to its policy. The command map is tagged with its command ID, which is
+// returned. If the CommandQueue has been closed or the command is rejected by a full queue, the
+// command is marked as failed and an ErrQueueClosed or ErrQueueFull is returned with its ID.
+// A command dropped from a full queue by its policy is also marked as failed. The trace
+// context of the span in the context is set on the command map with the TraceKey.
+func (meshorchestrator *MeshOrchestrator) SendCommand(ctx context.Context, command map[string]string) (string, error) {
+	// Set the trace context of the caller on the command
+	InjectTrace(ctx, command)
+
+	// Track the command in the queued state
+	cmdid := meshorchestrator.CommandTracker.Track(command)
+
+	meshorchestrator.queuemutex.RLock()
+	defer meshorchestrator.queuemutex.RUnlock()
+
+	// Fail the command if the CommandQueue has been closed
+	if meshorchestrator.commandsclosed {
+		meshorchestrator.CommandTracker.Update(cmdid, CommandFailed, fmt.Errorf("orchestrator is shutting down"))
+		return cmdid, ErrQueueClosed
+	}
+
+	// Send the command to the CommandQueue and fail it if it was rejected or dropped
+	sent, err := meshorchestrator.offerCommand(command)
+	if err != nil {
+		meshorchestrator.CommandTracker.Update(cmdid, CommandFailed, fmt.Errorf("rejected by the command queue - %v", err))
+		return cmdid, err
+	}
+	if !sent {
+		meshorchestrator.CommandTracker.Update(cmdid, CommandFailed, fmt.Errorf("dropped from the full command queue"))
+	}
+
+	// Return the command ID
+	return cmdid, nil
+}
+
+// A method of MeshOrchestrator that sends a command issued by the orchestrator itself to the
+// CommandQueue. The command is handed to a single background task that sends the internal commands
+// in order and waits for room on a full queue instead of applying its policy, so that the internal
+// commands are never rejected or dropped and the caller never waits. The command is skipped if the
+// same command is already waiting to be sent. Safe to call from the LogHandler.
+func (meshorchestrator *MeshOrchestrator) SendInternalCommand(command map[string]string) {
+	meshorchestrator.internalmutex.Lock()
+	defer meshorchestrator.internalmutex.Unlock()
+
+	// Skip the command if the same command is already waiting to be sent
+	for _, pending := range meshorchestrator.internalcommands {
+		if pending["command"] == command["command"] && pending["node"] == command["node"] {
+			return
+		}
+	}
+	meshorchestrator.internalcommands = append(meshorchestrator.internalcommands, command)
+
+	// Start the task that sends the internal commands, unless it is already running
+	if meshorchestrator.internalsending {
+		return
+	}
+	meshorchestrator.internalsending = meshorchestrator.Go(meshorchestrator.sendInternalCommands)
+	if meshorchestrator.internalsending {
+		return
+	}
+
+	// Fail the waiting commands if the background tasks have been stopped
+	for _, pending := range meshorchestrator.internalcommands {
+		cmdid := meshorchestrator.CommandTracker.Track(pending)
+		meshorchestrator.CommandTracker.Update(cmdid, CommandFailed, fmt.Errorf("orchestrator is shutting down"))
+		meshorchestrator.emitLog(NewOrchSchedlog(fmt.Sprintf("(failure) command could not be queued | command - %v | cmdid - %v | error - %v", pending["command"], cmdid, ErrQueueClosed)))
+	}
+	meshorchestrator.internalcommands = nil
+}
+
+// A method of MeshOrchestrator that sends the internal commands waiting to be sent until none remain.
+// Since there is no caller to return an error to, a failure to queue a command is logged.
+func (meshorchestrator *MeshOrchestrator) sendInternalCommands() {
+	for {
+		// Take the oldest waiting command or stop if none remain
+		meshorchestrator.internalmutex.Lock()
+		if len(meshorchestrator.internalcommands) == 0 {
+			meshorchestrator.internalsending = false
+			meshorchestrator.internalmutex.Unlock()
+			return
+		}
+		command := meshorchestrator.internalcommands[0]
+		meshorchestrator.internalcommands = meshorchestrator.internalcommands[1:]
+		meshorchestrator.internalmutex.Unlock()
+
+		if cmdid, err := meshorchestrator.sendInternalCommand(command); err != nil {
+			meshorchestrator.SendLog(NewOrchSchedlog(fmt.Sprintf("(failure) command could not be queued | command - %v | cmdid - %v | error - %v", command["command"], cmdid, err)))
+		}
+	}
+}
+
+// A method of MeshOrchestrator that tracks an internal command with the CommandTracker and sends it
+// to the CommandQueue, waiting for room on the queue if it is full. If the CommandQueue has been
+// closed, the command is marked as failed and an ErrQueueClosed is returned with its ID.
+func (meshorchestrator *MeshOrchestrator) sendInternalCommand(command map[string]string) (string, error) {
+	// Track the command in the queued state
+	cmdid := meshorchestrator.CommandTracker.Track(command)
+
+	meshorchestrator.queuemutex.RLock()
+	defer meshorchestrator.queuemutex.RUnlock()
+
+	// Fail the command if the CommandQueue has been closed
+	if meshorchestrator.commandsclosed {
+		meshorchestrator.CommandTracker.Update(cmdid, CommandFailed, fmt.Errorf("orchestrator is shutting down"))
+		return cmdid, ErrQueueClosed
+	}
+
+	// Send the command to the CommandQueue
+	meshorchestrator.offerInternalCommand(command)
+	return cmdid, nil
 }
 
 // A method of MeshOrchestrator that sends commands to the commandqueue
-// that will trigger events that configure the Controlnode, the NodeList
-// and the NodeListID fields
+// that will trigger events that configure the control node, the nodelist
+// and the list of node IDs. Called every time the read stream from the
+// LINK server is opened, so that the fields are refreshed after an outage.
+// The topology of the mesh is read once the control node config shows that it is supported.
 func (meshorchestrator *MeshOrchestrator) Initialize() {
-	// log the beginning of the pinghandler
-	fmt.Println(FormatLog(NewOrchServerlog("(startup) mesh orchestrator has started")))
-
 	// Send the command to read the control node config to the CommandQueue
 	command := map[string]string{"command": "readconfig-control"}
-	meshorchestrator.CommandQueue <- command
+	meshorchestrator.SendInternalCommand(command)
 
 	// Send the command to read the mesh node list to the CommandQueue
 	command = map[string]string{"command": "readnodelist-control"}
-	meshorchestrator.CommandQueue <- command
+	meshorchestrator.SendInternalCommand(command)
 }
 
-// A method of MeshOrchestrator that flushes the MeshDoc field to the cloud.
+// A method of MeshOrchestrator that flushes the MeshDocument to the cloud in the background. At most
+// one flush runs at a time and the flushes requested while it runs are coalesced into one more flush
+// once it returns, so that a burst of changes to the state of the mesh does not pile up flushes.
+func (meshorchestrator *MeshOrchestrator) requestFlush() {
+	meshorchestrator.internalmutex.Lock()
+	defer meshorchestrator.internalmutex.Unlock()
+
+	// Flush again once the running flush returns
+	if meshorchestrator.flushing {
+		meshorchestrator.reflush = true
+		return
+	}
+
+	meshorchestrator.flushing = meshorchestrator.Go(func() {
+		for {
+			meshorchestrator.Flush()
+
+			// Stop unless another flush was requested while flushing
+			meshorchestrator.internalmutex.Lock()
+			if !meshorchestrator.reflush {
+				meshorchestrator.flushing = false
+				meshorchestrator.internalmutex.Unlock()
+				return
+			}
+			meshorchestrator.reflush = false
+			meshorchestrator.internalmutex.Unlock()
+		}
+	})
+}
+
+// A method of MeshOrchestrator that flushes a MeshDocument
+// with the current state of the mesh to the cloud.
 func (meshorchestrator *MeshOrchestrator) Flush() {
-	// Update the MeshDoc with the current state of the MeshOrchestrator
-	meshorchestrator.MeshDoc = *NewMeshDocument(meshorchestrator)
+	// Create a MeshDocument with the current state of the MeshOrchestrator
+	meshdoc := NewMeshDocument(meshorchestrator)
 
 	// Push the meshdoc to the cloud and check the status
-	err := meshorchestrator.MeshDoc.Push(&meshorchestrator.Cloudinterface)
+	buffered, err := meshorchestrator.Cloud.PushMesh(meshdoc)
+	meshorchestrator.Metrics.CloudPushed(CloudPushMesh, buffered, err)
+	if buffered {
+		// Log the meshdoc being buffered until the cloud is available.
+		meshorchestrator.SendLog(NewOrchCloudlog(fmt.Sprintf("(buffered) mesh document buffered until the cloud is available | doc - %v", meshdoc.ControllerID)))
+		return
+	}
 	if err != nil {
 		// Log the meshdoc failing to be flushed to the cloud.
-		logmessage := NewOrchCloudlog(fmt.Sprintf("(failure) mesh document flush failed | doc - %v", meshorchestrator.MeshDoc.ControllerID))
-		meshorchestrator.LogQueue <- logmessage
+		logmessage := NewOrchCloudlog(fmt.Sprintf("(failure) mesh document flush failed | doc - %v", meshdoc.ControllerID))
+		meshorchestrator.SendLog(logmessage)
+		// Mark the cloud interface as unhealthy
+		meshorchestrator.Health.Set(ComponentCloud, false, fmt.Sprintf("mesh document flush failed - %v", err))
+		return
 	}
 
 	// Log the meshdoc succesfully being flushed to the cloud.
-	logmessage := NewOrchCloudlog(fmt.Sprintf("(success) mesh document flush successful | doc - %v", meshorchestrator.MeshDoc.ControllerID))
-	meshorchestrator.LogQueue <- logmessage
+	logmessage := NewOrchCloudlog(fmt.Sprintf("(success) mesh document flush successful | doc - %v", meshdoc.ControllerID))
+	meshorchestrator.SendLog(logmessage)
+	// Mark the cloud interface as healthy
+	meshorchestrator.Health.Set(ComponentCloud, true, "mesh document flush successful")
 }
 
 // A method of MeshOrchestrator that accepts a Log of type 'nodelist' and parses the nodelist sequence
-// on it into a slice of integer nodeIDs and assigns it to the NodeIDlist field. Finally calls the
-// method to update the NodeList based on the new NodeIDlist.
+// on it into a slice of integer nodeIDs and assigns it as the list of node IDs. Finally calls the
+// method to update the nodelist based on the new list of node IDs.
 func (meshorchestrator *MeshOrchestrator) SetNodeIDlist(log Log) error {
 	// Retrieve the type of the Log
 	logtype := log.GetLogtype()
@@ -385,23 +810,33 @@ func (meshorchestrator *MeshOrchestrator) SetNodeIDlist(log Log) error {
 
 	// Declare nodelist of type slice of int
 	var nodelist []int64
-	// Iterate over the string nodelist slice
+	// Iterate over the string nodelist slice, skipping the empty sequence of a mesh without nodes
 	for _, strnode := range strnodelist {
+		if strnode == "" {
+			continue
+		}
 		// Convert the string to an int and append it to the int nodelist
 		node, _ := strconv.ParseInt(strnode, 0, 64)
 		nodelist = append(nodelist, node)
 	}
 
-	// Assign the new NodeIDlist
-	meshorchestrator.NodeIDlist = nodelist
+	// Assign the new list of node IDs and set the nodes of the metrics
+	meshorchestrator.statemutex.Lock()
+	meshorchestrator.nodeidlist = nodelist
+	meshorchestrator.statemutex.Unlock()
+	meshorchestrator.Metrics.SetNodes(nodelist)
+	// Track the liveness of the nodes on the list
+	meshorchestrator.Liveness.Track(nodelist, time.Now())
+	// Set the topology of the mesh from the list until the topology is read from the mesh
+	meshorchestrator.updateTopology(NewNodelistTopology(meshorchestrator.GetControlnode().NodeID, nodelist, time.Now()))
 	// Call the method to update the NodeList based on the new NodeIDlist
-	go meshorchestrator.UpdateNodelist()
+	meshorchestrator.UpdateNodelist()
 	// Call the method to update the MeshDocument and flush it
-	go meshorchestrator.Flush()
+	meshorchestrator.requestFlush()
 	return nil
 }
 
-// A method of MeshOrchestrator that sets the value of the Controlnode field.
+// A method of MeshOrchestrator that sets the control node of the mesh.
 // Accepts a log of type 'ctrldata' and constructs a ControlNode from the log.
 func (meshorchestrator *MeshOrchestrator) SetControlnode(log Log) error {
 	// Retrieve the type of the Log
@@ -418,15 +853,19 @@ func (meshorchestrator *MeshOrchestrator) SetControlnode(log Log) error {
 	}
 
 	// Assign the controlnode to the meshorchestrator
-	meshorchestrator.Controlnode = *controlnode
+	meshorchestrator.statemutex.Lock()
+	meshorchestrator.controlnode = *controlnode
+	meshorchestrator.statemutex.Unlock()
+	// Call the method to read the topology of the mesh if the control node reports it
+	meshorchestrator.UpdateTopology()
 	// Call the method to update the MeshDocument and flush it
-	go meshorchestrator.Flush()
+	meshorchestrator.requestFlush()
 	return nil
 }
 
-// A method of MeshOrchestrator that adds/updates the Node on the Nodelist map.
+// A method of MeshOrchestrator that adds/updates the Node on the nodelist map.
 // Accepts a log og type 'configdata' and constructs a SensorNode from the log.
-// The SensorNode is then added to the Nodelist with the NodeID being the key.
+// The SensorNode is then added to the nodelist with the NodeID being the key.
 func (meshorchestrator *MeshOrchestrator) SetNode(log Log) error {
 	// Retrieve the type of the Log
 	logtype := log.GetLogtype()
@@ -441,10 +880,12 @@ func (meshorchestrator *MeshOrchestrator) SetNode(log Log) error {
 		return fmt.Errorf("sensor node config could not be constructed - %v", err)
 	}
 
-	// Assign the sensornode to the meshorchestrator's Nodelist
-	meshorchestrator.Nodelist[sensornode.NodeID] = *sensornode
+	// Assign the sensornode to the meshorchestrator's nodelist
+	meshorchestrator.statemutex.Lock()
+	meshorchestrator.nodelist[sensornode.NodeID] = *sensornode
+	meshorchestrator.statemutex.Unlock()
 	// Call the method to update the MeshDocument and flush it
-	go meshorchestrator.Flush()
+	meshorchestrator.requestFlush()
 	return nil
 }
 
@@ -464,6 +905,14 @@ func (meshorchestrator *MeshOrchestrator) SetSensorData(log Log) error {
 		return fmt.Errorf("sensor ping could not be constructed - %v", err)
 	}
 
+	// Set the fire probability of the node on the metrics
+	nodeid, _ := strconv.ParseInt(log.GetLogmetadata()["node"], 0, 64)
+	meshorchestrator.Metrics.SetFireProbability(nodeid, sensorping.Fireprobability)
+	// Set the sensor ping as the latest sensor data of the node
+	meshorchestrator.statemutex.Lock()
+	meshorchestrator.readings[nodeid] = *sensorping
+	meshorchestrator.statemutex.Unlock()
+
 	// userpings are never accumulated
 	userping := strings.HasPrefix(sensorping.PingID, "userping")
 	// only mesh wide pings can be accumulated
@@ -471,7 +920,14 @@ func (meshorchestrator *MeshOrchestrator) SetSensorData(log Log) error {
 
 	// Check if sensor ping is not a user ping and is a mesh ping
 	if !userping && meshping {
-		meshorchestrator.AccumulatorQueue <- *sensorping
+		meshorchestrator.queuemutex.RLock()
+		defer meshorchestrator.queuemutex.RUnlock()
+
+		// Drop the sensor ping if the AccumulatorQueue has been closed
+		if meshorchestrator.accumulatorclosed {
+			return ErrQueueClosed
+		}
+		return meshorchestrator.offerSensorPing(*sensorping)
 	}
 
 	return nil
@@ -482,19 +938,21 @@ func (meshorchestrator *MeshOrchestrator) SetSensorData(log Log) error {
 func (meshorchestrator *MeshOrchestrator) UpdateNodeIDlist() {
 	// Send the command to read a copy of the current mesh node list to the CommandQueue
 	command := map[string]string{"command": "readnodelist-control"}
-	meshorchestrator.CommandQueue <- command
+	meshorchestrator.SendInternalCommand(command)
 }
 
-// A method of MeshhOrchestrator that sets updates the NodeList field.
-// Compares the NodeIDlist field with a slice of NodeID integers collected
-// from the NodeList. If they are equal, no updation is performed, otherwise
+// A method of MeshhOrchestrator that sets updates the nodelist.
+// Compares the list of node IDs with a slice of NodeID integers collected
+// from the nodelist. If they are equal, no updation is performed, otherwise
 // a command is sent to ping the entire mesh for configdata, each of which
-// will accumulate into the NodeList map.
+// will accumulate into the nodelist map.
 func (meshorchestrator *MeshOrchestrator) UpdateNodelist() {
-	// Retrieve the current Nodelist
-	oldNodelist := meshorchestrator.Nodelist
-	// Retrieve the current(updated) NodeIDlist
-	newNodeIDlist := meshorchestrator.NodeIDlist
+	// Retrieve a snapshot of the current state
+	meshstate := meshorchestrator.Snapshot()
+	// Retrieve the current nodelist
+	oldNodelist := meshstate.Nodelist
+	// Retrieve the current(updated) list of node IDs
+	newNodeIDlist := meshstate.NodeIDlist
 
 	// Declare a slice of int
 	var oldNodeIDlist []int64
@@ -509,22 +967,107 @@ func (meshorchestrator *MeshOrchestrator) UpdateNodelist() {
 	if !result {
 		// If they are not equal, send the command to ping the mesh for config data to the CommandQueue
 		command := map[string]string{"command": "readconfig-mesh", "ping": fmt.Sprintf("controlping-nodelistupdater-%v-mesh", CurrentISOtime())}
-		meshorchestrator.CommandQueue <- command
+		meshorchestrator.SendInternalCommand(command)
 	}
 }
 
 // A method of MeshOrchestrator that returns a simplified nodelist.
 // The simplified nodelist is mapping of the nodeIDs to their config strings.
 func (meshorchestrator *MeshOrchestrator) GetSimpleNodeList() map[int64]string {
-	// Create a null map and retrieve the NodeList from the meshorchestrator
-	simplenodelist := make(map[int64]string)
-	nodelist := meshorchestrator.Nodelist
+	meshstate := meshorchestrator.Snapshot()
+	return meshstate.GetSimpleNodeList()
+}
 
-	// Iterate over the nodelist and accumulate into the simple map
-	for nodeid, node := range nodelist {
-		simplenodelist[nodeid] = node.GetConfigString()
+// A method of MeshOrchestrator that returns a MeshState with copies of the current state of the mesh.
+// The snapshot is consistent and is not affected by any later change to the state of the orchestrator.
+func (meshorchestrator *MeshOrchestrator) Snapshot() MeshState {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+
+	// Copy the nodelist and the list of node IDs
+	nodelist := make(map[int64]SensorNode, len(meshorchestrator.nodelist))
+	for nodeid, node := range meshorchestrator.nodelist {
+		nodelist[nodeid] = node
+	}
+	nodeidlist := make([]int64, len(meshorchestrator.nodeidlist))
+	copy(nodeidlist, meshorchestrator.nodeidlist)
+	// Copy the latest sensor data of the nodes
+	readings := make(map[int64]SensorPing, len(meshorchestrator.readings))
+	for nodeid, reading := range meshorchestrator.readings {
+		readings[nodeid] = reading
 	}
 
-	// Return the simple nodelist
-	return simplenodelist
+	return MeshState{
+		MeshConnected: meshorchestrator.meshconnected,
+		SchedulerOn:   meshorchestrator.scheduleron,
+		Controlnode:   meshorchestrator.controlnode,
+		Nodelist:      nodelist,
+		NodeIDlist:    nodeidlist,
+		Readings:      readings,
+	}
+}
+
+// A method of MeshOrchestrator that returns if the connection state to the control node has been set.
+func (meshorchestrator *MeshOrchestrator) GetMeshConnected() bool {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+	return meshorchestrator.meshconnected
+}
+
+// A method of MeshOrchestrator that sets the connection state to the control node.
+func (meshorchestrator *MeshOrchestrator) SetMeshConnected(connected bool) {
+	meshorchestrator.statemutex.Lock()
+	defer meshorchestrator.statemutex.Unlock()
+	meshorchestrator.meshconnected = connected
+}
+
+// A method of MeshOrchestrator that returns if the scheduler is on.
+func (meshorchestrator *MeshOrchestrator) GetSchedulerOn() bool {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+	return meshorchestrator.scheduleron
+}
+
+// A method of MeshOrchestrator that sets the scheduler on or off.
+func (meshorchestrator *MeshOrchestrator) SetSchedulerOn(enabled bool) {
+	meshorchestrator.statemutex.Lock()
+	defer meshorchestrator.statemutex.Unlock()
+	meshorchestrator.scheduleron = enabled
+}
+
+// A method of MeshOrchestrator that returns the control node of the mesh.
+func (meshorchestrator *MeshOrchestrator) GetControlnode() ControlNode {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+	return meshorchestrator.controlnode
+}
+
+// A method of MeshOrchestrator that returns a copy of the list of node IDs on the mesh.
+func (meshorchestrator *MeshOrchestrator) GetNodeIDlist() []int64 {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+
+	nodeidlist := make([]int64, len(meshorchestrator.nodeidlist))
+	copy(nodeidlist, meshorchestrator.nodeidlist)
+	return nodeidlist
+}
+
+// A method of MeshOrchestrator that returns the SensorNode for a given node ID
+// from the nodelist and a bool indicating if the node is on the nodelist.
+func (meshorchestrator *MeshOrchestrator) GetNode(nodeid int64) (SensorNode, bool) {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+
+	node, ok := meshorchestrator.nodelist[nodeid]
+	return node, ok
+}
+
+// A method of MeshOrchestrator that returns the latest sensor data of a node
+// and a bool indicating if any sensor data has been received from the node.
+func (meshorchestrator *MeshOrchestrator) GetReading(nodeid int64) (SensorPing, bool) {
+	meshorchestrator.statemutex.RLock()
+	defer meshorchestrator.statemutex.RUnlock()
+
+	reading, ok := meshorchestrator.readings[nodeid]
+	return reading, ok
 }
@@ -0,0 +1,245 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	orch "github.com/fyrwatch/fyrmesh/fyrorch/orch"
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that returns a MeshOrchestrator with the default config in a temporary config directory.
+func newTestOrchestrator(t *testing.T) *tools.MeshOrchestrator {
+	return newTestOrchestratorWithConfig(t, "{}")
+}
+
+// A function that returns a MeshOrchestrator with a config file in a temporary config directory.
+func newTestOrchestratorWithConfig(t *testing.T, config string) *tools.MeshOrchestrator {
+	configdir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(configdir, "config.json"), []byte(config), 0600); err != nil {
+		t.Fatalf("could not write config file - %v", err)
+	}
+
+	// Point the config to the temporary directory for the duration of the test
+	setTestConfigDir(t, configdir)
+
+	meshorchestrator, err := tools.NewMeshOrchestrator()
+	if err != nil {
+		t.Fatalf("could not create mesh orchestrator - %v", err)
+	}
+	return meshorchestrator
+}
+
+// A function that points the config to a directory for the duration of a test.
+func setTestConfigDir(t *testing.T, configdir string) {
+	previous, isset := os.LookupEnv("FYRMESHCONFIG")
+	os.Setenv("FYRMESHCONFIG", configdir)
+	t.Cleanup(func() {
+		if isset {
+			os.Setenv("FYRMESHCONFIG", previous)
+		} else {
+			os.Unsetenv("FYRMESHCONFIG")
+		}
+	})
+}
+
+// A function that returns a log from the mesh with a log type and metadata.
+func newMeshLog(logtype string, metadata map[string]string) tools.Log {
+	return &tools.OrchLog{Logsource: "MESH", Logtype: logtype, Logtime: tools.CurrentISOtime(), Logmessage: logtype, Logmetadata: metadata}
+}
+
+// A test that writes to the state of the mesh from the paths that the LogHandler drives while the state
+// is read by Snapshot and NewMeshOrchStatus. Run with 'go test -race' to check the guards of the state.
+func TestMeshStateConcurrentAccess(t *testing.T) {
+	meshorchestrator := newTestOrchestrator(t)
+
+	// Run the LogHandler, which records the nodes that handshake as seen, and discard the commands
+	loghandler := make(chan struct{})
+	go func() {
+		tools.LogHandler(meshorchestrator)
+		close(loghandler)
+	}()
+	go func() {
+		for range meshorchestrator.CommandQueue {
+		}
+	}()
+	go func() {
+		for range meshorchestrator.AccumulatorQueue {
+		}
+	}()
+
+	nodelistlog := newMeshLog("nodelist", map[string]string{"nodelist": "1-2-3-"})
+	configlog := func(node string) tools.Log {
+		config := "NODEID-" + node + "=SERIALBAUD-115200=PINGER-false=PINGERPIN-0=CONNECTLEDPIN-2=DHTTYP-11=DHTPIN-4=FLMTYP-1=FLMPIN-5=GASTYP-2=GASPIN-6"
+		return newMeshLog("configdata", map[string]string{"ping": "userping-test-node", "node": node, "config": config})
+	}
+	sensorlog := func(node string) tools.Log {
+		sensors := "HUM-40.0=TEM-25.0=FLM-0=GAS-100"
+		return newMeshLog("sensordata", map[string]string{"ping": "controlping-test-mesh", "node": node, "sensors": sensors})
+	}
+
+	const iterations = 50
+	nodes := []string{"1", "2", "3"}
+	var workers sync.WaitGroup
+	run := func(task func(iteration int)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for iteration := 0; iteration < iterations; iteration++ {
+				task(iteration)
+			}
+		}()
+	}
+
+	// Write to the state of the mesh
+	run(func(int) {
+		if err := meshorchestrator.SetNodeIDlist(nodelistlog); err != nil {
+			t.Errorf("SetNodeIDlist failed - %v", err)
+		}
+	})
+	run(func(iteration int) {
+		if err := meshorchestrator.SetNode(configlog(nodes[iteration%len(nodes)])); err != nil {
+			t.Errorf("SetNode failed - %v", err)
+		}
+	})
+	run(func(iteration int) {
+		if err := meshorchestrator.SetSensorData(sensorlog(nodes[iteration%len(nodes)])); err != nil {
+			t.Errorf("SetSensorData failed - %v", err)
+		}
+	})
+	run(func(int) { meshorchestrator.UpdateNodelist() })
+	run(func(iteration int) {
+		meshorchestrator.SendLog(newMeshLog("handshake", map[string]string{"node": nodes[iteration%len(nodes)]}))
+	})
+
+	// Read the state of the mesh
+	run(func(int) {
+		meshstate := meshorchestrator.Snapshot()
+		for nodeid, sensornode := range meshstate.Nodelist {
+			if sensornode.NodeID != nodeid {
+				t.Errorf("node %v is listed with the config of node %v", nodeid, sensornode.NodeID)
+			}
+		}
+	})
+	run(func(int) {
+		if status := orch.NewMeshOrchStatus(meshorchestrator); status == nil {
+			t.Errorf("NewMeshOrchStatus returned nil")
+		}
+	})
+
+	// Wait for the workers and the background tasks they started before shutting down
+	workers.Wait()
+	meshorchestrator.StopTasks()
+	meshorchestrator.Close()
+	<-loghandler
+
+	// Check the final state of the mesh
+	meshstate := meshorchestrator.Snapshot()
+	if len(meshstate.NodeIDlist) != len(nodes) {
+		t.Errorf("expected %v node IDs, got %v", len(nodes), meshstate.NodeIDlist)
+	}
+	if len(meshstate.Nodelist) != len(nodes) {
+		t.Errorf("expected %v nodes on the nodelist, got %v", len(nodes), len(meshstate.Nodelist))
+	}
+	if len(meshstate.Readings) != len(nodes) {
+		t.Errorf("expected %v readings, got %v", len(nodes), len(meshstate.Readings))
+	}
+}
+
+// A function that returns the statistics of a queue of the orchestrator.
+func queueStats(t *testing.T, meshorchestrator *tools.MeshOrchestrator, name string) tools.QueueStats {
+	for _, stats := range meshorchestrator.QueueStats() {
+		if stats.Name == name {
+			return stats
+		}
+	}
+	t.Fatalf("no statistics for the %v queue", name)
+	return tools.QueueStats{}
+}
+
+// A test that fills the CommandQueue and checks that the commands issued by the orchestrator itself
+// wait for room on it instead of being rejected, are counted as deferred and are skipped while the
+// same command is already waiting to be sent.
+func TestInternalCommandsAreNeverRejected(t *testing.T) {
+	meshorchestrator := newTestOrchestratorWithConfig(t, `{"queues": {"command": {"capacity": 1, "policy": "reject"}}}`)
+
+	// Fill the CommandQueue and check that a command of a caller is rejected
+	if _, err := meshorchestrator.SendCommand(context.Background(), map[string]string{"command": "readconfig-control"}); err != nil {
+		t.Fatalf("could not send command - %v", err)
+	}
+	if _, err := meshorchestrator.SendCommand(context.Background(), map[string]string{"command": "readconfig-control"}); !errors.Is(err, tools.ErrQueueFull) {
+		t.Fatalf("expected the command to be rejected by the full queue, got %v", err)
+	}
+
+	// Send an internal command and wait for it to be deferred by the full queue
+	meshorchestrator.SendInternalCommand(map[string]string{"command": "readnodelist-control"})
+	deadline := time.Now().Add(5 * time.Second)
+	for queueStats(t, meshorchestrator, tools.QueueCommand).Deferred == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("internal command was not deferred by the full queue")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Send a command twice while the first internal command waits, the second is skipped
+	meshorchestrator.SendInternalCommand(map[string]string{"command": "readtopology-control"})
+	meshorchestrator.SendInternalCommand(map[string]string{"command": "readtopology-control"})
+
+	// Drain the CommandQueue and check the order of the commands
+	expected := []string{"readconfig-control", "readnodelist-control", "readtopology-control"}
+	for _, message := range expected {
+		select {
+		case command := <-meshorchestrator.CommandQueue:
+			if command["command"] != message {
+				t.Errorf("expected command %v, got %v", message, command["command"])
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for command %v", message)
+		}
+	}
+	meshorchestrator.StopTasks()
+	select {
+	case command := <-meshorchestrator.CommandQueue:
+		t.Errorf("unexpected command %v", command["command"])
+	default:
+	}
+
+	// Check the counts of the CommandQueue
+	stats := queueStats(t, meshorchestrator, tools.QueueCommand)
+	if stats.Enqueued != 3 || stats.Rejected != 1 || stats.Deferred == 0 {
+		t.Errorf("expected 3 enqueued, 1 rejected and some deferred commands, got %+v", stats)
+	}
+}
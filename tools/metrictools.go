@@ -0,0 +1,273 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The namespace of the metrics of the orchestrator.
+const MetricsNamespace = "fyrmesh"
+
+// The kinds of documents pushed to the cloud and the results of a push.
+const (
+	CloudPushPing = "ping"
+	CloudPushMesh = "mesh"
+
+	CloudPushSuccess  = "success"
+	CloudPushBuffered = "buffered"
+	CloudPushFailure  = "failure"
+)
+
+// A struct that defines the configuration of the metrics endpoint of the orchestrator.
+// The endpoint is disabled if the address is empty.
+type MetricsConfig struct {
+	Address string `json:"address,omitempty"`
+}
+
+// A struct that defines the metrics of the orchestrator that are exported to Prometheus.
+// The counters are updated as the events occur while the state of the queues, the observers,
+// the LINK stream and the nodes is collected from the orchestrator when the metrics are scraped.
+type Metrics struct {
+	// The registry of the metrics of the orchestrator
+	Registry *prometheus.Registry
+
+	// The counters of the pings written to the LINK and the mesh pings completed
+	pingssent      prometheus.Counter
+	pingscompleted prometheus.Counter
+
+	// The histogram of the time taken by mesh pings to accumulate a response from every node
+	accumulation prometheus.Histogram
+
+	// The counter of the documents pushed to the cloud by their kind and result
+	cloudpushes *prometheus.CounterVec
+
+	// The counter of the calls rejected by the rate limiter
+	ratelimited prometheus.Counter
+
+	// The descriptions of the metrics collected when they are scraped
+	nodesdesc, lastseendesc, probabilitydesc, meshprobabilitydesc *prometheus.Desc
+	reconnectsdesc, queuedepthdesc, observersdesc                 *prometheus.Desc
+
+	// A mutex that guards the state of the nodes
+	mutex sync.Mutex
+	// The node IDs on the mesh, the time each node was last seen and the latest fire probability of each node
+	nodes         []int64
+	lastseen      map[int64]time.Time
+	probabilities map[int64]float64
+
+	// The orchestrator whose state is collected
+	meshorchestrator *MeshOrchestrator
+}
+
+// A constructor function that generates and returns the Metrics of a MeshOrchestrator.
+// The metrics are registered on a new registry along with the metrics of the Go runtime and the process.
+func NewMetrics(meshorchestrator *MeshOrchestrator) *Metrics {
+	metrics := &Metrics{
+		Registry:         prometheus.NewRegistry(),
+		lastseen:         make(map[int64]time.Time),
+		probabilities:    make(map[int64]float64),
+		meshorchestrator: meshorchestrator,
+	}
+
+	// Create the metrics that are updated as the events occur
+	metrics.pingssent = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace, Name: "pings_sent_total",
+		Help: "The number of ping commands written to the LINK server.",
+	})
+	metrics.pingscompleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace, Name: "pings_completed_total",
+		Help: "The number of mesh pings that accumulated a response from every node.",
+	})
+	metrics.accumulation = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace, Name: "accumulation_duration_seconds",
+		Help:    "The time from the first response of a mesh ping to its completion.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	})
+	metrics.cloudpushes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace, Name: "cloud_pushes_total",
+		Help: "The number of documents pushed to the cloud by their kind and result.",
+	}, []string{"kind", "result"})
+	metrics.ratelimited = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace, Name: "ratelimited_calls_total",
+		Help: "The number of calls that send commands to the control node rejected by the rate limiter.",
+	})
+
+	// Create the descriptions of the metrics that are collected when they are scraped
+	metrics.nodesdesc = prometheus.NewDesc(MetricsNamespace+"_mesh_nodes",
+		"The number of nodes on the mesh.", nil, nil)
+	metrics.lastseendesc = prometheus.NewDesc(MetricsNamespace+"_node_last_seen_seconds",
+		"The number of seconds since the node was last heard from.", []string{"node"}, nil)
+	metrics.probabilitydesc = prometheus.NewDesc(MetricsNamespace+"_node_fire_probability",
+		"The latest fire probability of the node.", []string{"node"}, nil)
+	metrics.meshprobabilitydesc = prometheus.NewDesc(MetricsNamespace+"_mesh_fire_probability",
+		"The average of the latest fire probability of the nodes on the mesh.", nil, nil)
+	metrics.reconnectsdesc = prometheus.NewDesc(MetricsNamespace+"_link_reconnects_total",
+		"The number of times the read stream from the LINK server has been reopened.", nil, nil)
+	metrics.queuedepthdesc = prometheus.NewDesc(MetricsNamespace+"_queue_depth",
+		"The number of items on the queue.", []string{"queue"}, nil)
+	metrics.observersdesc = prometheus.NewDesc(MetricsNamespace+"_observers",
+		"The number of observers connected to the orchestrator.", nil, nil)
+
+	// Register the metrics of the orchestrator, the Go runtime and the process
+	metrics.Registry.MustRegister(
+		metrics.pingssent, metrics.pingscompleted, metrics.accumulation, metrics.cloudpushes, metrics.ratelimited, metrics,
+		prometheus.NewGoCollector(), prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+
+	return metrics
+}
+
+// A method of Metrics that counts a ping written to the LINK server.
+func (metrics *Metrics) PingWritten() {
+	metrics.pingssent.Inc()
+}
+
+// A method of Metrics that counts a completed mesh ping and
+// observes the time since its first response was accumulated.
+func (metrics *Metrics) PingCompleted(started time.Time) {
+	metrics.pingscompleted.Inc()
+	metrics.accumulation.Observe(time.Since(started).Seconds())
+}
+
+// A method of Metrics that counts a document pushed to the cloud from the outcome of the push.
+func (metrics *Metrics) CloudPushed(kind string, buffered bool, err error) {
+	result := CloudPushSuccess
+	if buffered {
+		result = CloudPushBuffered
+	} else if err != nil {
+		result = CloudPushFailure
+	}
+	metrics.cloudpushes.WithLabelValues(kind, result).Inc()
+}
+
+// A method of Metrics that counts a call rejected by the rate limiter.
+func (metrics *Metrics) CallRateLimited() {
+	metrics.ratelimited.Inc()
+}
+
+// A method of Metrics that sets the node IDs on the mesh.
+// The state of the nodes that have left the mesh is discarded.
+func (metrics *Metrics) SetNodes(nodeids []int64) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	// Collect the node IDs that are on the mesh
+	current := make(map[int64]bool, len(nodeids))
+	for _, nodeid := range nodeids {
+		current[nodeid] = true
+	}
+
+	// Discard the state of the nodes that are not on the mesh
+	for nodeid := range metrics.lastseen {
+		if !current[nodeid] {
+			delete(metrics.lastseen, nodeid)
+		}
+	}
+	for nodeid := range metrics.probabilities {
+		if !current[nodeid] {
+			delete(metrics.probabilities, nodeid)
+		}
+	}
+
+	metrics.nodes = append([]int64(nil), nodeids...)
+}
+
+// A method of Metrics that records a node being heard from.
+func (metrics *Metrics) NodeSeen(nodeid int64) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	metrics.lastseen[nodeid] = time.Now()
+}
+
+// A method of Metrics that sets the latest fire probability of a node.
+func (metrics *Metrics) SetFireProbability(nodeid int64, probability float64) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	metrics.probabilities[nodeid] = probability
+}
+
+// A method of Metrics that sends the descriptions of the metrics collected when they are scraped.
+// Implements the prometheus.Collector interface.
+func (metrics *Metrics) Describe(descs chan<- *prometheus.Desc) {
+	descs <- metrics.nodesdesc
+	descs <- metrics.lastseendesc
+	descs <- metrics.probabilitydesc
+	descs <- metrics.meshprobabilitydesc
+	descs <- metrics.reconnectsdesc
+	descs <- metrics.queuedepthdesc
+	descs <- metrics.observersdesc
+}
+
+// A method of Metrics that collects the state of the orchestrator when the metrics are scraped.
+// Implements the prometheus.Collector interface.
+func (metrics *Metrics) Collect(collected chan<- prometheus.Metric) {
+	metrics.mutex.Lock()
+	now := time.Now()
+
+	// Collect the number of nodes, the time since each node was last seen and their fire probabilities
+	collected <- prometheus.MustNewConstMetric(metrics.nodesdesc, prometheus.GaugeValue, float64(len(metrics.nodes)))
+	for nodeid, lastseen := range metrics.lastseen {
+		node := strconv.FormatInt(nodeid, 10)
+		collected <- prometheus.MustNewConstMetric(metrics.lastseendesc, prometheus.GaugeValue, now.Sub(lastseen).Seconds(), node)
+	}
+	total := 0.0
+	for nodeid, probability := range metrics.probabilities {
+		node := strconv.FormatInt(nodeid, 10)
+		collected <- prometheus.MustNewConstMetric(metrics.probabilitydesc, prometheus.GaugeValue, probability, node)
+		total += probability
+	}
+
+	// Collect the average fire probability of the mesh, if any node has reported one
+	if len(metrics.probabilities) > 0 {
+		average := total / float64(len(metrics.probabilities))
+		collected <- prometheus.MustNewConstMetric(metrics.meshprobabilitydesc, prometheus.GaugeValue, average)
+	}
+	metrics.mutex.Unlock()
+
+	// Collect the reconnects of the LINK stream, the depth of the queues and the number of observers.
+	// The buffers of the observers are not collected since every observer has a buffer of its own.
+	meshorchestrator := metrics.meshorchestrator
+	collected <- prometheus.MustNewConstMetric(metrics.reconnectsdesc, prometheus.CounterValue, float64(meshorchestrator.Link.Status().Reconnects))
+	queuestats := []QueueStats{
+		meshorchestrator.logmonitor.Stats(),
+		meshorchestrator.commandmonitor.Stats(),
+		meshorchestrator.accumulatormonitor.Stats(),
+	}
+	if meshorchestrator.Outbox != nil {
+		queuestats = append(queuestats, meshorchestrator.Outbox.Stats())
+	}
+	for _, stats := range queuestats {
+		collected <- prometheus.MustNewConstMetric(metrics.queuedepthdesc, prometheus.GaugeValue, float64(stats.Depth), stats.Name)
+	}
+	collected <- prometheus.MustNewConstMetric(metrics.observersdesc, prometheus.GaugeValue, float64(meshorchestrator.ObserverBroker.Count()))
+}
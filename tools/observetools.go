@@ -0,0 +1,323 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+)
+
+// The default number of logs buffered for each observer of the orchestrator.
+const DefaultObserverBufferSize = 256
+
+// A struct that defines a subscription of an observer to the ObserverBroker.
+// Logs published to the broker are held in a bounded buffer until they are drained.
+// When a log arrives on a full buffer, the oldest log is dropped and counted.
+type ObserverSubscription struct {
+	// An integer identifier of the subscription
+	SubscriptionID int
+
+	// A channel that is signalled when logs are available on the buffer
+	Notify chan struct{}
+
+	// A mutex that guards the buffer and the dropped counter
+	mutex sync.Mutex
+
+	// A slice of Logs that have been published but not yet drained
+	buffer []Log
+
+	// The maximum number of Logs that the buffer can hold
+	capacity int
+
+	// The number of Logs that have been dropped from the buffer
+	dropped uint64
+
+	// The number of Logs that have been pushed onto the buffer
+	enqueued uint64
+
+	// The largest number of Logs that have been on the buffer
+	highwater int
+}
+
+// A method of ObserverSubscription that pushes a Log onto the buffer. If the buffer is
+// full, the oldest Log is dropped to make room. Never blocks the publisher of the log.
+func (subscription *ObserverSubscription) push(log Log) {
+	subscription.mutex.Lock()
+	// Drop the oldest log if the buffer is full
+	if len(subscription.buffer) >= subscription.capacity {
+		subscription.buffer = subscription.buffer[1:]
+		subscription.dropped++
+	}
+	// Append the log to the buffer
+	subscription.buffer = append(subscription.buffer, log)
+	subscription.enqueued++
+	if len(subscription.buffer) > subscription.highwater {
+		subscription.highwater = len(subscription.buffer)
+	}
+	subscription.mutex.Unlock()
+
+	// Signal the observer without blocking if a signal is already pending
+	select {
+	case subscription.Notify <- struct{}{}:
+	default:
+	}
+}
+
+// A method of ObserverSubscription that removes and returns all the Logs currently
+// on the buffer along with the total number of Logs dropped over its lifetime.
+func (subscription *ObserverSubscription) Drain() ([]Log, uint64) {
+	subscription.mutex.Lock()
+	defer subscription.mutex.Unlock()
+
+	// Swap out the buffer for a new one
+	logs := subscription.buffer
+	subscription.buffer = make([]Log, 0, subscription.capacity)
+
+	// Return the logs and the dropped count
+	return logs, subscription.dropped
+}
+
+// A method of ObserverSubscription that returns the number of Logs dropped over its lifetime.
+func (subscription *ObserverSubscription) Dropped() uint64 {
+	subscription.mutex.Lock()
+	defer subscription.mutex.Unlock()
+	return subscription.dropped
+}
+
+// A method of ObserverSubscription that returns the statistics of the buffer.
+func (subscription *ObserverSubscription) Stats() QueueStats {
+	subscription.mutex.Lock()
+	defer subscription.mutex.Unlock()
+
+	return QueueStats{
+		Name:      fmt.Sprintf("%v-%d", QueueObserver, subscription.SubscriptionID),
+		Policy:    QueueDropOldest.String(),
+		Capacity:  subscription.capacity,
+		Depth:     len(subscription.buffer),
+		HighWater: subscription.highwater,
+		Enqueued:  subscription.enqueued,
+		Dropped:   subscription.dropped,
+	}
+}
+
+// A struct that defines a publish-subscribe broker for the logs of the orchestrator.
+// Every observer holds its own ObserverSubscription, so any number of observers can
+// watch the logs at once and a slow observer never stalls the publisher of the logs.
+type ObserverBroker struct {
+	// A mutex that guards the subscriptions
+	mutex sync.Mutex
+
+	// The number of Logs buffered for each subscription
+	buffersize int
+
+	// The identifier to assign to the next subscription
+	nextid int
+
+	// A mapping of the subscription IDs to their ObserverSubscriptions
+	subscriptions map[int]*ObserverSubscription
+}
+
+// A constructor function that generates and returns an ObserverBroker.
+// Requires the number of Logs to buffer for each subscription.
+func NewObserverBroker(buffersize int) *ObserverBroker {
+	// Create an empty ObserverBroker
+	broker := ObserverBroker{}
+
+	// Assign the buffer size and an empty map of subscriptions
+	broker.buffersize = buffersize
+	broker.subscriptions = make(map[int]*ObserverSubscription)
+
+	// Return the broker
+	return &broker
+}
+
+// A method of ObserverBroker that creates and returns a new ObserverSubscription.
+// The subscription receives every Log published after it has been created.
+func (broker *ObserverBroker) Subscribe() *ObserverSubscription {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	// Create a new subscription with the next subscription ID
+	subscription := ObserverSubscription{}
+	subscription.SubscriptionID = broker.nextid
+	subscription.Notify = make(chan struct{}, 1)
+	subscription.capacity = broker.buffersize
+	subscription.buffer = make([]Log, 0, broker.buffersize)
+
+	// Add the subscription to the broker and increment the next ID
+	broker.subscriptions[subscription.SubscriptionID] = &subscription
+	broker.nextid++
+
+	// Return the subscription
+	return &subscription
+}
+
+// A method of ObserverBroker that removes an ObserverSubscription from the broker.
+// No further Logs are published to the subscription after it has been removed.
+func (broker *ObserverBroker) Unsubscribe(subscription *ObserverSubscription) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	// Delete the subscription from the broker
+	delete(broker.subscriptions, subscription.SubscriptionID)
+}
+
+// A method of ObserverBroker that publishes a Log to every subscription on the broker.
+func (broker *ObserverBroker) Publish(log Log) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	// Push the log to every subscription
+	for _, subscription := range broker.subscriptions {
+		subscription.push(log)
+	}
+}
+
+// A method of ObserverBroker that returns the statistics of the buffer
+// of every subscription on the broker in the order of their subscription.
+func (broker *ObserverBroker) Stats() []QueueStats {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	// Collect the subscription IDs in order
+	ids := make([]int, 0, len(broker.subscriptions))
+	for id := range broker.subscriptions {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	// Collect the statistics of each subscription
+	stats := make([]QueueStats, 0, len(ids))
+	for _, id := range ids {
+		stats = append(stats, broker.subscriptions[id].Stats())
+	}
+
+	return stats
+}
+
+// A method of ObserverBroker that returns the number of subscriptions on the broker.
+func (broker *ObserverBroker) Count() int {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	return len(broker.subscriptions)
+}
+
+// A struct that defines a filter for the logs streamed to an observer.
+// A log matches the filter if its source is one of the Sources, its type is one of the Types,
+// every metadata matcher matches the glob pattern against the metadata value of the log and
+// its severity is at least the Severity of the filter. Empty Sources or Types match any log.
+type ObserveFilter struct {
+	// A slice of log sources to allow
+	Sources []string
+
+	// A slice of log types to allow
+	Types []string
+
+	// A mapping of metadata keys to the glob patterns their values must match
+	Metadata map[string]string
+
+	// The minimum severity level of the logs to allow
+	Severity int
+}
+
+// A constructor function that generates and returns an ObserveFilter.
+// Requires the sources, types, metadata matchers and the name of the minimum severity.
+// Returns an error if a metadata pattern is malformed or the severity is unknown.
+func NewObserveFilter(sources []string, types []string, metadata map[string]string, severity string) (*ObserveFilter, error) {
+	// Parse the severity level
+	level, err := ParseSeverity(severity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an ObserveFilter
+	filter := ObserveFilter{Severity: level, Metadata: make(map[string]string)}
+
+	// Add the non-empty sources and types to the filter
+	for _, source := range sources {
+		if source != "" {
+			filter.Sources = append(filter.Sources, source)
+		}
+	}
+	for _, logtype := range types {
+		if logtype != "" {
+			filter.Types = append(filter.Types, logtype)
+		}
+	}
+
+	// Check every metadata pattern and add it to the filter
+	for key, pattern := range metadata {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid metadata matcher '%v=%v' - %v", key, pattern, err)
+		}
+		filter.Metadata[key] = pattern
+	}
+
+	// Return the filter
+	return &filter, nil
+}
+
+// A method of ObserveFilter that returns whether a Log matches the filter.
+func (filter *ObserveFilter) Match(log Log) bool {
+	// Check the source of the log
+	if len(filter.Sources) > 0 && !containsString(filter.Sources, log.GetLogsource()) {
+		return false
+	}
+
+	// Check the type of the log
+	if len(filter.Types) > 0 && !containsString(filter.Types, log.GetLogtype()) {
+		return false
+	}
+
+	// Check the metadata of the log against each matcher
+	logmetadata := log.GetLogmetadata()
+	for key, pattern := range filter.Metadata {
+		value, ok := logmetadata[key]
+		if !ok {
+			return false
+		}
+		if matched, _ := path.Match(pattern, value); !matched {
+			return false
+		}
+	}
+
+	// Check the severity of the log
+	return LogSeverity(log) >= filter.Severity
+}
+
+// A function that returns whether a slice of strings contains a given string.
+func containsString(slice []string, str string) bool {
+	for _, item := range slice {
+		if item == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,413 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// The name of the file beside the config file that holds the commands pending in the outbox.
+const OutboxFile = "outbox.json"
+
+// An error that is returned when a command is not added to the outbox because it is full.
+var ErrOutboxFull = errors.New("outbox is full")
+
+// An error that is returned when the outbox could not be written to the OutboxFile. The
+// change to the outbox is kept in memory and is persisted with the next successful write.
+var ErrOutboxPersist = errors.New("outbox could not be persisted")
+
+// The default number of commands the outbox can hold.
+const DefaultOutboxCapacity = 256
+
+// The default number of seconds a command is held in the outbox before it expires.
+const DefaultOutboxTTL = 600
+
+// A map of the commands that expire sooner than the DefaultOutboxTTL to their TTL in seconds.
+// A ping for sensor data is useless once the moment it was meant to capture has passed.
+var DefaultOutboxTTLs = map[string]int{
+	"readsensors-mesh": 60,
+	"readsensors-node": 60,
+}
+
+// A struct that defines the configuration of the command outbox.
+type OutboxConfig struct {
+	Enabled  bool           `json:"enabled"`
+	Capacity int            `json:"capacity,omitempty"`
+	TTL      int            `json:"ttl,omitempty"`
+	TTLs     map[string]int `json:"ttls,omitempty"`
+}
+
+// A method of OutboxConfig that returns the number of commands the outbox can hold.
+func (config OutboxConfig) GetCapacity() int {
+	if config.Capacity <= 0 {
+		return DefaultOutboxCapacity
+	}
+	return config.Capacity
+}
+
+// A method of OutboxConfig that returns the duration a command is held in the outbox before it
+// expires. The TTL of the command in the config is used first, followed by its default TTL and
+// then the TTL of the config for every command, which falls back to the DefaultOutboxTTL.
+func (config OutboxConfig) GetTTL(command string) time.Duration {
+	if ttl, ok := config.TTLs[command]; ok && ttl > 0 {
+		return time.Second * time.Duration(ttl)
+	}
+	if ttl, ok := DefaultOutboxTTLs[command]; ok {
+		return time.Second * time.Duration(ttl)
+	}
+	if config.TTL > 0 {
+		return time.Second * time.Duration(config.TTL)
+	}
+	return time.Second * DefaultOutboxTTL
+}
+
+// A struct that defines a command pending in the outbox.
+type OutboxEntry struct {
+	// The ID of the command
+	CommandID string `json:"cmdid"`
+	// The command map with the command message, its metadata and its ID
+	Command map[string]string `json:"command"`
+	// The time at which the command entered the outbox
+	Queued time.Time `json:"queued"`
+	// The time after which the command is dropped from the outbox
+	Expires time.Time `json:"expires"`
+	// The number of attempts to write the command to the LINK
+	Attempts int `json:"attempts"`
+	// The error of the last attempt to write the command
+	LastError string `json:"lasterror,omitempty"`
+}
+
+// A method of OutboxEntry that returns a copy of the command map of the entry,
+// since the command map is consumed when it is written to the LINK.
+func (entry OutboxEntry) CopyCommand() map[string]string {
+	command := make(map[string]string, len(entry.Command))
+	for key, value := range entry.Command {
+		command[key] = value
+	}
+	return command
+}
+
+// A struct that defines a disk-backed outbox of the commands that are to be written to the LINK.
+// Commands are held in the order they were added and persisted to the OutboxFile on every change,
+// so that they survive an outage of the LINK and a restart of the orchestrator. The outbox is
+// paused after a failed write until the next attempt, and a command is dropped once it expires.
+type Outbox struct {
+	// A mutex that guards the entries and the counters
+	mutex sync.Mutex
+
+	// The path of the file that the entries are persisted to
+	filepath string
+
+	// The configuration of the outbox
+	config OutboxConfig
+
+	// A slice of the pending entries in the order they were added
+	entries []*OutboxEntry
+
+	// The time before which no command is written to the LINK
+	nextattempt time.Time
+
+	// The largest number of entries that have been in the outbox
+	highwater int
+
+	// The number of entries added, dropped by expiry or purge and rejected by a full outbox
+	enqueued, dropped, rejected uint64
+
+	// A channel that is signalled when an entry is added or the outbox is woken
+	Notify chan struct{}
+}
+
+// A constructor function that generates and returns an Outbox with the given configuration.
+// The entries persisted in the OutboxFile beside the config file are loaded into the outbox.
+func NewOutbox(config OutboxConfig) (*Outbox, error) {
+	// Read the 'FYRMESHCONFIG' env var
+	filedir := os.Getenv("FYRMESHCONFIG")
+	if filedir == "" {
+		return nil, fmt.Errorf("environment variable 'FYRMESHCONFIG' has not been set")
+	}
+
+	// Create the outbox
+	outbox := Outbox{filepath: filepath.Join(filedir, OutboxFile), config: config, Notify: make(chan struct{}, 1)}
+
+	// Load the persisted entries, if any
+	data, err := ioutil.ReadFile(outbox.filepath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not read outbox file - %v", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &outbox.entries); err != nil {
+			return nil, fmt.Errorf("could not parse outbox file - %v", err)
+		}
+	}
+	outbox.highwater = len(outbox.entries)
+
+	// Return the outbox
+	return &outbox, nil
+}
+
+// A method of Outbox that writes the entries to the OutboxFile. Must be called with the mutex held.
+func (outbox *Outbox) save() error {
+	// Serialize the entries
+	data, err := json.MarshalIndent(outbox.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize outbox - %v", err)
+	}
+
+	// Durably replace the outbox file with the entries
+	if err := writeFileSynced(outbox.filepath, data, 0600); err != nil {
+		return fmt.Errorf("could not write outbox file - %v", err)
+	}
+
+	return nil
+}
+
+// A method of Outbox that signals the Notify channel without blocking.
+func (outbox *Outbox) notify() {
+	select {
+	case outbox.Notify <- struct{}{}:
+	default:
+	}
+}
+
+// A method of Outbox that returns the index of an entry given its command ID,
+// or -1 if the entry is not in the outbox. Must be called with the mutex held.
+func (outbox *Outbox) find(cmdid string) int {
+	for index, entry := range outbox.entries {
+		if entry.CommandID == cmdid {
+			return index
+		}
+	}
+	return -1
+}
+
+// A method of Outbox that adds a command to the outbox and persists it. The command must carry its
+// command ID with the CommandIDKey. Returns an ErrOutboxFull error if the command was not queued.
+// Returns the new entry with an ErrOutboxPersist error if the command was queued but not persisted.
+func (outbox *Outbox) Add(command map[string]string) (OutboxEntry, error) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Reject the command if the outbox is full
+	if len(outbox.entries) >= outbox.config.GetCapacity() {
+		outbox.rejected++
+		return OutboxEntry{}, fmt.Errorf("%w (%v commands)", ErrOutboxFull, len(outbox.entries))
+	}
+
+	// Create the entry with the TTL of the command
+	now := time.Now()
+	entry := &OutboxEntry{CommandID: command[CommandIDKey], Command: command, Queued: now, Expires: now.Add(outbox.config.GetTTL(command["command"]))}
+	outbox.entries = append(outbox.entries, entry)
+
+	// Count the entry
+	outbox.enqueued++
+	if len(outbox.entries) > outbox.highwater {
+		outbox.highwater = len(outbox.entries)
+	}
+
+	// Signal the new entry and persist the outbox, the entry stays queued even if it is not persisted
+	outbox.notify()
+	if err := outbox.save(); err != nil {
+		return *entry, fmt.Errorf("%w - %v", ErrOutboxPersist, err)
+	}
+
+	return *entry, nil
+}
+
+// A method of Outbox that returns the oldest entry if a command may be written to the LINK at the
+// given time. Returns whether an entry was returned and the duration until the outbox should be
+// checked again, which is either the time of the next attempt or of the next expiry. A duration
+// of zero means that the outbox only needs to be checked again once it is notified.
+func (outbox *Outbox) Next(now time.Time) (OutboxEntry, bool, time.Duration) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Nothing is due if the outbox is empty
+	if len(outbox.entries) == 0 {
+		return OutboxEntry{}, false, 0
+	}
+
+	// Return the oldest entry if the outbox is not paused
+	if !now.Before(outbox.nextattempt) {
+		return *outbox.entries[0], true, 0
+	}
+
+	// Wait until the next attempt or the next expiry, whichever is sooner
+	wait := outbox.nextattempt.Sub(now)
+	for _, entry := range outbox.entries {
+		if until := entry.Expires.Sub(now); until < wait {
+			wait = until
+		}
+	}
+	if wait <= 0 {
+		wait = time.Millisecond
+	}
+
+	return OutboxEntry{}, false, wait
+}
+
+// A method of Outbox that removes and returns the entries that have expired at the given time.
+func (outbox *Outbox) Expire(now time.Time) ([]OutboxEntry, error) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Split the entries into the expired and the remaining
+	expired := make([]OutboxEntry, 0)
+	remaining := make([]*OutboxEntry, 0, len(outbox.entries))
+	for _, entry := range outbox.entries {
+		if now.After(entry.Expires) {
+			expired = append(expired, *entry)
+		} else {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	// Persist the outbox if any entry expired
+	if len(expired) == 0 {
+		return expired, nil
+	}
+	outbox.entries = remaining
+	outbox.dropped += uint64(len(expired))
+	return expired, outbox.save()
+}
+
+// A method of Outbox that removes an entry after it has been written to the LINK, or after the LINK
+// rejected it, and resumes the outbox. Does nothing if the entry is not in the outbox.
+func (outbox *Outbox) Remove(cmdid string) error {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Resume the outbox, since the LINK has responded
+	outbox.nextattempt = time.Time{}
+
+	// Remove the entry and persist the outbox
+	index := outbox.find(cmdid)
+	if index < 0 {
+		return nil
+	}
+	outbox.entries = append(outbox.entries[:index], outbox.entries[index+1:]...)
+	return outbox.save()
+}
+
+// A method of Outbox that records a failed attempt to write an entry to the LINK and pauses the
+// outbox until the time of the next attempt. Returns the number of attempts made for the entry.
+func (outbox *Outbox) Retry(cmdid string, err error, next time.Time) (int, error) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Pause the outbox until the next attempt
+	outbox.nextattempt = next
+
+	// Record the attempt on the entry and persist the outbox
+	index := outbox.find(cmdid)
+	if index < 0 {
+		return 0, nil
+	}
+	entry := outbox.entries[index]
+	entry.Attempts++
+	entry.LastError = err.Error()
+	return entry.Attempts, outbox.save()
+}
+
+// A method of Outbox that resumes a paused outbox, so that the pending commands are written
+// to the LINK without waiting for the next attempt. Called once the LINK has recovered.
+func (outbox *Outbox) Wake() {
+	outbox.mutex.Lock()
+	outbox.nextattempt = time.Time{}
+	outbox.mutex.Unlock()
+
+	outbox.notify()
+}
+
+// A method of Outbox that removes and returns the entries with the given command IDs.
+// Every entry is removed and returned if no command IDs are given.
+func (outbox *Outbox) Purge(cmdids []string) ([]OutboxEntry, error) {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	// Collect the command IDs to purge
+	purge := make(map[string]bool)
+	for _, cmdid := range cmdids {
+		purge[cmdid] = true
+	}
+
+	// Split the entries into the purged and the remaining
+	purged := make([]OutboxEntry, 0)
+	remaining := make([]*OutboxEntry, 0, len(outbox.entries))
+	for _, entry := range outbox.entries {
+		if len(cmdids) == 0 || purge[entry.CommandID] {
+			purged = append(purged, *entry)
+		} else {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	// Persist the outbox if any entry was purged
+	if len(purged) == 0 {
+		return purged, nil
+	}
+	outbox.entries = remaining
+	outbox.dropped += uint64(len(purged))
+	return purged, outbox.save()
+}
+
+// A method of Outbox that returns the pending entries in the order they were added.
+func (outbox *Outbox) List() []OutboxEntry {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	entries := make([]OutboxEntry, 0, len(outbox.entries))
+	for _, entry := range outbox.entries {
+		entries = append(entries, *entry)
+	}
+	return entries
+}
+
+// A method of Outbox that returns the statistics of the outbox as a queue with the reject policy.
+// The dropped count includes the entries that expired and the entries that were purged.
+func (outbox *Outbox) Stats() QueueStats {
+	outbox.mutex.Lock()
+	defer outbox.mutex.Unlock()
+
+	return QueueStats{
+		Name:      QueueOutbox,
+		Policy:    QueueReject.String(),
+		Capacity:  outbox.config.GetCapacity(),
+		Depth:     len(outbox.entries),
+		HighWater: outbox.highwater,
+		Enqueued:  outbox.enqueued,
+		Dropped:   outbox.dropped,
+		Rejected:  outbox.rejected,
+	}
+}
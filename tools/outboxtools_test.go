@@ -0,0 +1,208 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that returns an empty Outbox persisted in a temporary config directory.
+func newTestOutbox(t *testing.T, config tools.OutboxConfig) *tools.Outbox {
+	setTestConfigDir(t, t.TempDir())
+	outbox, err := tools.NewOutbox(config)
+	if err != nil {
+		t.Fatalf("could not create outbox - %v", err)
+	}
+	return outbox
+}
+
+// A function that adds a command with a new command ID to an outbox and returns its entry.
+func addTestCommand(t *testing.T, outbox *tools.Outbox, command string) tools.OutboxEntry {
+	entry, err := outbox.Add(map[string]string{"command": command, tools.CommandIDKey: tools.NewCommandID()})
+	if err != nil {
+		t.Fatalf("could not add %v to the outbox - %v", command, err)
+	}
+	return entry
+}
+
+// A test that checks the TTL of the commands for the configs of the outbox.
+func TestOutboxConfigTTL(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  tools.OutboxConfig
+		command string
+		ttl     time.Duration
+	}{
+		{"default", tools.OutboxConfig{}, "readconfig-control", tools.DefaultOutboxTTL * time.Second},
+		{"default of command", tools.OutboxConfig{}, "readsensors-mesh", 60 * time.Second},
+		{"config", tools.OutboxConfig{TTL: 30}, "readconfig-control", 30 * time.Second},
+		{"default of command over config", tools.OutboxConfig{TTL: 30}, "readsensors-node", 60 * time.Second},
+		{"config of command", tools.OutboxConfig{TTL: 30, TTLs: map[string]int{"readsensors-mesh": 5}}, "readsensors-mesh", 5 * time.Second},
+		{"invalid config of command", tools.OutboxConfig{TTL: 30, TTLs: map[string]int{"readconfig-control": 0}}, "readconfig-control", 30 * time.Second},
+	}
+
+	for _, test := range tests {
+		if ttl := test.config.GetTTL(test.command); ttl != test.ttl {
+			t.Errorf("%v: expected TTL %v, got %v", test.name, test.ttl, ttl)
+		}
+	}
+}
+
+// A test that expires the commands of the outbox at different times and checks
+// the commands that expire, the commands that remain and the persisted outbox.
+func TestOutboxExpire(t *testing.T) {
+	outbox := newTestOutbox(t, tools.OutboxConfig{Enabled: true, TTL: 300})
+	sensors := addTestCommand(t, outbox, "readsensors-mesh")
+	config := addTestCommand(t, outbox, "readconfig-control")
+	start := sensors.Queued
+
+	tests := []struct {
+		elapsed   time.Duration
+		expired   []string
+		remaining int
+	}{
+		{30 * time.Second, []string{}, 2},
+		{61 * time.Second, []string{sensors.CommandID}, 1},
+		{120 * time.Second, []string{}, 1},
+		{301 * time.Second, []string{config.CommandID}, 0},
+	}
+
+	for _, test := range tests {
+		expired, err := outbox.Expire(start.Add(test.elapsed))
+		if err != nil {
+			t.Fatalf("after %v: could not expire the outbox - %v", test.elapsed, err)
+		}
+		cmdids := make([]string, 0)
+		for _, entry := range expired {
+			cmdids = append(cmdids, entry.CommandID)
+		}
+		if fmt.Sprint(cmdids) != fmt.Sprint(test.expired) {
+			t.Errorf("after %v: expected expired %v, got %v", test.elapsed, test.expired, cmdids)
+		}
+
+		// Check the remaining entries, including the entries persisted to the outbox file
+		reopened, err := tools.NewOutbox(tools.OutboxConfig{Enabled: true})
+		if err != nil {
+			t.Fatalf("after %v: could not reopen the outbox - %v", test.elapsed, err)
+		}
+		if remaining := len(outbox.List()); remaining != test.remaining {
+			t.Errorf("after %v: expected %v remaining commands, got %v", test.elapsed, test.remaining, remaining)
+		}
+		if persisted := len(reopened.List()); persisted != test.remaining {
+			t.Errorf("after %v: expected %v persisted commands, got %v", test.elapsed, test.remaining, persisted)
+		}
+	}
+
+	if stats := outbox.Stats(); stats.Enqueued != 2 || stats.Dropped != 2 || stats.Depth != 0 {
+		t.Errorf("expected 2 enqueued and 2 dropped commands, got %+v", stats)
+	}
+}
+
+// A test that fails the writes of a command and checks that the outbox is paused until the next attempt,
+// that the attempts are recorded and persisted, and that the outbox resumes once it is woken or written.
+func TestOutboxRetry(t *testing.T) {
+	outbox := newTestOutbox(t, tools.OutboxConfig{Enabled: true})
+	first := addTestCommand(t, outbox, "readconfig-control")
+	second := addTestCommand(t, outbox, "readnodelist-control")
+	now := second.Queued
+
+	// The oldest command is due first
+	if entry, due, _ := outbox.Next(now); !due || entry.CommandID != first.CommandID {
+		t.Fatalf("expected the first command to be due, got due %v with %v", due, entry.CommandID)
+	}
+
+	// A failed write pauses the outbox until the next attempt
+	for attempt := 1; attempt <= 2; attempt++ {
+		attempts, err := outbox.Retry(first.CommandID, errors.New("link unavailable"), now.Add(5*time.Second))
+		if err != nil || attempts != attempt {
+			t.Fatalf("expected attempt %v, got %v with error %v", attempt, attempts, err)
+		}
+	}
+	if _, due, wait := outbox.Next(now); due || wait != 5*time.Second {
+		t.Errorf("expected the outbox to be paused for 5s, got due %v and wait %v", due, wait)
+	}
+	if entry, due, _ := outbox.Next(now.Add(5 * time.Second)); !due || entry.CommandID != first.CommandID {
+		t.Errorf("expected the first command to be due after the pause, got due %v with %v", due, entry.CommandID)
+	}
+
+	// The attempts of the command are persisted
+	reopened, err := tools.NewOutbox(tools.OutboxConfig{Enabled: true})
+	if err != nil {
+		t.Fatalf("could not reopen the outbox - %v", err)
+	}
+	if entries := reopened.List(); len(entries) != 2 || entries[0].Attempts != 2 || entries[0].LastError != "link unavailable" {
+		t.Errorf("expected the persisted attempts of the first command, got %+v", entries)
+	}
+
+	// The paused outbox is resumed when it is woken
+	outbox.Retry(first.CommandID, errors.New("link unavailable"), now.Add(time.Minute))
+	outbox.Wake()
+	if _, due, _ := outbox.Next(now); !due {
+		t.Errorf("expected the woken outbox to be due")
+	}
+
+	// The next command is due once the first is written
+	outbox.Retry(first.CommandID, errors.New("link unavailable"), now.Add(time.Minute))
+	if err := outbox.Remove(first.CommandID); err != nil {
+		t.Fatalf("could not remove the first command - %v", err)
+	}
+	if entry, due, _ := outbox.Next(now); !due || entry.CommandID != second.CommandID {
+		t.Errorf("expected the second command to be due, got due %v with %v", due, entry.CommandID)
+	}
+}
+
+// A test that checks that a pause of the outbox ends early for a command that expires before the next attempt.
+func TestOutboxNextWaitsForExpiry(t *testing.T) {
+	outbox := newTestOutbox(t, tools.OutboxConfig{Enabled: true})
+	entry := addTestCommand(t, outbox, "readsensors-mesh")
+	now := entry.Queued
+
+	outbox.Retry(entry.CommandID, errors.New("link unavailable"), now.Add(time.Hour))
+	if _, due, wait := outbox.Next(now); due || wait != entry.Expires.Sub(now) {
+		t.Errorf("expected to wait %v for the expiry, got due %v and wait %v", entry.Expires.Sub(now), due, wait)
+	}
+}
+
+// A test that fills the outbox and checks that the commands beyond its capacity are rejected.
+func TestOutboxFull(t *testing.T) {
+	outbox := newTestOutbox(t, tools.OutboxConfig{Enabled: true, Capacity: 2})
+	addTestCommand(t, outbox, "readconfig-control")
+	addTestCommand(t, outbox, "readnodelist-control")
+
+	if _, err := outbox.Add(map[string]string{"command": "readtopology-control", tools.CommandIDKey: tools.NewCommandID()}); !errors.Is(err, tools.ErrOutboxFull) {
+		t.Errorf("expected the full outbox to reject the command, got %v", err)
+	}
+	if stats := outbox.Stats(); stats.Enqueued != 2 || stats.Rejected != 1 || stats.Depth != 2 || stats.Capacity != 2 {
+		t.Errorf("expected 2 enqueued and 1 rejected commands, got %+v", stats)
+	}
+}
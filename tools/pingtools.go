@@ -31,6 +31,8 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"sync"
+	"time"
 )
 
 // A function that maps a given input range of numbers to an output range.
@@ -155,6 +157,9 @@ type SensorPing struct {
 	// A SensorNode object that represents the origin of the ping data
 	Sensornode SensorNode
 
+	// A NodeRecord object that describes the origin of the ping data from the node registry
+	Noderecord NodeRecord
+
 	// A string that represents the pingID of a ping response
 	PingID string
 
@@ -235,6 +240,7 @@ func (sensorping *SensorPing) GenerateSensorData(sensordata map[string]string, m
 // Requires a Log of type 'sensordata'
 // The value of Sensordata is collected by parsed the sensordata log.
 // The value of the Sensornode is retrieved from MeshOrchestrator's Nodelist.
+// The value of the Noderecord is retrieved from MeshOrchestrator's Registry.
 // The value of the PingID is taken from sensordata log.
 // The value of the Pingtime is the current time when the struct is constructed.
 // The value of Fireprobabiliy is set by the CalculateFireProbability method.
@@ -258,7 +264,10 @@ func NewSensorPing(log Log, meshorchestrator *MeshOrchestrator) (*SensorPing, er
 	// Retrieve the node ID from the metadata
 	nodeid, _ := strconv.ParseInt(metadata["node"], 0, 64)
 	// Retrieve the SensorNode object for the nodeID from the mesh orchestrator
-	sensorping.Sensornode = meshorchestrator.Nodelist[nodeid]
+	sensorping.Sensornode, _ = meshorchestrator.GetNode(nodeid)
+	// Retrieve the NodeRecord object for the nodeID from the node registry, or a record with only the nodeID
+	sensorping.Noderecord, _ = meshorchestrator.Registry.Get(nodeid)
+	sensorping.Noderecord.NodeID = nodeid
 	// Assign the ping ID from the metadata
 	sensorping.PingID = metadata["ping"]
 	// Assign the ping time to the current time
@@ -284,6 +293,9 @@ type MeshPing struct {
 
 	// A string that represents the time of response of the first SensorPing to get accumulated
 	Pingtime string
+
+	// The time at which the MeshPing started to accumulate
+	started time.Time
 }
 
 // A constructor function that generates and returns a MeshPing.
@@ -300,6 +312,8 @@ func NewMeshPing(pingid string, pingtime string, nodelist []int64) *MeshPing {
 	meshping.PingID = pingid
 	meshping.Pingtime = pingtime
 	meshping.Nodelist = nodelist
+	// Set the time at which the accumulation started
+	meshping.started = time.Now()
 	// Create and assign empty slices for Pings
 	meshping.Pings = make(map[int64]SensorPing)
 
@@ -335,6 +349,22 @@ func (meshping *MeshPing) GenerateSensordatamap() map[string]map[string]float64
 	return sensordata
 }
 
+// A method of MeshPing that generates and returns a mapping of the string node ID to its NodeRecord.
+// Nodes that do not have a record in the node registry are mapped to a record with only their node ID.
+func (meshping *MeshPing) GenerateRecordmap() map[string]NodeRecord {
+	// Create an empty record map
+	records := make(map[string]NodeRecord)
+
+	// Iterate over the Pings in the meshping
+	for nodeid, sensorping := range meshping.Pings {
+		// Convert the nodeIDs to strings and assign the Noderecord
+		records[strconv.FormatInt(nodeid, 10)] = sensorping.Noderecord
+	}
+
+	// Return the records
+	return records
+}
+
 // A method of MeshPing that generates and a mapping of string node ID to the fire probability value
 func (meshping *MeshPing) GenerateProbabilitydatamap() map[string]float64 {
 	// Create an empty probability map
@@ -382,20 +412,29 @@ func (meshping *MeshPing) Flush(meshorchestrator *MeshOrchestrator) error {
 	pingdoc := NewPingDocument(meshping)
 
 	// Push the pingdoc to the cloud and check the success.
-	err := pingdoc.Push(&meshorchestrator.Cloudinterface)
-	if err != nil {
+	buffered, err := meshorchestrator.Cloud.PushPing(pingdoc)
+	meshorchestrator.Metrics.CloudPushed(CloudPushPing, buffered, err)
+	if buffered {
+		// Log the meshping being buffered until the cloud is available.
+		logmessage := NewOrchCloudlog(fmt.Sprintf("(buffered) mesh ping accumulated and buffered until the cloud is available | doc - %v", meshping.PingID))
+		meshorchestrator.SendLog(logmessage)
+	} else if err != nil {
 		// Log the meshping failing to be flushed to the cloud.
 		logmessage := NewOrchCloudlog(fmt.Sprintf("(failure) mesh ping accumulated and flush failed | doc - %v", meshping.PingID))
-		meshorchestrator.LogQueue <- logmessage
+		meshorchestrator.SendLog(logmessage)
+		// Mark the cloud interface as unhealthy
+		meshorchestrator.Health.Set(ComponentCloud, false, fmt.Sprintf("mesh ping flush failed - %v", err))
+	} else {
+		// Log the meshping succesfully being flushed to the cloud.
+		logmessage := NewOrchCloudlog(fmt.Sprintf("(success) mesh ping accumulated and flush successful | doc - %v", meshping.PingID))
+		meshorchestrator.SendLog(logmessage)
+		// Mark the cloud interface as healthy
+		meshorchestrator.Health.Set(ComponentCloud, true, "mesh ping flush successful")
 	}
 
-	// Log the meshping succesfully being flushed to the cloud.
-	logmessage := NewOrchCloudlog(fmt.Sprintf("(success) mesh ping accumulated and flush successful | doc - %v", meshping.PingID))
-	meshorchestrator.LogQueue <- logmessage
-
 	// Delete the meshping from the accumulation
-	delete(meshorchestrator.Accumulation, meshping.PingID)
-	return nil
+	delete(meshorchestrator.accumulation, meshping.PingID)
+	return err
 }
 
 // A method of MeshPing that assigns a SensorPing to the MeshPing
@@ -406,6 +445,10 @@ func (meshping *MeshPing) AddPing(sensorping SensorPing, meshorchestrator *MeshO
 
 	// Check if the meshping is complete
 	if meshping.Complete() {
+		// Count the completed mesh ping on the metrics
+		meshorchestrator.Metrics.PingCompleted(meshping.started)
+		// Trace the accumulation to the span that wrote the ping
+		meshorchestrator.PingTraces.TraceAccumulation(meshping.PingID, meshping.started, len(meshping.Pings))
 		// Flush the mesh ping to the cloud
 		meshping.Flush(meshorchestrator)
 	}
@@ -416,23 +459,111 @@ func (meshping *MeshPing) AddPing(sensorping SensorPing, meshorchestrator *MeshO
 // Mesh and assigns it to that new MeshPing and adds the new MeshPing to orchestrator's accumulation.
 func PingHandler(meshorchestrator *MeshOrchestrator) {
 	// log the beginning of the pinghandler
-	meshorchestrator.LogQueue <- NewOrchServerlog("(startup) ping handler has started")
+	meshorchestrator.SendLog(NewOrchServerlog("(startup) ping handler has started"))
+	// Mark the ping handler as healthy until the AccumulatorQueue closes
+	meshorchestrator.Health.Set(ComponentPingHandler, true, "running")
+	defer meshorchestrator.Health.Set(ComponentPingHandler, false, "accumulator queue closed")
 
 	// Iterate over the AccumulatorQueue until it closes.
 	for sensorping := range meshorchestrator.AccumulatorQueue {
 
 		// Check if the sensorping's ping ID exists on the accumulation
-		if meshping, ok := meshorchestrator.Accumulation[sensorping.PingID]; ok {
+		if meshping, ok := meshorchestrator.accumulation[sensorping.PingID]; ok {
 			// Assign the sensor ping to existing meshping
 			meshping.AddPing(sensorping, meshorchestrator)
 
 		} else {
 			// Create a new mesh ping with the sensorping's ping ID and ping time.
-			meshping := *NewMeshPing(sensorping.PingID, sensorping.Pingtime, meshorchestrator.NodeIDlist)
+			meshping := *NewMeshPing(sensorping.PingID, sensorping.Pingtime, meshorchestrator.GetNodeIDlist())
 			// Assign the sensorping into the new meshping
 			meshping.AddPing(sensorping, meshorchestrator)
 			// Add the new meshping into the meshorchestrator's accumulation.
-			meshorchestrator.Accumulation[sensorping.PingID] = meshping
+			meshorchestrator.accumulation[sensorping.PingID] = meshping
 		}
 	}
 }
+
+// A struct that defines a collector of the responses to a ping.
+// Every sensordata or configdata log that carries the PingID is
+// dispatched onto the Responses channel by the PingRegistry.
+type PingCollector struct {
+	// A string that represents the ping ID whose responses are collected
+	PingID string
+
+	// A channel of Logs that carries the responses to the ping
+	Responses chan Log
+}
+
+// A struct that defines a registry of PingCollectors. It maps the
+// ping IDs that are being collected to their respective collectors.
+type PingRegistry struct {
+	// A mutex that guards the collectors map
+	mutex sync.Mutex
+
+	// A mapping of string ping IDs to their PingCollectors
+	collectors map[string]*PingCollector
+}
+
+// A constructor function that generates and returns a PingRegistry.
+// The map of collectors is set to an empty map of string -> *PingCollector
+func NewPingRegistry() *PingRegistry {
+	// Create an empty PingRegistry
+	registry := PingRegistry{}
+	// Create and assign an empty map of collectors
+	registry.collectors = make(map[string]*PingCollector)
+
+	// Return the registry
+	return &registry
+}
+
+// A method of PingRegistry that registers a new PingCollector for the given ping ID.
+// The buffersize sets the number of responses the collector can hold before it is read.
+// Returns an error if a collector for the ping ID has already been registered.
+func (registry *PingRegistry) Register(pingid string, buffersize int) (*PingCollector, error) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Check if the ping ID is already being collected
+	if _, exists := registry.collectors[pingid]; exists {
+		return nil, fmt.Errorf("ping ID '%v' is already being collected", pingid)
+	}
+
+	// Create a new PingCollector and add it into the registry
+	collector := PingCollector{PingID: pingid, Responses: make(chan Log, buffersize)}
+	registry.collectors[pingid] = &collector
+
+	// Return the collector
+	return &collector, nil
+}
+
+// A method of PingRegistry that removes the PingCollector of the given ping ID.
+// Responses to the ping that arrive after this will no longer be collected.
+func (registry *PingRegistry) Unregister(pingid string) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Delete the collector from the registry
+	delete(registry.collectors, pingid)
+}
+
+// A method of PingRegistry that dispatches a Log to the PingCollector registered for the
+// ping ID in its metadata. Never blocks, the log is dropped if the collector's buffer is full.
+// Returns a bool indicating whether the log was dispatched to a collector.
+func (registry *PingRegistry) Dispatch(log Log) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Retrieve the collector for the ping ID of the log
+	collector, exists := registry.collectors[log.GetLogmetadata()["ping"]]
+	if !exists {
+		return false
+	}
+
+	// Send the log to the collector without blocking
+	select {
+	case collector.Responses <- log:
+		return true
+	default:
+		return false
+	}
+}
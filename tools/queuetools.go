@@ -0,0 +1,393 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// The names of the queues of the orchestrator as they appear in the
+// 'queues' section of the config file and in the status of the orchestrator.
+const (
+	QueueLog         = "log"
+	QueueCommand     = "command"
+	QueueAccumulator = "accumulator"
+	QueueObserver    = "observer"
+	QueueOutbox      = "outbox"
+)
+
+// An error that is returned when a queue with the reject policy is full.
+var ErrQueueFull = errors.New("queue is full")
+
+// An error that is returned when a queue has been closed.
+var ErrQueueClosed = errors.New("queue is closed")
+
+// A type that defines the policy of a queue when an item is sent to it while it is full.
+type QueuePolicy int
+
+// The overflow policies of a queue. With QueueBlock the sender waits for room on the queue,
+// with QueueDropOldest the oldest item on the queue is dropped to make room, with QueueDropNewest
+// the item being sent is dropped and with QueueReject the item is dropped and the sender is
+// returned an ErrQueueFull, which is surfaced to the callers of RPCs as an error.
+const (
+	QueueBlock QueuePolicy = iota
+	QueueDropOldest
+	QueueDropNewest
+	QueueReject
+)
+
+// A map of the QueuePolicies to their names.
+var queuepolicynames = map[QueuePolicy]string{
+	QueueBlock:      "block",
+	QueueDropOldest: "drop-oldest",
+	QueueDropNewest: "drop-newest",
+	QueueReject:     "reject",
+}
+
+// A method of QueuePolicy that returns the name of the policy.
+func (policy QueuePolicy) String() string {
+	if name, ok := queuepolicynames[policy]; ok {
+		return name
+	}
+	return fmt.Sprintf("policy(%d)", int(policy))
+}
+
+// A function that parses the name of a QueuePolicy.
+// Returns an error if the name is not a known policy.
+func ParseQueuePolicy(name string) (QueuePolicy, error) {
+	for policy, policyname := range queuepolicynames {
+		if policyname == name {
+			return policy, nil
+		}
+	}
+	return QueueBlock, fmt.Errorf("unknown queue policy '%v' (expected block, drop-oldest, drop-newest or reject)", name)
+}
+
+// A struct that defines the configuration of a queue of the orchestrator.
+type QueueConfig struct {
+	Capacity int    `json:"capacity"`
+	Policy   string `json:"policy"`
+}
+
+// A map of the queue names to their default QueueConfigs. The observer queue is the buffer
+// of each observer of the orchestrator and only supports the drop-oldest policy. The accumulator
+// queue does not support the block policy, since it is fed by the LogHandler.
+var DefaultQueueConfigs = map[string]QueueConfig{
+	QueueLog:         {Capacity: 1024, Policy: "block"},
+	QueueCommand:     {Capacity: 64, Policy: "reject"},
+	QueueAccumulator: {Capacity: 256, Policy: "drop-oldest"},
+	QueueObserver:    {Capacity: DefaultObserverBufferSize, Policy: "drop-oldest"},
+}
+
+// A method of Config that returns the QueueConfig of a queue. The capacity and
+// policy that are not set in the config file are taken from the DefaultQueueConfigs.
+func (config Config) GetQueueConfig(name string) QueueConfig {
+	queueconfig := config.Queues[name]
+	defaultconfig := DefaultQueueConfigs[name]
+
+	// Fall back to the default capacity and policy
+	if queueconfig.Capacity <= 0 {
+		queueconfig.Capacity = defaultconfig.Capacity
+	}
+	if queueconfig.Policy == "" {
+		queueconfig.Policy = defaultconfig.Policy
+	}
+
+	return queueconfig
+}
+
+// A struct that defines the statistics of a queue at a point in time.
+type QueueStats struct {
+	// The name of the queue
+	Name string
+	// The overflow policy of the queue
+	Policy string
+	// The number of items the queue can hold
+	Capacity int
+	// The number of items on the queue
+	Depth int
+	// The largest number of items that have been on the queue
+	HighWater int
+	// The number of items sent to the queue
+	Enqueued uint64
+	// The number of items dropped by the drop-oldest or drop-newest policy
+	Dropped uint64
+	// The number of items rejected by the reject policy
+	Rejected uint64
+	// The number of items issued by the orchestrator itself that found the queue full
+	// and waited for room on it instead of being rejected or dropped by the policy
+	Deferred uint64
+}
+
+// A struct that defines a monitor of a queue. It applies the overflow
+// policy of the queue to the items sent to it and counts their outcomes.
+type QueueMonitor struct {
+	// The name of the queue
+	Name string
+	// The number of items the queue can hold
+	Capacity int
+	// The overflow policy of the queue
+	Policy QueuePolicy
+
+	// A function that returns the number of items on the queue
+	depth func() int
+
+	// A mutex that guards the counters
+	mutex sync.Mutex
+	// The largest number of items that have been on the queue
+	highwater int
+	// The number of items sent, dropped, rejected and deferred
+	enqueued, dropped, rejected, deferred uint64
+}
+
+// A constructor function that generates and returns a QueueMonitor.
+// Requires the name and config of the queue and a function that returns its depth.
+// Returns an error if the policy of the queue is unknown.
+func NewQueueMonitor(name string, queueconfig QueueConfig, depth func() int) (*QueueMonitor, error) {
+	// Parse the policy of the queue
+	policy, err := ParseQueuePolicy(queueconfig.Policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for the %v queue - %v", name, err)
+	}
+
+	// Create the QueueMonitor and return it
+	monitor := QueueMonitor{Name: name, Capacity: queueconfig.Capacity, Policy: policy, depth: depth}
+	return &monitor, nil
+}
+
+// A method of QueueMonitor that sends an item to the queue according to its policy. Requires a
+// function that sends the item without blocking and reports if it was sent, a function that drops
+// the oldest item on the queue without blocking and reports if one was dropped, and a function that
+// sends the item and blocks until it is sent. Returns whether the item was sent to the queue
+// and an ErrQueueFull if the item was rejected.
+func (monitor *QueueMonitor) offer(trysend func() bool, dropoldest func() bool, send func()) (bool, error) {
+	switch monitor.Policy {
+	case QueueDropOldest:
+		// Drop the oldest items until the item fits on the queue
+		for !trysend() {
+			if dropoldest() {
+				monitor.count(&monitor.dropped)
+			}
+		}
+
+	case QueueDropNewest:
+		// Drop the item if the queue is full
+		if !trysend() {
+			monitor.count(&monitor.dropped)
+			return false, nil
+		}
+
+	case QueueReject:
+		// Reject the item if the queue is full
+		if !trysend() {
+			monitor.count(&monitor.rejected)
+			return false, ErrQueueFull
+		}
+
+	default:
+		// Wait for room on the queue
+		send()
+	}
+
+	// Count the item and the depth of the queue
+	monitor.count(&monitor.enqueued)
+	return true, nil
+}
+
+// A method of QueueMonitor that sends an item issued by the orchestrator itself to the queue. The item
+// is never rejected or dropped by the policy of the queue, instead it waits for room on a full queue and
+// is counted as deferred. Requires a function that sends the item without blocking and reports if it
+// was sent, and a function that sends the item and blocks until it is sent.
+func (monitor *QueueMonitor) offerInternal(trysend func() bool, send func()) {
+	// Wait for room on the queue if it is full
+	if !trysend() {
+		monitor.count(&monitor.deferred)
+		send()
+	}
+
+	// Count the item and the depth of the queue
+	monitor.count(&monitor.enqueued)
+}
+
+// A method of QueueMonitor that increments a counter and records the depth of the queue.
+func (monitor *QueueMonitor) count(counter *uint64) {
+	depth := monitor.depth()
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	*counter++
+	if depth > monitor.highwater {
+		monitor.highwater = depth
+	}
+}
+
+// A method of QueueMonitor that returns the statistics of the queue.
+func (monitor *QueueMonitor) Stats() QueueStats {
+	depth := monitor.depth()
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	return QueueStats{
+		Name:      monitor.Name,
+		Policy:    monitor.Policy.String(),
+		Capacity:  monitor.Capacity,
+		Depth:     depth,
+		HighWater: monitor.highwater,
+		Enqueued:  monitor.enqueued,
+		Dropped:   monitor.dropped,
+		Rejected:  monitor.rejected,
+		Deferred:  monitor.deferred,
+	}
+}
+
+// A method of MeshOrchestrator that sends a Log to the LogQueue according to its policy.
+// A Log rejected by the reject policy is dropped, since the senders of logs cannot act on it.
+func (meshorchestrator *MeshOrchestrator) SendLog(log Log) {
+	queue := meshorchestrator.LogQueue
+	meshorchestrator.logmonitor.offer(
+		func() bool {
+			select {
+			case queue <- log:
+				return true
+			default:
+				return false
+			}
+		},
+		func() bool {
+			select {
+			case <-queue:
+				return true
+			default:
+				return false
+			}
+		},
+		func() { queue <- log },
+	)
+}
+
+// A method of MeshOrchestrator that prints a Log and publishes it to the observers without sending it
+// to the LogQueue. Used for the logs of the work that the LogHandler does itself, which must not wait
+// on the queue that the LogHandler drains.
+func (meshorchestrator *MeshOrchestrator) emitLog(log Log) {
+	fmt.Println(FormatLog(log))
+	meshorchestrator.ObserverBroker.Publish(log)
+}
+
+// A method of MeshOrchestrator that sends a command to the CommandQueue according to its policy.
+// A command that is dropped from the queue is marked as failed. Returns whether the command was
+// sent and an ErrQueueFull if it was rejected. Must be called while holding the read lock of the queues.
+func (meshorchestrator *MeshOrchestrator) offerCommand(command map[string]string) (bool, error) {
+	queue := meshorchestrator.CommandQueue
+	return meshorchestrator.commandmonitor.offer(
+		func() bool {
+			select {
+			case queue <- command:
+				return true
+			default:
+				return false
+			}
+		},
+		func() bool {
+			select {
+			case dropped := <-queue:
+				meshorchestrator.CommandTracker.Update(dropped[CommandIDKey], CommandFailed, fmt.Errorf("dropped from the full command queue"))
+				return true
+			default:
+				return false
+			}
+		},
+		func() { queue <- command },
+	)
+}
+
+// A method of MeshOrchestrator that sends a command issued by the orchestrator itself to the CommandQueue,
+// waiting for room on the queue if it is full regardless of its policy. Must be called while holding the
+// read lock of the queues and never from the LogHandler, since the CommandHandler sends logs.
+func (meshorchestrator *MeshOrchestrator) offerInternalCommand(command map[string]string) {
+	queue := meshorchestrator.CommandQueue
+	meshorchestrator.commandmonitor.offerInternal(
+		func() bool {
+			select {
+			case queue <- command:
+				return true
+			default:
+				return false
+			}
+		},
+		func() { queue <- command },
+	)
+}
+
+// A method of MeshOrchestrator that sends a SensorPing to the AccumulatorQueue according to its
+// policy. Returns an ErrQueueFull if the ping was rejected. Must be called while holding the
+// read lock of the queues.
+func (meshorchestrator *MeshOrchestrator) offerSensorPing(sensorping SensorPing) error {
+	queue := meshorchestrator.AccumulatorQueue
+	_, err := meshorchestrator.accumulatormonitor.offer(
+		func() bool {
+			select {
+			case queue <- sensorping:
+				return true
+			default:
+				return false
+			}
+		},
+		func() bool {
+			select {
+			case <-queue:
+				return true
+			default:
+				return false
+			}
+		},
+		func() { queue <- sensorping },
+	)
+	return err
+}
+
+// A method of MeshOrchestrator that returns the statistics of its queues and its outbox, followed
+// by the statistics of the buffer of every observer in the order of their subscription.
+func (meshorchestrator *MeshOrchestrator) QueueStats() []QueueStats {
+	stats := []QueueStats{
+		meshorchestrator.logmonitor.Stats(),
+		meshorchestrator.commandmonitor.Stats(),
+		meshorchestrator.accumulatormonitor.Stats(),
+	}
+
+	// Collect the statistics of the outbox, if it is enabled
+	if meshorchestrator.Outbox != nil {
+		stats = append(stats, meshorchestrator.Outbox.Stats())
+	}
+
+	// Collect the statistics of the observers
+	return append(stats, meshorchestrator.ObserverBroker.Stats()...)
+}
@@ -0,0 +1,222 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// The default limits of the rate limiter when they are not set in the config file.
+// The rates are in calls per second and the bursts are the number of calls that can be made at once.
+const (
+	DefaultRateLimitRate        = 5.0
+	DefaultRateLimitBurst       = 10
+	DefaultRateLimitCallerRate  = 1.0
+	DefaultRateLimitCallerBurst = 5
+)
+
+// The number of caller buckets above which the idle buckets are discarded.
+const maxCallerBuckets = 1024
+
+// A struct that defines the configuration of the rate limiter of the calls that send commands to the control node.
+type RateLimitConfig struct {
+	// A bool that indicates whether the calls are rate limited
+	Enabled bool `json:"enabled"`
+
+	// The rate and burst of the calls of all callers combined
+	Rate  float64 `json:"rate,omitempty"`
+	Burst int     `json:"burst,omitempty"`
+
+	// The rate and burst of the calls of each caller
+	CallerRate  float64 `json:"callerrate,omitempty"`
+	CallerBurst int     `json:"callerburst,omitempty"`
+
+	// The names of the callers that are exempt from the limits, such as alarm integrations
+	Exempt []string `json:"exempt,omitempty"`
+}
+
+// A method of RateLimitConfig that returns the rate and burst of the calls of all callers combined.
+// Falls back to the DefaultRateLimitRate and DefaultRateLimitBurst if they are not set.
+func (config RateLimitConfig) GetGlobal() (float64, int) {
+	rate, burst := config.Rate, config.Burst
+	if rate <= 0 {
+		rate = DefaultRateLimitRate
+	}
+	if burst <= 0 {
+		burst = DefaultRateLimitBurst
+	}
+	return rate, burst
+}
+
+// A method of RateLimitConfig that returns the rate and burst of the calls of each caller.
+// Falls back to the DefaultRateLimitCallerRate and DefaultRateLimitCallerBurst if they are not set.
+func (config RateLimitConfig) GetCaller() (float64, int) {
+	rate, burst := config.CallerRate, config.CallerBurst
+	if rate <= 0 {
+		rate = DefaultRateLimitCallerRate
+	}
+	if burst <= 0 {
+		burst = DefaultRateLimitCallerBurst
+	}
+	return rate, burst
+}
+
+// A struct that defines a token bucket. The bucket holds up to its burst of tokens and is
+// refilled at its rate of tokens per second. Every allowed call takes a token from the bucket.
+type TokenBucket struct {
+	// The rate at which the bucket is refilled and the number of tokens it can hold
+	rate  float64
+	burst float64
+
+	// The number of tokens in the bucket and the time at which it was last refilled
+	tokens float64
+	last   time.Time
+}
+
+// A constructor function that generates and returns a full TokenBucket with a rate and a burst.
+func NewTokenBucket(rate float64, burst int, now time.Time) *TokenBucket {
+	return &TokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+}
+
+// A method of TokenBucket that refills the bucket for the time since it was last refilled.
+func (bucket *TokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(bucket.last).Seconds(); elapsed > 0 {
+		bucket.tokens = math.Min(bucket.burst, bucket.tokens+elapsed*bucket.rate)
+		bucket.last = now
+	}
+}
+
+// A method of TokenBucket that returns the duration until the bucket holds a token, which is zero if it already does.
+func (bucket *TokenBucket) Delay(now time.Time) time.Duration {
+	bucket.refill(now)
+	if bucket.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / bucket.rate * float64(time.Second))
+}
+
+// A method of TokenBucket that takes a token from the bucket.
+func (bucket *TokenBucket) Take(now time.Time) {
+	bucket.refill(now)
+	bucket.tokens--
+}
+
+// A method of TokenBucket that returns whether the bucket is full.
+func (bucket *TokenBucket) Full(now time.Time) bool {
+	bucket.refill(now)
+	return bucket.tokens >= bucket.burst
+}
+
+// A struct that defines a rate limiter with a token bucket for all callers combined and a token bucket for each caller.
+// A call is only allowed if both the bucket of its caller and the global bucket hold a token.
+type RateLimiter struct {
+	// A mutex that guards the buckets
+	mutex sync.Mutex
+
+	// The config of the limiter
+	config RateLimitConfig
+
+	// The bucket of all callers and the buckets of each caller
+	global  *TokenBucket
+	callers map[string]*TokenBucket
+
+	// The set of callers that are exempt from the limits
+	exempt map[string]bool
+}
+
+// A constructor function that generates and returns a RateLimiter from its config.
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	rate, burst := config.GetGlobal()
+	limiter := &RateLimiter{
+		config:  config,
+		global:  NewTokenBucket(rate, burst, time.Now()),
+		callers: make(map[string]*TokenBucket),
+		exempt:  make(map[string]bool),
+	}
+
+	for _, caller := range config.Exempt {
+		limiter.exempt[caller] = true
+	}
+	return limiter
+}
+
+// A method of RateLimiter that returns whether a caller is exempt from the limits.
+// Every caller is exempt if the limiter is not enabled.
+func (limiter *RateLimiter) Exempt(caller string) bool {
+	return !limiter.config.Enabled || limiter.exempt[caller]
+}
+
+// A method of RateLimiter that checks whether a call of a caller is allowed and takes a token from both buckets if it is.
+// Returns false and the duration after which the call can be retried if it is not allowed.
+func (limiter *RateLimiter) Allow(caller string) (bool, time.Duration) {
+	if limiter.Exempt(caller) {
+		return true, 0
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+
+	// Retrieve the bucket of the caller or create a full one
+	bucket, ok := limiter.callers[caller]
+	if !ok {
+		limiter.prune(now)
+		rate, burst := limiter.config.GetCaller()
+		bucket = NewTokenBucket(rate, burst, now)
+		limiter.callers[caller] = bucket
+	}
+
+	// Reject the call with the longer of the delays of the two buckets
+	delay := bucket.Delay(now)
+	if globaldelay := limiter.global.Delay(now); globaldelay > delay {
+		delay = globaldelay
+	}
+	if delay > 0 {
+		return false, delay
+	}
+
+	// Take a token from both buckets
+	bucket.Take(now)
+	limiter.global.Take(now)
+	return true, 0
+}
+
+// A method of RateLimiter that discards the buckets of the callers that are full, since a new
+// bucket is full as well, once there are more than maxCallerBuckets. Must be called with the mutex held.
+func (limiter *RateLimiter) prune(now time.Time) {
+	if len(limiter.callers) < maxCallerBuckets {
+		return
+	}
+	for caller, bucket := range limiter.callers {
+		if bucket.Full(now) {
+			delete(limiter.callers, caller)
+		}
+	}
+}
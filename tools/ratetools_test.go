@@ -0,0 +1,153 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"testing"
+	"time"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A test that takes tokens from a TokenBucket over time and checks the delay until it holds a token.
+func TestTokenBucket(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		rate    float64
+		burst   int
+		takes   int
+		elapsed time.Duration
+		delay   time.Duration
+		full    bool
+	}{
+		{"full bucket", 2, 3, 0, 0, 0, true},
+		{"burst left", 2, 3, 2, 0, 0, false},
+		{"burst spent", 2, 3, 3, 0, 500 * time.Millisecond, false},
+		{"partly refilled", 2, 3, 3, 250 * time.Millisecond, 250 * time.Millisecond, false},
+		{"refilled by a token", 2, 3, 3, 500 * time.Millisecond, 0, false},
+		{"refilled to the burst", 2, 3, 3, time.Hour, 0, true},
+		{"overdrawn", 1, 1, 2, 0, 2 * time.Second, false},
+	}
+
+	for _, test := range tests {
+		bucket := tools.NewTokenBucket(test.rate, test.burst, start)
+		for take := 0; take < test.takes; take++ {
+			bucket.Take(start)
+		}
+
+		now := start.Add(test.elapsed)
+		if delay := bucket.Delay(now); delay != test.delay {
+			t.Errorf("%v: expected delay %v, got %v", test.name, test.delay, delay)
+		}
+		if full := bucket.Full(now); full != test.full {
+			t.Errorf("%v: expected full %v, got %v", test.name, test.full, full)
+		}
+	}
+}
+
+// A test that checks that the RateLimiter applies the limit of each caller and the limit of all callers
+// combined, and that the exempt callers and every caller of a disabled limiter are never limited.
+func TestRateLimiterAllow(t *testing.T) {
+	// Use rates that do not refill a token during the test
+	config := tools.RateLimitConfig{Enabled: true, Rate: 0.001, Burst: 5, CallerRate: 0.001, CallerBurst: 2, Exempt: []string{"alarm"}}
+
+	tests := []struct {
+		name    string
+		config  tools.RateLimitConfig
+		callers []string
+		allowed []bool
+	}{
+		{
+			name:    "caller burst",
+			config:  config,
+			callers: []string{"a", "a", "a"},
+			allowed: []bool{true, true, false},
+		},
+		{
+			name:    "separate callers",
+			config:  config,
+			callers: []string{"a", "a", "b", "b", "a", "b"},
+			allowed: []bool{true, true, true, true, false, false},
+		},
+		{
+			name:    "global burst",
+			config:  config,
+			callers: []string{"a", "b", "c", "d", "e", "f"},
+			allowed: []bool{true, true, true, true, true, false},
+		},
+		{
+			name:    "exempt caller",
+			config:  config,
+			callers: []string{"alarm", "alarm", "alarm", "alarm", "alarm", "alarm", "a"},
+			allowed: []bool{true, true, true, true, true, true, true},
+		},
+		{
+			name:    "disabled limiter",
+			config:  tools.RateLimitConfig{Rate: 0.001, Burst: 1, CallerRate: 0.001, CallerBurst: 1},
+			callers: []string{"a", "a", "a"},
+			allowed: []bool{true, true, true},
+		},
+	}
+
+	for _, test := range tests {
+		limiter := tools.NewRateLimiter(test.config)
+		for index, caller := range test.callers {
+			allowed, delay := limiter.Allow(caller)
+			if allowed != test.allowed[index] {
+				t.Errorf("%v: call %v of %v: expected allowed %v, got %v", test.name, index, caller, test.allowed[index], allowed)
+			}
+			if allowed && delay != 0 {
+				t.Errorf("%v: call %v of %v: expected no delay for an allowed call, got %v", test.name, index, caller, delay)
+			}
+			if !allowed && delay <= 0 {
+				t.Errorf("%v: call %v of %v: expected a retry delay for a rejected call, got %v", test.name, index, caller, delay)
+			}
+		}
+	}
+}
+
+// A test that checks that the limits fall back to their defaults when they are not set in the config.
+func TestRateLimitConfigDefaults(t *testing.T) {
+	config := tools.RateLimitConfig{}
+	if rate, burst := config.GetGlobal(); rate != tools.DefaultRateLimitRate || burst != tools.DefaultRateLimitBurst {
+		t.Errorf("expected the default global limit, got %v/%v", rate, burst)
+	}
+	if rate, burst := config.GetCaller(); rate != tools.DefaultRateLimitCallerRate || burst != tools.DefaultRateLimitCallerBurst {
+		t.Errorf("expected the default caller limit, got %v/%v", rate, burst)
+	}
+
+	config = tools.RateLimitConfig{Rate: 2, Burst: 4, CallerRate: 0.5, CallerBurst: 1}
+	if rate, burst := config.GetGlobal(); rate != 2 || burst != 4 {
+		t.Errorf("expected the configured global limit, got %v/%v", rate, burst)
+	}
+	if rate, burst := config.GetCaller(); rate != 0.5 || burst != 1 {
+		t.Errorf("expected the configured caller limit, got %v/%v", rate, burst)
+	}
+}
@@ -0,0 +1,253 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The format and version written in the header of every recording.
+const (
+	RecordingFormat  = "fyrmesh-recording"
+	RecordingVersion = 1
+)
+
+// The number of logs buffered for each recorder of the LINK read stream.
+// Recordings are meant to be complete, so the buffer is larger than that of an observer.
+const DefaultRecorderBufferSize = 4096
+
+// A struct that defines a log received from the LINK server along with the time it arrived.
+type RecordedLog struct {
+	Log
+	// The time at which the log was received from the LINK server
+	Arrival time.Time
+}
+
+// A constructor function that generates and returns a RecordedLog for a log that arrived at the given time.
+func NewRecordedLog(log Log, arrival time.Time) *RecordedLog {
+	return &RecordedLog{Log: log, Arrival: arrival}
+}
+
+// A struct that defines the header of a recording, written as its first line.
+type RecordingHeader struct {
+	Format       string `json:"format"`
+	Version      int    `json:"version"`
+	Started      string `json:"started"`
+	ControllerID string `json:"controller,omitempty"`
+}
+
+// A struct that defines a log in a recording. The keys are short to keep the recording compact.
+type recordingEntry struct {
+	// The number of microseconds since the recording started at which the log arrived
+	Offset   int64             `json:"t"`
+	Source   string            `json:"s"`
+	Type     string            `json:"y"`
+	Time     string            `json:"lt"`
+	Message  string            `json:"m"`
+	Metadata map[string]string `json:"md,omitempty"`
+}
+
+// A struct that defines a writer of recordings. A recording is a gzip compressed
+// stream of JSON lines, with a RecordingHeader followed by one line for every log.
+type RecordingWriter struct {
+	gzipwriter *gzip.Writer
+	encoder    *json.Encoder
+	started    time.Time
+	count      int
+}
+
+// A constructor function that generates and returns a RecordingWriter that writes to a writer.
+// Requires the time at which the recording started and the ID of the controller that is recorded.
+func NewRecordingWriter(writer io.Writer, started time.Time, controllerid string) (*RecordingWriter, error) {
+	// Create the compressed writer and its encoder
+	gzipwriter := gzip.NewWriter(writer)
+	recordingwriter := RecordingWriter{gzipwriter: gzipwriter, encoder: json.NewEncoder(gzipwriter), started: started}
+
+	// Write the header of the recording
+	header := RecordingHeader{Format: RecordingFormat, Version: RecordingVersion, Started: started.UTC().Format(time.RFC3339Nano), ControllerID: controllerid}
+	if err := recordingwriter.encoder.Encode(header); err != nil {
+		return nil, fmt.Errorf("could not write recording header - %v", err)
+	}
+
+	return &recordingwriter, nil
+}
+
+// A method of RecordingWriter that writes a RecordedLog to the recording.
+func (recordingwriter *RecordingWriter) Write(log *RecordedLog) error {
+	entry := recordingEntry{
+		Offset:   log.Arrival.Sub(recordingwriter.started).Microseconds(),
+		Source:   log.GetLogsource(),
+		Type:     log.GetLogtype(),
+		Time:     log.GetLogtime(),
+		Message:  log.GetLogmessage(),
+		Metadata: log.GetLogmetadata(),
+	}
+	if err := recordingwriter.encoder.Encode(entry); err != nil {
+		return fmt.Errorf("could not write log to recording - %v", err)
+	}
+
+	recordingwriter.count++
+	return nil
+}
+
+// A method of RecordingWriter that returns the number of logs written to the recording.
+func (recordingwriter *RecordingWriter) Count() int {
+	return recordingwriter.count
+}
+
+// A method of RecordingWriter that flushes and completes the recording.
+// Does not close the underlying writer.
+func (recordingwriter *RecordingWriter) Close() error {
+	return recordingwriter.gzipwriter.Close()
+}
+
+// A struct that defines a reader of the recordings written by a RecordingWriter.
+type RecordingReader struct {
+	decoder *json.Decoder
+	header  RecordingHeader
+	started time.Time
+}
+
+// A constructor function that generates and returns a RecordingReader that reads from a reader.
+// Returns an error if the reader does not hold a recording of a supported version.
+func NewRecordingReader(reader io.Reader) (*RecordingReader, error) {
+	// Create the decompressing reader
+	gzipreader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("file is not a recording - %v", err)
+	}
+	recordingreader := RecordingReader{decoder: json.NewDecoder(gzipreader)}
+
+	// Read and check the header of the recording
+	if err := recordingreader.decoder.Decode(&recordingreader.header); err != nil {
+		return nil, fmt.Errorf("could not read recording header - %v", err)
+	}
+	if recordingreader.header.Format != RecordingFormat {
+		return nil, fmt.Errorf("file is not a recording")
+	}
+	if recordingreader.header.Version != RecordingVersion {
+		return nil, fmt.Errorf("unsupported recording version '%v'", recordingreader.header.Version)
+	}
+	if recordingreader.started, err = time.Parse(time.RFC3339Nano, recordingreader.header.Started); err != nil {
+		return nil, fmt.Errorf("invalid recording start time - %v", err)
+	}
+
+	return &recordingreader, nil
+}
+
+// A method of RecordingReader that returns the header of the recording.
+func (recordingreader *RecordingReader) Header() RecordingHeader {
+	return recordingreader.header
+}
+
+// A method of RecordingReader that reads and returns the next log of the recording.
+// Returns io.EOF once every log has been read.
+func (recordingreader *RecordingReader) Next() (*RecordedLog, error) {
+	var entry recordingEntry
+	if err := recordingreader.decoder.Decode(&entry); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("could not read log from recording - %v", err)
+	}
+
+	// Reconstruct the log with its arrival time
+	log := OrchLog{Logsource: entry.Source, Logtype: entry.Type, Logtime: entry.Time, Logmessage: entry.Message, Logmetadata: entry.Metadata}
+	if log.Logmetadata == nil {
+		log.Logmetadata = make(map[string]string)
+	}
+	arrival := recordingreader.started.Add(time.Duration(entry.Offset) * time.Microsecond)
+	return NewRecordedLog(&log, arrival), nil
+}
+
+// A struct that defines the pace at which a recording is replayed.
+type ReplayOptions struct {
+	// The factor by which the original pace is accelerated. The original pace is 1 and
+	// a factor of 0 replays the logs as fast as they can be accepted.
+	Speed float64
+
+	// A function called before every log when replaying stepwise, which returns whether to
+	// continue the replay. The Speed is ignored if it is set.
+	Step func(log *RecordedLog) bool
+}
+
+// A function that replays the logs of a recording to a sink at the pace of the ReplayOptions.
+// Stops when the recording ends, the context is done, the step function returns false or the
+// sink returns an error. Returns the number of logs replayed.
+func Replay(ctx context.Context, reader *RecordingReader, options ReplayOptions, sink func(Log) error) (int, error) {
+	// The arrival of the first log and the time it was replayed, which the pace is kept against
+	var firstarrival, replaystart time.Time
+	replayed := 0
+
+	for {
+		// Read the next log
+		log, err := reader.Next()
+		if err == io.EOF {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, err
+		}
+
+		if options.Step != nil {
+			// Wait for the step to continue
+			if !options.Step(log) {
+				return replayed, nil
+			}
+		} else if options.Speed > 0 {
+			// Wait until the log is due at the accelerated pace
+			if replaystart.IsZero() {
+				firstarrival, replaystart = log.Arrival, time.Now()
+			}
+			due := replaystart.Add(time.Duration(float64(log.Arrival.Sub(firstarrival)) / options.Speed))
+			if wait := time.Until(due); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return replayed, ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+		}
+
+		// Stop if the context is done
+		if ctx.Err() != nil {
+			return replayed, ctx.Err()
+		}
+
+		// Send the log to the sink
+		if err := sink(log.Log); err != nil {
+			return replayed, err
+		}
+		replayed++
+	}
+}
@@ -0,0 +1,593 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The name of the file beside the config file that holds the records of the node registry.
+const RegistryFile = "registry.json"
+
+// The columns of a registry CSV file that map to the fields of a NodeRecord.
+// Any other column of the file is imported as a label named after the column.
+const (
+	RegistryColumnNode      = "node"
+	RegistryColumnName      = "name"
+	RegistryColumnZone      = "zone"
+	RegistryColumnLatitude  = "latitude"
+	RegistryColumnLongitude = "longitude"
+	RegistryColumnLabels    = "labels"
+)
+
+// A struct that defines the GPS coordinates of a node in decimal degrees.
+type NodeLocation struct {
+	Latitude  float64 `json:"latitude" firestore:"latitude"`
+	Longitude float64 `json:"longitude" firestore:"longitude"`
+}
+
+// A method of NodeLocation that returns an error if the coordinates are out of range.
+func (location NodeLocation) Validate() error {
+	// The coordinates are checked to be within range rather than out of it to also reject NaN
+	if !(location.Latitude >= -90 && location.Latitude <= 90) {
+		return fmt.Errorf("invalid latitude '%v' - must be between -90 and 90", location.Latitude)
+	}
+	if !(location.Longitude >= -180 && location.Longitude <= 180) {
+		return fmt.Errorf("invalid longitude '%v' - must be between -180 and 180", location.Longitude)
+	}
+	return nil
+}
+
+// A struct that defines the record of a node in the node registry, which
+// describes where a node is placed with a human name, labels, a location and a zone.
+type NodeRecord struct {
+	// The identifier of the node
+	NodeID int64 `json:"node" firestore:"nodeID"`
+	// The human name of the node
+	Name string `json:"name,omitempty" firestore:"name"`
+	// The free-form labels of the node
+	Labels map[string]string `json:"labels,omitempty" firestore:"labels"`
+	// The GPS coordinates of the node. Nil if the location of the node is not known.
+	Location *NodeLocation `json:"location,omitempty" firestore:"location"`
+	// The zone of the node
+	Zone string `json:"zone,omitempty" firestore:"zone"`
+	// The time at which the record was last changed
+	Updated time.Time `json:"updated" firestore:"updated"`
+}
+
+// A method of NodeRecord that returns a copy of the record that shares no maps or pointers with it.
+func (record NodeRecord) Copy() NodeRecord {
+	if record.Labels != nil {
+		labels := make(map[string]string, len(record.Labels))
+		for key, value := range record.Labels {
+			labels[key] = value
+		}
+		record.Labels = labels
+	}
+	if record.Location != nil {
+		location := *record.Location
+		record.Location = &location
+	}
+	return record
+}
+
+// A method of NodeRecord that returns the name of the node, or its node ID if it has no name.
+func (record NodeRecord) DisplayName() string {
+	if record.Name != "" {
+		return record.Name
+	}
+	return strconv.FormatInt(record.NodeID, 10)
+}
+
+// A method of NodeRecord that returns an error if the node ID, the labels or the location of the record are invalid.
+func (record NodeRecord) Validate() error {
+	if record.NodeID <= 0 {
+		return fmt.Errorf("invalid node ID '%v'", record.NodeID)
+	}
+	for key := range record.Labels {
+		if err := checkLabelKey(key); err != nil {
+			return err
+		}
+	}
+	if record.Location != nil {
+		return record.Location.Validate()
+	}
+	return nil
+}
+
+// A function that returns an error if a label key is empty or contains the characters that
+// separate the labels and their values in the 'labels' column of a registry CSV file.
+func checkLabelKey(key string) error {
+	if strings.TrimSpace(key) == "" {
+		return fmt.Errorf("invalid label - the key must not be empty")
+	}
+	if strings.ContainsAny(key, "=;") {
+		return fmt.Errorf("invalid label '%v' - the key must not contain '=' or ';'", key)
+	}
+	return nil
+}
+
+// A struct that defines a change to the record of a node in the node registry.
+// Fields that are nil are left unchanged, while the labels are merged into the labels of the record.
+type NodeRecordUpdate struct {
+	// The identifier of the node
+	NodeID int64
+	// The new name of the node, if it is set
+	Name *string
+	// The new zone of the node, if it is set
+	Zone *string
+	// The new location of the node, if it is set
+	Location *NodeLocation
+	// The bool indicating if the location of the node is to be removed
+	ClearLocation bool
+	// The labels to add to the node or to replace the values of
+	Labels map[string]string
+	// The keys of the labels to remove from the node
+	RemoveLabels []string
+}
+
+// A method of NodeRecordUpdate that returns an error if the node ID, the location or the labels of the update are invalid.
+func (update NodeRecordUpdate) Validate() error {
+	if update.NodeID <= 0 {
+		return fmt.Errorf("invalid node ID '%v'", update.NodeID)
+	}
+	if update.Location != nil {
+		if err := update.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	for key := range update.Labels {
+		if err := checkLabelKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A function that returns an error if any of a slice of records to import is invalid or if they repeat a node.
+func ValidateImport(records []NodeRecord) error {
+	seen := make(map[int64]bool)
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if seen[record.NodeID] {
+			return fmt.Errorf("node '%v' is repeated in the imported records", record.NodeID)
+		}
+		seen[record.NodeID] = true
+	}
+	return nil
+}
+
+// A struct that defines the disk-backed registry of the records of the nodes that are known by their
+// node ID on the mesh. The records are persisted to the RegistryFile on every change, so that they are
+// kept across restarts of the orchestrator and do not depend on the nodes being on the mesh.
+type NodeRegistry struct {
+	// A mutex that guards the records
+	mutex sync.RWMutex
+
+	// The path of the file that the records are persisted to
+	filepath string
+
+	// A map of the node IDs to their records
+	records map[int64]NodeRecord
+}
+
+// A constructor function that generates and returns a NodeRegistry.
+// The records persisted in the RegistryFile beside the config file are loaded into the registry.
+func NewNodeRegistry() (*NodeRegistry, error) {
+	// Read the 'FYRMESHCONFIG' env var
+	filedir := os.Getenv("FYRMESHCONFIG")
+	if filedir == "" {
+		return nil, fmt.Errorf("environment variable 'FYRMESHCONFIG' has not been set")
+	}
+
+	// Create the registry
+	registry := NodeRegistry{filepath: filepath.Join(filedir, RegistryFile), records: make(map[int64]NodeRecord)}
+
+	// Load the persisted records, if any
+	data, err := ioutil.ReadFile(registry.filepath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not read registry file - %v", err)
+	}
+	if len(data) > 0 {
+		var records []NodeRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("could not parse registry file - %v", err)
+		}
+		for _, record := range records {
+			if err := record.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid record in registry file - %v", err)
+			}
+			registry.records[record.NodeID] = record
+		}
+	}
+
+	// Return the registry
+	return &registry, nil
+}
+
+// A method of NodeRegistry that writes a map of records to the RegistryFile, sorted by their node ID.
+// Must be called with the mutex held. The records of the registry are only replaced once they are written.
+func (registry *NodeRegistry) save(records map[int64]NodeRecord) error {
+	// Serialize the records
+	data, err := json.MarshalIndent(sortRecords(records), "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize registry - %v", err)
+	}
+
+	// Durably replace the registry file with the records
+	if err := writeFileSynced(registry.filepath, data, 0600); err != nil {
+		return fmt.Errorf("could not write registry file - %v", err)
+	}
+
+	return nil
+}
+
+// A method of NodeRegistry that returns a copy of the records of the registry. Must be called with the mutex held.
+func (registry *NodeRegistry) copyRecords() map[int64]NodeRecord {
+	records := make(map[int64]NodeRecord, len(registry.records))
+	for nodeid, record := range registry.records {
+		records[nodeid] = record
+	}
+	return records
+}
+
+// A method of NodeRegistry that returns the record of a node and a bool indicating if the node has a record.
+func (registry *NodeRegistry) Get(nodeid int64) (NodeRecord, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	record, ok := registry.records[nodeid]
+	return record.Copy(), ok
+}
+
+// A method of NodeRegistry that returns the records of the nodes in a zone sorted
+// by their node ID, or the records of every node if the zone is an empty string.
+func (registry *NodeRegistry) List(zone string) []NodeRecord {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	records := make([]NodeRecord, 0, len(registry.records))
+	for _, record := range sortRecords(registry.records) {
+		if zone == "" || record.Zone == zone {
+			records = append(records, record.Copy())
+		}
+	}
+	return records
+}
+
+// A method of NodeRegistry that applies a NodeRecordUpdate to the record of a node at the
+// given time and persists it. The record is created if the node does not have one yet.
+// Returns the updated record or an error if the update is invalid or could not be persisted.
+func (registry *NodeRegistry) Set(update NodeRecordUpdate, now time.Time) (NodeRecord, error) {
+	// Check the update
+	if err := update.Validate(); err != nil {
+		return NodeRecord{}, err
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Retrieve a copy of the record of the node or create it
+	record, ok := registry.records[update.NodeID]
+	if !ok {
+		record = NodeRecord{NodeID: update.NodeID}
+	}
+	record = record.Copy()
+
+	// Apply the name, zone and location of the update
+	if update.Name != nil {
+		record.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Zone != nil {
+		record.Zone = strings.TrimSpace(*update.Zone)
+	}
+	if update.ClearLocation {
+		record.Location = nil
+	}
+	if update.Location != nil {
+		location := *update.Location
+		record.Location = &location
+	}
+
+	// Apply the labels of the update
+	for _, key := range update.RemoveLabels {
+		delete(record.Labels, key)
+	}
+	for key, value := range update.Labels {
+		if record.Labels == nil {
+			record.Labels = make(map[string]string)
+		}
+		record.Labels[key] = value
+	}
+	if len(record.Labels) == 0 {
+		record.Labels = nil
+	}
+
+	record.Updated = now
+
+	// Persist the updated record and replace the records
+	records := registry.copyRecords()
+	records[record.NodeID] = record
+	if err := registry.save(records); err != nil {
+		return NodeRecord{}, err
+	}
+	registry.records = records
+
+	return record.Copy(), nil
+}
+
+// A method of NodeRegistry that removes the record of a node and persists the registry.
+// Returns the removed record or an error if the node does not have a record.
+func (registry *NodeRegistry) Delete(nodeid int64) (NodeRecord, error) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Check if the node has a record
+	record, ok := registry.records[nodeid]
+	if !ok {
+		return NodeRecord{}, fmt.Errorf("node '%v' is not in the registry", nodeid)
+	}
+
+	// Persist the registry without the record and replace the records
+	records := registry.copyRecords()
+	delete(records, nodeid)
+	if err := registry.save(records); err != nil {
+		return NodeRecord{}, err
+	}
+	registry.records = records
+
+	return record, nil
+}
+
+// A method of NodeRegistry that imports a slice of records at the given time and persists them.
+// The imported records replace the records of the same nodes, while the records of other nodes are
+// kept unless replace is set. No record is imported if any of them is invalid or they repeat a node.
+// Returns the number of imported records.
+func (registry *NodeRegistry) Import(imported []NodeRecord, replace bool, now time.Time) (int, error) {
+	// Check the imported records
+	if err := ValidateImport(imported); err != nil {
+		return 0, err
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// Start from an empty registry or a copy of the records
+	records := make(map[int64]NodeRecord)
+	if !replace {
+		records = registry.copyRecords()
+	}
+
+	// Add the imported records
+	for _, record := range imported {
+		record = record.Copy()
+		record.Updated = now
+		records[record.NodeID] = record
+	}
+
+	// Persist the imported records and replace the records
+	if err := registry.save(records); err != nil {
+		return 0, err
+	}
+	registry.records = records
+
+	return len(imported), nil
+}
+
+// A function that returns the records of a map of records sorted by their node ID.
+func sortRecords(records map[int64]NodeRecord) []NodeRecord {
+	sorted := make([]NodeRecord, 0, len(records))
+	for _, record := range records {
+		sorted = append(sorted, record)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].NodeID < sorted[j].NodeID })
+	return sorted
+}
+
+// A function that parses and returns the records of a registry CSV file. The first row of the file must be
+// a header that names its columns, among which the 'node' column is required. The 'name', 'zone', 'latitude'
+// and 'longitude' columns map to the fields of the record and the 'labels' column holds the labels of the
+// node as 'key=value' pairs separated by ';'. Any other column is imported as a label named after the column
+// in lowercase. Empty values are ignored and lines that start with '#' are treated as comments.
+func ParseRegistryCSV(reader io.Reader) ([]NodeRecord, error) {
+	// Create the CSV reader
+	csvreader := csv.NewReader(reader)
+	csvreader.Comment = '#'
+	csvreader.TrimLeadingSpace = true
+
+	// Read the header of the file and check for the node column
+	header, err := csvreader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("registry CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read registry CSV header - %v", err)
+	}
+	columns := make(map[string]int)
+	for index, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("column '%v' is repeated in the registry CSV header", column)
+		}
+		columns[column] = index
+		header[index] = column
+	}
+	if _, ok := columns[RegistryColumnNode]; !ok {
+		return nil, fmt.Errorf("registry CSV header does not have a '%v' column", RegistryColumnNode)
+	}
+
+	// Read and parse the rows of the file
+	records := make([]NodeRecord, 0)
+	for rownumber := 1; ; rownumber++ {
+		row, err := csvreader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read registry CSV file - %v", err)
+		}
+
+		record, err := parseRegistryRow(header, row)
+		if err != nil {
+			return nil, fmt.Errorf("invalid registry CSV row %v - %v", rownumber, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// A function that parses and returns the NodeRecord of a row of a registry CSV file given the columns of its header.
+func parseRegistryRow(header []string, row []string) (NodeRecord, error) {
+	record := NodeRecord{}
+	var latitude, longitude string
+
+	// Iterate over the values of the row and assign them by their column
+	for index, value := range row {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		switch column := header[index]; column {
+		case RegistryColumnNode:
+			nodeid, err := strconv.ParseInt(value, 0, 64)
+			if err != nil {
+				return NodeRecord{}, fmt.Errorf("invalid node ID '%v'", value)
+			}
+			record.NodeID = nodeid
+
+		case RegistryColumnName:
+			record.Name = value
+
+		case RegistryColumnZone:
+			record.Zone = value
+
+		case RegistryColumnLatitude:
+			latitude = value
+
+		case RegistryColumnLongitude:
+			longitude = value
+
+		case RegistryColumnLabels:
+			labels, err := ParseLabels(strings.Split(value, ";"))
+			if err != nil {
+				return NodeRecord{}, err
+			}
+			for key, value := range labels {
+				record.Labels = setLabel(record.Labels, key, value)
+			}
+
+		default:
+			record.Labels = setLabel(record.Labels, column, value)
+		}
+	}
+
+	// Parse the location of the node, which requires both coordinates
+	if latitude != "" || longitude != "" {
+		location, err := ParseLocation(latitude, longitude)
+		if err != nil {
+			return NodeRecord{}, err
+		}
+		record.Location = &location
+	}
+
+	// Check and return the record
+	if err := record.Validate(); err != nil {
+		return NodeRecord{}, err
+	}
+	return record, nil
+}
+
+// A function that sets a label on a map of labels, creating the map if it is nil, and returns the map.
+func setLabel(labels map[string]string, key, value string) map[string]string {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[key] = value
+	return labels
+}
+
+// A function that parses and returns a map of labels from a slice of 'key=value' pairs. Empty pairs are ignored.
+func ParseLabels(pairs []string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range pairs {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		// Split the pair into its key and value
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid label '%v' - must be of the form 'key=value'", pair)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if err := checkLabelKey(key); err != nil {
+			return nil, err
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// A function that parses and returns a NodeLocation from its latitude and longitude in decimal degrees.
+func ParseLocation(latitude, longitude string) (NodeLocation, error) {
+	if latitude == "" || longitude == "" {
+		return NodeLocation{}, fmt.Errorf("invalid location - both the latitude and the longitude must be set")
+	}
+
+	// Parse the coordinates
+	lat, err := strconv.ParseFloat(latitude, 64)
+	if err != nil {
+		return NodeLocation{}, fmt.Errorf("invalid latitude '%v'", latitude)
+	}
+	lon, err := strconv.ParseFloat(longitude, 64)
+	if err != nil {
+		return NodeLocation{}, fmt.Errorf("invalid longitude '%v'", longitude)
+	}
+
+	// Check and return the location
+	location := NodeLocation{Latitude: lat, Longitude: lon}
+	if err := location.Validate(); err != nil {
+		return NodeLocation{}, err
+	}
+	return location, nil
+}
@@ -0,0 +1,181 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A function that returns an empty NodeRegistry persisted in a temporary config directory.
+func newTestRegistry(t *testing.T) *tools.NodeRegistry {
+	setTestConfigDir(t, t.TempDir())
+	registry, err := tools.NewNodeRegistry()
+	if err != nil {
+		t.Fatalf("could not create node registry - %v", err)
+	}
+	return registry
+}
+
+// A test that parses registry CSV files and checks the records or the error they produce.
+func TestParseRegistryCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		records []tools.NodeRecord
+		err     string
+	}{
+		{
+			name: "every column",
+			data: "node,name,zone,latitude,longitude,labels\n" +
+				"2000000001,kitchen,ground,12.97,77.59,floor=1;wing=east\n",
+			records: []tools.NodeRecord{{
+				NodeID:   2000000001,
+				Name:     "kitchen",
+				Zone:     "ground",
+				Location: &tools.NodeLocation{Latitude: 12.97, Longitude: 77.59},
+				Labels:   map[string]string{"floor": "1", "wing": "east"},
+			}},
+		},
+		{
+			name: "extra columns, comments and empty values",
+			data: "# exported registry\n" +
+				"Node, Name, Owner\n" +
+				"1, , facilities\n" +
+				"# retired node\n" +
+				"2,lobby,\n",
+			records: []tools.NodeRecord{
+				{NodeID: 1, Labels: map[string]string{"owner": "facilities"}},
+				{NodeID: 2, Name: "lobby"},
+			},
+		},
+		{
+			name:    "header only",
+			data:    "node,name\n",
+			records: []tools.NodeRecord{},
+		},
+		{name: "empty file", data: "", err: "empty"},
+		{name: "no node column", data: "name,zone\nkitchen,ground\n", err: "does not have a 'node' column"},
+		{name: "repeated column", data: "node,name,Name\n1,a,b\n", err: "is repeated"},
+		{name: "invalid node", data: "node\nkitchen\n", err: "row 1 - invalid node ID"},
+		{name: "missing node", data: "node,name\n1,a\n,b\n", err: "row 2 - invalid node ID"},
+		{name: "half a location", data: "node,latitude\n1,12.97\n", err: "both the latitude and the longitude"},
+		{name: "latitude out of range", data: "node,latitude,longitude\n1,91,0\n", err: "invalid latitude"},
+		{name: "malformed label", data: "node,labels\n1,floor\n", err: "must be of the form 'key=value'"},
+		{name: "ragged row", data: "node,name\n1,a,b\n", err: "could not read registry CSV file"},
+	}
+
+	for _, test := range tests {
+		records, err := tools.ParseRegistryCSV(strings.NewReader(test.data))
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%v: expected an error containing %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error - %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(records, test.records) {
+			t.Errorf("%v: expected records %+v, got %+v", test.name, test.records, records)
+		}
+	}
+}
+
+// A test that imports records into a registry with and without replacing its records
+// and checks that the records are persisted and that an invalid import changes nothing.
+func TestNodeRegistryImport(t *testing.T) {
+	registry := newTestRegistry(t)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := registry.Import([]tools.NodeRecord{{NodeID: 1, Name: "kitchen"}, {NodeID: 2, Name: "lobby"}}, false, now); err != nil {
+		t.Fatalf("could not import records - %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		imported []tools.NodeRecord
+		replace  bool
+		names    map[int64]string
+		valid    bool
+	}{
+		{
+			name:     "merge",
+			imported: []tools.NodeRecord{{NodeID: 2, Name: "hall"}, {NodeID: 3, Name: "garage"}},
+			names:    map[int64]string{1: "kitchen", 2: "hall", 3: "garage"},
+			valid:    true,
+		},
+		{
+			name:     "repeated node",
+			imported: []tools.NodeRecord{{NodeID: 4}, {NodeID: 4}},
+			names:    map[int64]string{1: "kitchen", 2: "hall", 3: "garage"},
+		},
+		{
+			name:     "invalid record",
+			imported: []tools.NodeRecord{{NodeID: 4}, {NodeID: 5, Location: &tools.NodeLocation{Latitude: 100}}},
+			names:    map[int64]string{1: "kitchen", 2: "hall", 3: "garage"},
+		},
+		{
+			name:     "replace",
+			imported: []tools.NodeRecord{{NodeID: 3, Name: "shed"}},
+			replace:  true,
+			names:    map[int64]string{3: "shed"},
+			valid:    true,
+		},
+	}
+
+	for _, test := range tests {
+		count, err := registry.Import(test.imported, test.replace, now)
+		if (err == nil) != test.valid {
+			t.Errorf("%v: expected valid %v, got error %v", test.name, test.valid, err)
+		}
+		if test.valid && count != len(test.imported) {
+			t.Errorf("%v: expected %v imported records, got %v", test.name, len(test.imported), count)
+		}
+
+		// Check the records of the registry and the records persisted to its file
+		reopened, err := tools.NewNodeRegistry()
+		if err != nil {
+			t.Fatalf("%v: could not reopen node registry - %v", test.name, err)
+		}
+		for _, current := range []*tools.NodeRegistry{registry, reopened} {
+			names := make(map[int64]string)
+			for _, record := range current.List("") {
+				names[record.NodeID] = record.Name
+			}
+			if !reflect.DeepEqual(names, test.names) {
+				t.Errorf("%v: expected records %v, got %v", test.name, test.names, names)
+			}
+		}
+	}
+}
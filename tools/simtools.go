@@ -28,6 +28,7 @@ FyrMesh gopkg tools
 package tools
 
 import (
+	"context"
 	"math"
 	"math/rand"
 	"strconv"
@@ -50,6 +51,23 @@ type SimulatorSeed struct {
 	Cursor float64
 	// A string that represents the kind of seed curve to follow
 	Curve string
+
+	// A mutex that guards the Cursor while the seed curve moves it
+	mutex sync.RWMutex
+}
+
+// A method of SimulatorSeed that returns the current value of the Cursor.
+func (seed *SimulatorSeed) GetCursor() float64 {
+	seed.mutex.RLock()
+	defer seed.mutex.RUnlock()
+	return seed.Cursor
+}
+
+// A method of SimulatorSeed that sets the value of the Cursor.
+func (seed *SimulatorSeed) setCursor(cursor float64) {
+	seed.mutex.Lock()
+	defer seed.mutex.Unlock()
+	seed.Cursor = cursor
 }
 
 // A constructor function that generates and returns a SimulatorSeed object.
@@ -71,68 +89,95 @@ func NewSimulatorSeed(initial, peak, adjust, width float64, curve string) *Simul
 	return &seed
 }
 
+// A function that pauses for a given duration. Returns
+// false if the context is done before the pause ends.
+func pause(ctx context.Context, duration time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
+
 // A method of SimulatorSeed that serves as the seed curve while rising.
 // Increments the Cursor by Adjust until it exceeds the peak.
 // However if the reverse bool is set, the opposite occurs.
-func (seed *SimulatorSeed) rising(reverse bool) {
+// Returns false if the context is done before the curve ends.
+func (seed *SimulatorSeed) rising(ctx context.Context, reverse bool) bool {
 	for {
 		// Check the cursor state
-		if seed.Cursor > seed.Peak && !reverse {
+		cursor := seed.GetCursor()
+		if cursor > seed.Peak && !reverse {
 			break
-		} else if seed.Cursor < seed.Peak && reverse {
+		} else if cursor < seed.Peak && reverse {
 			break
 		}
 
 		// Increment the cursor
-		seed.Cursor = seed.Cursor + seed.Adjust
-		time.Sleep(time.Second * 5)
+		seed.setCursor(cursor + seed.Adjust)
+		if !pause(ctx, time.Second*5) {
+			return false
+		}
 	}
+
+	return true
 }
 
 // A method of SimulatorSeed that serves as the seed curve while falling.
 // Decrements the Cursor by Adjust until it goes below the initial.
 // However if the reverse bool is set, the opposite occurs.
-func (seed *SimulatorSeed) falling(reverse bool) {
+// Returns false if the context is done before the curve ends.
+func (seed *SimulatorSeed) falling(ctx context.Context, reverse bool) bool {
 	for {
 		// Check the cursor state
-		if seed.Cursor < seed.Initial && !reverse {
+		cursor := seed.GetCursor()
+		if cursor < seed.Initial && !reverse {
 			break
-		} else if seed.Cursor > seed.Initial && reverse {
+		} else if cursor > seed.Initial && reverse {
 			break
 		}
 
 		// Decrement the cursor
-		seed.Cursor = seed.Cursor - seed.Adjust
-		time.Sleep(time.Second * 5)
+		seed.setCursor(cursor - seed.Adjust)
+		if !pause(ctx, time.Second*5) {
+			return false
+		}
 	}
+
+	return true
 }
 
 // A method of SimulatorSeed that serves as a flip curve.
 // Simply flips the Cursor from 1 to 0 after the Adjust*5 amount of seconds
 // and then flips it back after the same amount of time.
-func (seed *SimulatorSeed) flip() {
-	time.Sleep(time.Second * time.Duration(seed.Adjust*5))
-	seed.Cursor = 1
-	time.Sleep(time.Second * time.Duration(seed.Adjust*5))
-	seed.Cursor = 0
+// The Cursor is flipped back early if the context is done.
+func (seed *SimulatorSeed) flip(ctx context.Context) {
+	if !pause(ctx, time.Second*time.Duration(seed.Adjust*5)) {
+		return
+	}
+	seed.setCursor(1)
+	pause(ctx, time.Second*time.Duration(seed.Adjust*5))
+	seed.setCursor(0)
 }
 
 // A method of SimulatorSeed that starts the curve of the seed.
-func (seed *SimulatorSeed) StartCurve(wg *sync.WaitGroup) {
+// The curve ends early if the context is done.
+func (seed *SimulatorSeed) StartCurve(ctx context.Context, wg *sync.WaitGroup) {
 	// Check the type of curve to start
 	switch seed.Curve {
 	case "bell":
-		seed.rising(false)
-		time.Sleep(time.Second * 10)
-		seed.falling(false)
+		if seed.rising(ctx, false) && pause(ctx, time.Second*10) {
+			seed.falling(ctx, false)
+		}
 
 	case "revbell":
-		seed.rising(true)
-		time.Sleep(time.Second * 10)
-		seed.falling(true)
+		if seed.rising(ctx, true) && pause(ctx, time.Second*10) {
+			seed.falling(ctx, true)
+		}
 
 	case "flip":
-		seed.flip()
+		seed.flip(ctx)
 	}
 
 	// Decrement the waitgroup.
@@ -141,10 +186,18 @@ func (seed *SimulatorSeed) StartCurve(wg *sync.WaitGroup) {
 
 // A struct that represents a Fire Event Simulator
 type FireEventSimulator struct {
-	// A bool tht represents if the simulator is on.
-	SimulationOn bool
 	// A pool of SimulatorSeeds for each sensor type.
 	SimulationSeeds map[string]*SimulatorSeed
+
+	// A mutex that guards the state of the simulator
+	mutex sync.RWMutex
+	// A bool tht represents if the simulator is on.
+	simulationon bool
+
+	// A context that is done once the simulator has been stopped
+	ctx context.Context
+	// A function that stops the simulator
+	cancel context.CancelFunc
 }
 
 // A constructor function that generates and returns a FireEventSimulator
@@ -153,9 +206,11 @@ func NewFireEventSimulator() *FireEventSimulator {
 	// Create a FireEventSimulator
 	simulator := FireEventSimulator{}
 	// Set the simulator to off
-	simulator.SimulationOn = false
+	simulator.simulationon = false
 	// Create an empty map and assign it
 	simulator.SimulationSeeds = make(map[string]*SimulatorSeed)
+	// Create the context that stops the fire events of the simulator
+	simulator.ctx, simulator.cancel = context.WithCancel(context.Background())
 
 	// Create the SimulatorSeeds for each sensor type.
 	simulator.SimulationSeeds["GAS"] = NewSimulatorSeed(450.0, 900.0, 25.0, 75.0, "bell")
@@ -167,26 +222,52 @@ func NewFireEventSimulator() *FireEventSimulator {
 }
 
 // A method of FireEventSimulator that starts a Fire Event.
-// Requires a LogQueue to log the start and end of the Fire Event.
+// Requires a function that sends logs to the LogQueue to log the start and end of the Fire Event.
 // Uses a wait group to monitor the completion of each individual seed's event curve.
-func (simulator *FireEventSimulator) StartFireEvent(logqueue chan Log) {
+// The fire event ends early if the simulator is stopped.
+func (simulator *FireEventSimulator) StartFireEvent(sendlog func(Log)) {
 	// Create a wait group
 	wg := sync.WaitGroup{}
 	// Log the start of the fire event
-	logqueue <- NewOrchSchedlog("(simulator) fire event has started")
+	sendlog(NewOrchSchedlog("(simulator) fire event has started"))
 
 	// Iterate over the Seed pool
 	for _, seed := range simulator.SimulationSeeds {
 		// Increment the wait group
 		wg.Add(1)
 		// start the seed curve
-		go seed.StartCurve(&wg)
+		go seed.StartCurve(simulator.ctx, &wg)
 	}
 
 	// Wait for wait group to complete
 	wg.Wait()
 	// Log the end of the fire event
-	logqueue <- NewOrchSchedlog("(simulator) fire event has ended")
+	if simulator.ctx.Err() != nil {
+		sendlog(NewOrchSchedlog("(simulator) fire event has been stopped"))
+		return
+	}
+	sendlog(NewOrchSchedlog("(simulator) fire event has ended"))
+}
+
+// A method of FireEventSimulator that stops the simulator. Every running
+// fire event ends early and the simulator is set to off.
+func (simulator *FireEventSimulator) Stop() {
+	simulator.cancel()
+	simulator.SetSimulationOn(false)
+}
+
+// A method of FireEventSimulator that returns if the simulator is on.
+func (simulator *FireEventSimulator) GetSimulationOn() bool {
+	simulator.mutex.RLock()
+	defer simulator.mutex.RUnlock()
+	return simulator.simulationon
+}
+
+// A method of FireEventSimulator that sets the simulator on or off.
+func (simulator *FireEventSimulator) SetSimulationOn(on bool) {
+	simulator.mutex.Lock()
+	defer simulator.mutex.Unlock()
+	simulator.simulationon = on
 }
 
 // A method of FireEventSimulator that returns a
@@ -200,19 +281,22 @@ func (simulator *FireEventSimulator) GetSimulatedValue(sensortype string) float6
 	switch sensortype {
 	case "HUM":
 		seed := simulator.SimulationSeeds["HUM"]
-		simvalue = generaterandomvalue(seed.Cursor, seed.Cursor-seed.Width, 2)
+		cursor := seed.GetCursor()
+		simvalue = generaterandomvalue(cursor, cursor-seed.Width, 2)
 
 	case "TEM":
 		seed := simulator.SimulationSeeds["TEM"]
-		simvalue = generaterandomvalue(seed.Cursor, seed.Cursor+seed.Width, 2)
+		cursor := seed.GetCursor()
+		simvalue = generaterandomvalue(cursor, cursor+seed.Width, 2)
 
 	case "GAS":
 		seed := simulator.SimulationSeeds["GAS"]
-		simvalue = generaterandomvalue(seed.Cursor, seed.Cursor+seed.Width, 0)
+		cursor := seed.GetCursor()
+		simvalue = generaterandomvalue(cursor, cursor+seed.Width, 0)
 
 	case "FLM":
 		seed := simulator.SimulationSeeds["FLM"]
-		simvalue = seed.Cursor
+		simvalue = seed.GetCursor()
 	}
 
 	// Return the simulated value
@@ -227,7 +311,7 @@ func GenerateSensorValue(sensorvalue string, sensortype string, meshorchestrator
 	var generatedvalue float64
 
 	// Check if simulation is on or if corrections have been enabled
-	if meshorchestrator.Simulator.SimulationOn || enableCorrection {
+	if meshorchestrator.Simulator.GetSimulationOn() || enableCorrection {
 		// generate a simulated value, either for a fire event or for baseline seed.
 		generatedvalue = meshorchestrator.Simulator.GetSimulatedValue(sensortype)
 
@@ -0,0 +1,334 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// The names of the files of the certificate authority generated for a mesh.
+const (
+	CACertFile = "ca.crt"
+	CAKeyFile  = "ca.key"
+)
+
+// A struct that defines the TLS identity of a device. The paths are either absolute
+// or relative to the directory specified by the 'FYRMESHCONFIG' env variable.
+type TLSConfig struct {
+	// The path to the certificate of the CA that signs the certificates of the mesh
+	CA string `json:"ca,omitempty"`
+
+	// The path to the certificate of the device
+	Cert string `json:"cert,omitempty"`
+
+	// The path to the private key of the device
+	Key string `json:"key,omitempty"`
+}
+
+// A function that resolves a path from the TLSConfig against the directory
+// specified by the 'FYRMESHCONFIG' env variable if the path is relative.
+func resolveConfigPath(file string) string {
+	if file == "" || filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(os.Getenv("FYRMESHCONFIG"), file)
+}
+
+// A function that reads the CA certificate from the TLSConfig into a certificate pool.
+func loadCertPool(tlsconfig TLSConfig) (*x509.CertPool, error) {
+	// Check that the CA has been configured
+	if tlsconfig.CA == "" {
+		return nil, fmt.Errorf("no CA certificate has been configured")
+	}
+
+	// Read the CA certificate file
+	capem, err := ioutil.ReadFile(resolveConfigPath(tlsconfig.CA))
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA certificate - %v", err)
+	}
+
+	// Add the CA certificate to a new pool
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(capem) {
+		return nil, fmt.Errorf("could not parse CA certificate '%v'", tlsconfig.CA)
+	}
+
+	// Return the pool
+	return pool, nil
+}
+
+// A function that loads the certificate and key of the device from the TLSConfig.
+func loadKeyPair(tlsconfig TLSConfig) (tls.Certificate, error) {
+	// Check that the certificate and key have been configured
+	if tlsconfig.Cert == "" || tlsconfig.Key == "" {
+		return tls.Certificate{}, fmt.Errorf("no device certificate and key have been configured")
+	}
+
+	// Load the key pair
+	certificate, err := tls.LoadX509KeyPair(resolveConfigPath(tlsconfig.Cert), resolveConfigPath(tlsconfig.Key))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not load device certificate - %v", err)
+	}
+
+	// Return the certificate
+	return certificate, nil
+}
+
+// A function that generates and returns the gRPC server option with the transport credentials
+// for serving a service from the config. The service is served with the device certificate if
+// TLS is enabled for it and client certificates signed by the CA are required if mutual TLS is
+// enabled. Returns an empty slice of options if TLS is disabled for the service.
+func NewServerCredentials(config Config, service string) ([]grpc.ServerOption, error) {
+	// Retrieve the service config
+	serviceconfig := config.Services[service]
+	if !serviceconfig.TLS {
+		return []grpc.ServerOption{}, nil
+	}
+
+	// Load the device certificate
+	certificate, err := loadKeyPair(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the TLS config for the server
+	tlsconfig := &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+	if serviceconfig.MutualTLS {
+		// Load the CA pool and require verified client certificates
+		pool, err := loadCertPool(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		tlsconfig.ClientCAs = pool
+		tlsconfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	// Return the server option with the credentials
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsconfig))}, nil
+}
+
+// A function that generates and returns the gRPC dial option with the transport credentials for
+// connecting to a service from the config. The server certificate is verified against the CA and
+// the device certificate is presented if mutual TLS is enabled. Returns an insecure dial option
+// if TLS is disabled for the service.
+func NewDialCredentials(config Config, service string) (grpc.DialOption, error) {
+	// Retrieve the service config
+	serviceconfig := config.Services[service]
+	if !serviceconfig.TLS {
+		return grpc.WithInsecure(), nil
+	}
+
+	// Load the CA pool to verify the server
+	pool, err := loadCertPool(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the TLS config for the client
+	tlsconfig := &tls.Config{RootCAs: pool, ServerName: serviceconfig.Host, MinVersion: tls.VersionTLS12}
+	if serviceconfig.MutualTLS {
+		// Load the device certificate to present to the server
+		certificate, err := loadKeyPair(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		tlsconfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	// Return the dial option with the credentials
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsconfig)), nil
+}
+
+// A function that generates a self-signed CA for a mesh in a directory, unless one already exists there.
+// Writes the CA certificate and key files and returns the CA certificate, key and whether it was created.
+func GenerateCA(dir string, days int) (*x509.Certificate, *ecdsa.PrivateKey, bool, error) {
+	certpath := filepath.Join(dir, CACertFile)
+	keypath := filepath.Join(dir, CAKeyFile)
+
+	// Reuse the CA if it already exists in the directory
+	if _, err := os.Stat(certpath); err == nil {
+		cacert, cakey, err := readCA(certpath, keypath)
+		return cacert, cakey, false, err
+	}
+
+	// Generate the CA key
+	cakey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("could not generate CA key - %v", err)
+	}
+
+	// Construct the CA certificate template
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"FyrMesh"}, CommonName: "FyrMesh CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(0, 0, days),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	// Self-sign the CA certificate
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &cakey.PublicKey, cakey)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("could not create CA certificate - %v", err)
+	}
+	cacert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("could not parse CA certificate - %v", err)
+	}
+
+	// Write the CA certificate and key
+	if err := writeKeyPair(certpath, keypath, der, cakey); err != nil {
+		return nil, nil, false, err
+	}
+
+	// Return the CA
+	return cacert, cakey, true, nil
+}
+
+// A function that generates a device certificate signed by the CA of a mesh and writes it to the
+// directory as '<name>.crt' and '<name>.key'. The name is used as the common name and the device type
+// as the organizational unit of the certificate. The certificate is valid for both server and client
+// authentication and for each of the given hosts, which may be DNS names or IP addresses.
+func GenerateDeviceCert(dir string, cacert *x509.Certificate, cakey *ecdsa.PrivateKey, name string, devicetype string, hosts []string, days int) error {
+	// Check that the name can be used as a file name
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "ca" {
+		return fmt.Errorf("invalid device name '%v'", name)
+	}
+
+	// Generate the device key
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("could not generate key for '%v' - %v", name, err)
+	}
+
+	// Construct the device certificate template
+	serial, err := newSerialNumber()
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{Organization: []string{"FyrMesh"}, OrganizationalUnit: []string{devicetype}, CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(0, 0, days),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	// Add the hosts as the subject alternative names
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if host != "" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	// Sign the device certificate with the CA
+	der, err := x509.CreateCertificate(rand.Reader, template, cacert, &key.PublicKey, cakey)
+	if err != nil {
+		return fmt.Errorf("could not create certificate for '%v' - %v", name, err)
+	}
+
+	// Write the device certificate and key
+	return writeKeyPair(filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key"), der, key)
+}
+
+// A function that reads the CA certificate and key from their files.
+func readCA(certpath string, keypath string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	// Load the key pair from the files
+	keypair, err := tls.LoadX509KeyPair(certpath, keypath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load existing CA - %v", err)
+	}
+
+	// Parse the certificate and assert the type of the key
+	cacert, err := x509.ParseCertificate(keypair.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse existing CA certificate - %v", err)
+	}
+	cakey, ok := keypair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok || !cacert.IsCA {
+		return nil, nil, fmt.Errorf("existing CA at '%v' is not a FyrMesh CA", certpath)
+	}
+
+	// Return the CA
+	return cacert, cakey, nil
+}
+
+// A function that writes a DER certificate and an ECDSA private key as PEM files.
+// The private key file is only readable by its owner.
+func writeKeyPair(certpath string, keypath string, der []byte, key *ecdsa.PrivateKey) error {
+	// Marshal the private key
+	keyder, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("could not marshal private key - %v", err)
+	}
+
+	// Write the certificate and the private key
+	if err := ioutil.WriteFile(certpath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		return fmt.Errorf("could not write certificate - %v", err)
+	}
+	if err := ioutil.WriteFile(keypath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyder}), 0600); err != nil {
+		return fmt.Errorf("could not write private key - %v", err)
+	}
+
+	return nil
+}
+
+// A function that generates a random serial number for a certificate.
+func newSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate serial number - %v", err)
+	}
+	return serial, nil
+}
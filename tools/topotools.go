@@ -0,0 +1,348 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The sources of a mesh topology.
+const (
+	// The topology was read from the connections reported by the control node
+	TopologySourceMesh = "mesh"
+	// The topology was derived from the nodelist, with every node connected to the control node
+	TopologySourceNodelist = "nodelist"
+)
+
+// The default number of topology changes kept in the history of the TopologyTracker.
+const DefaultTopologyHistory = 64
+
+// A struct that defines a link of the mesh topology between a node and the node that relays for it.
+type TopologyLink struct {
+	// The identifier of the node
+	NodeID int64
+	// The identifier of the node that the node is connected through
+	Parent int64
+}
+
+// A struct that defines the topology of the mesh as a tree rooted at the control node.
+type Topology struct {
+	// The identifier of the control node at the root of the mesh
+	Root int64
+	// A mapping of the node IDs to the ID of the node that they are connected through
+	Parents map[int64]int64
+	// The source of the topology
+	Source string
+	// The time at which the topology was read
+	Updated time.Time
+}
+
+// A struct that defines the shape of a node in the topology JSON reported
+// by the control node. Each node lists the nodes connected through it.
+type topologyJSON struct {
+	NodeID int64          `json:"nodeId"`
+	Subs   []topologyJSON `json:"subs"`
+}
+
+// A function that parses and returns a Topology from the topology JSON reported by
+// the control node, which is the tree of sub connections of painlessMesh rooted at the control node.
+func ParseTopology(data string, updated time.Time) (Topology, error) {
+	// Decode the tree
+	var tree topologyJSON
+	if err := json.Unmarshal([]byte(data), &tree); err != nil {
+		return Topology{}, fmt.Errorf("could not decode topology - %v", err)
+	}
+
+	// Walk the tree and collect the parent of every node below the root
+	topology := Topology{Root: tree.NodeID, Parents: make(map[int64]int64), Source: TopologySourceMesh, Updated: updated}
+	var walk func(node topologyJSON) error
+	walk = func(node topologyJSON) error {
+		for _, sub := range node.Subs {
+			if _, ok := topology.Parents[sub.NodeID]; ok || sub.NodeID == topology.Root {
+				return fmt.Errorf("node '%v' appears more than once", sub.NodeID)
+			}
+			topology.Parents[sub.NodeID] = node.NodeID
+			if err := walk(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(tree); err != nil {
+		return Topology{}, fmt.Errorf("invalid topology - %v", err)
+	}
+
+	return topology, nil
+}
+
+// A constructor function that generates and returns a Topology from a list of node IDs,
+// in which every node is connected to the control node at the root.
+func NewNodelistTopology(root int64, nodeids []int64, updated time.Time) Topology {
+	topology := Topology{Root: root, Parents: make(map[int64]int64), Source: TopologySourceNodelist, Updated: updated}
+	for _, nodeid := range nodeids {
+		topology.Parents[nodeid] = root
+	}
+	return topology
+}
+
+// A method of Topology that returns the IDs of the nodes connected through a node, sorted by their ID.
+func (topology *Topology) Children(parent int64) []int64 {
+	children := make([]int64, 0)
+	for nodeid, nodeparent := range topology.Parents {
+		if nodeparent == parent {
+			children = append(children, nodeid)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i] < children[j] })
+	return children
+}
+
+// A method of Topology that returns the links of every node to its parent, sorted by the node ID.
+func (topology *Topology) Links() []TopologyLink {
+	links := make([]TopologyLink, 0, len(topology.Parents))
+	for nodeid, parent := range topology.Parents {
+		links = append(links, TopologyLink{NodeID: nodeid, Parent: parent})
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].NodeID < links[j].NodeID })
+	return links
+}
+
+// A method of Topology that returns a copy of the topology.
+func (topology *Topology) Copy() Topology {
+	copied := *topology
+	copied.Parents = make(map[int64]int64, len(topology.Parents))
+	for nodeid, parent := range topology.Parents {
+		copied.Parents[nodeid] = parent
+	}
+	return copied
+}
+
+// A struct that defines a change of the mesh topology.
+type TopologyChange struct {
+	// The time at which the change was observed
+	Time time.Time
+	// The source of the topology after the change
+	Source string
+	// The IDs of the nodes that joined the mesh
+	Joined []int64
+	// The IDs of the nodes that left the mesh
+	Left []int64
+	// The new links of the nodes that are connected through a different node
+	Moved []TopologyLink
+}
+
+// A method of TopologyChange that returns whether the change is empty.
+func (change *TopologyChange) Empty() bool {
+	return len(change.Joined) == 0 && len(change.Left) == 0 && len(change.Moved) == 0
+}
+
+// A method of TopologyChange that returns a summary of the change as a string.
+func (change *TopologyChange) String() string {
+	moved := make([]string, 0, len(change.Moved))
+	for _, link := range change.Moved {
+		moved = append(moved, fmt.Sprintf("%v->%v", link.NodeID, link.Parent))
+	}
+	return fmt.Sprintf("joined - %v | left - %v | moved - %v", change.Joined, change.Left, moved)
+}
+
+// A function that compares two topologies and returns the change from the old to the new topology.
+func diffTopology(old, new Topology) TopologyChange {
+	change := TopologyChange{Time: new.Updated, Source: new.Source, Joined: []int64{}, Left: []int64{}, Moved: []TopologyLink{}}
+	for _, link := range new.Links() {
+		oldparent, ok := old.Parents[link.NodeID]
+		switch {
+		case !ok:
+			change.Joined = append(change.Joined, link.NodeID)
+		case oldparent != link.Parent:
+			change.Moved = append(change.Moved, link)
+		}
+	}
+	for _, link := range old.Links() {
+		if _, ok := new.Parents[link.NodeID]; !ok {
+			change.Left = append(change.Left, link.NodeID)
+		}
+	}
+	return change
+}
+
+// A struct that defines a tracker of the topology of the mesh and a history of its changes.
+type TopologyTracker struct {
+	// A mutex that guards the topology and the history
+	mutex sync.Mutex
+
+	// The current topology of the mesh
+	topology Topology
+	// The changes of the topology, oldest first
+	history []TopologyChange
+	// The number of changes kept in the history
+	capacity int
+}
+
+// A constructor function that generates and returns a TopologyTracker
+// with an empty topology that keeps the given number of changes.
+func NewTopologyTracker(capacity int) *TopologyTracker {
+	return &TopologyTracker{topology: Topology{Parents: make(map[int64]int64)}, capacity: capacity}
+}
+
+// A method of TopologyTracker that sets the current topology of the mesh. The change from the previous
+// topology is recorded in the history and returned along with whether the topology changed. A topology
+// derived from the nodelist is ignored once a topology has been read from the mesh, since it carries
+// no connections and the mesh topology is read again whenever the connections of the mesh change.
+func (tracker *TopologyTracker) Update(topology Topology) (TopologyChange, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	return tracker.update(topology)
+}
+
+// A method of TopologyTracker that adds a node that joined the mesh to a topology derived from the nodelist,
+// connected to the control node at the root. Does nothing if the node is already on the topology or if the
+// topology has been read from the mesh. Returns the change and whether the topology changed.
+func (tracker *TopologyTracker) Join(root int64, nodeid int64, updated time.Time) (TopologyChange, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if _, ok := tracker.topology.Parents[nodeid]; ok || tracker.topology.Source == TopologySourceMesh {
+		return TopologyChange{}, false
+	}
+
+	// Derive the topology with the node connected to the root
+	topology := tracker.topology.Copy()
+	topology.Root, topology.Source, topology.Updated = root, TopologySourceNodelist, updated
+	topology.Parents[nodeid] = root
+	return tracker.update(topology)
+}
+
+// A method of TopologyTracker that sets the current topology of the mesh. Must be called with the mutex held.
+func (tracker *TopologyTracker) update(topology Topology) (TopologyChange, bool) {
+	if topology.Source == TopologySourceNodelist && tracker.topology.Source == TopologySourceMesh {
+		return TopologyChange{}, false
+	}
+
+	// Compare the topologies and set the new topology
+	change := diffTopology(tracker.topology, topology)
+	tracker.topology = topology.Copy()
+	if change.Empty() {
+		return change, false
+	}
+
+	// Record the change in the history and discard the oldest changes beyond the capacity
+	tracker.history = append(tracker.history, change)
+	if len(tracker.history) > tracker.capacity {
+		tracker.history = tracker.history[len(tracker.history)-tracker.capacity:]
+	}
+	return change, true
+}
+
+// A method of TopologyTracker that returns a copy of the current topology of the mesh.
+func (tracker *TopologyTracker) Current() Topology {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	return tracker.topology.Copy()
+}
+
+// A method of TopologyTracker that returns the latest changes of the topology, oldest first.
+// Returns every change in the history if the limit is not positive.
+func (tracker *TopologyTracker) History(limit int) []TopologyChange {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	start := 0
+	if limit > 0 && limit < len(tracker.history) {
+		start = len(tracker.history) - limit
+	}
+	return append([]TopologyChange{}, tracker.history[start:]...)
+}
+
+// A method of MeshOrchestrator that accepts a Log of type 'topology' and sets the
+// topology of the mesh from the topology JSON on it. The change is logged to the observers.
+func (meshorchestrator *MeshOrchestrator) SetTopology(log Log) error {
+	// Check if the logtype is 'topology'
+	if log.GetLogtype() != "topology" {
+		return fmt.Errorf("log is not of type 'topology'")
+	}
+
+	// Construct the topology from the log
+	topology, err := ParseTopology(log.GetLogmetadata()["topology"], time.Now())
+	if err != nil {
+		meshorchestrator.emitLog(NewOrchServerlog(fmt.Sprintf("(failure) mesh topology could not be read | error - %v", err)))
+		return err
+	}
+
+	meshorchestrator.updateTopology(topology)
+	return nil
+}
+
+// A method of MeshOrchestrator that accepts a Log of type 'handshake' and adds the node that
+// handshaked to the topology, connected to the control node, until the nodelist is read again.
+// A topology read from the mesh is left as it is, since it is read again on the following 'meshsync'.
+func (meshorchestrator *MeshOrchestrator) SetHandshake(log Log) error {
+	// Check if the logtype is 'handshake'
+	if log.GetLogtype() != "handshake" {
+		return fmt.Errorf("log is not of type 'handshake'")
+	}
+
+	// Retrieve the node that handshaked
+	root := meshorchestrator.GetControlnode().NodeID
+	nodeid, err := strconv.ParseInt(log.GetLogmetadata()["node"], 0, 64)
+	if err != nil || nodeid <= 0 || nodeid == root {
+		return fmt.Errorf("invalid node on handshake - '%v'", log.GetLogmetadata()["node"])
+	}
+
+	meshorchestrator.logTopologyChange(meshorchestrator.Topology.Join(root, nodeid, time.Now()))
+	return nil
+}
+
+// A method of MeshOrchestrator that sends the command to read the topology of the mesh. Does nothing
+// if the firmware of the control node does not report the topology, in which case the topology is
+// derived from the 'nodelist' and 'handshake' events of the mesh.
+func (meshorchestrator *MeshOrchestrator) UpdateTopology() {
+	if !meshorchestrator.GetControlnode().Topology {
+		return
+	}
+
+	// Send the command to read the topology of the mesh to the CommandQueue
+	command := map[string]string{"command": "readtopology-control"}
+	meshorchestrator.SendInternalCommand(command)
+}
+
+// A method of MeshOrchestrator that sets the topology of the mesh and logs the change, if any.
+func (meshorchestrator *MeshOrchestrator) updateTopology(topology Topology) {
+	meshorchestrator.logTopologyChange(meshorchestrator.Topology.Update(topology))
+}
+
+// A method of MeshOrchestrator that logs a change of the topology of the mesh, if it changed.
+func (meshorchestrator *MeshOrchestrator) logTopologyChange(change TopologyChange, changed bool) {
+	if changed {
+		meshorchestrator.emitLog(NewOrchServerlog(fmt.Sprintf("(topology) mesh topology changed | source - %v | %v", change.Source, change.String())))
+	}
+}
@@ -0,0 +1,223 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// A test that parses the topology JSON reported by the control node and checks the parents of the nodes.
+func TestParseTopology(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		root    int64
+		parents map[int64]int64
+		valid   bool
+	}{
+		{
+			name:    "control node only",
+			data:    `{"nodeId": 1}`,
+			root:    1,
+			parents: map[int64]int64{},
+			valid:   true,
+		},
+		{
+			name:    "star",
+			data:    `{"nodeId": 1, "subs": [{"nodeId": 2}, {"nodeId": 3}]}`,
+			root:    1,
+			parents: map[int64]int64{2: 1, 3: 1},
+			valid:   true,
+		},
+		{
+			name:    "relayed nodes",
+			data:    `{"nodeId": 1, "subs": [{"nodeId": 2, "subs": [{"nodeId": 4, "subs": [{"nodeId": 5}]}]}, {"nodeId": 3}]}`,
+			root:    1,
+			parents: map[int64]int64{2: 1, 3: 1, 4: 2, 5: 4},
+			valid:   true,
+		},
+		{
+			name:  "duplicate node",
+			data:  `{"nodeId": 1, "subs": [{"nodeId": 2, "subs": [{"nodeId": 3}]}, {"nodeId": 3}]}`,
+			valid: false,
+		},
+		{
+			name:  "root below itself",
+			data:  `{"nodeId": 1, "subs": [{"nodeId": 2, "subs": [{"nodeId": 1}]}]}`,
+			valid: false,
+		},
+		{
+			name:  "malformed",
+			data:  `{"nodeId": 1, "subs": [`,
+			valid: false,
+		},
+		{
+			name:  "empty",
+			data:  ``,
+			valid: false,
+		},
+	}
+
+	updated := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range tests {
+		topology, err := ParseTopology(test.data, updated)
+		if (err == nil) != test.valid {
+			t.Errorf("%v: expected valid %v, got error %v", test.name, test.valid, err)
+			continue
+		}
+		if !test.valid {
+			continue
+		}
+
+		if topology.Root != test.root || topology.Source != TopologySourceMesh || !topology.Updated.Equal(updated) {
+			t.Errorf("%v: expected a mesh topology rooted at %v, got %+v", test.name, test.root, topology)
+		}
+		if !reflect.DeepEqual(topology.Parents, test.parents) {
+			t.Errorf("%v: expected parents %v, got %v", test.name, test.parents, topology.Parents)
+		}
+	}
+}
+
+// A test that compares two topologies and checks the nodes that joined, left and moved.
+func TestDiffTopology(t *testing.T) {
+	tests := []struct {
+		name   string
+		old    map[int64]int64
+		new    map[int64]int64
+		joined []int64
+		left   []int64
+		moved  []TopologyLink
+	}{
+		{
+			name:   "unchanged",
+			old:    map[int64]int64{2: 1, 3: 2},
+			new:    map[int64]int64{2: 1, 3: 2},
+			joined: []int64{},
+			left:   []int64{},
+			moved:  []TopologyLink{},
+		},
+		{
+			name:   "first topology",
+			old:    map[int64]int64{},
+			new:    map[int64]int64{3: 1, 2: 1},
+			joined: []int64{2, 3},
+			left:   []int64{},
+			moved:  []TopologyLink{},
+		},
+		{
+			name:   "joined and left",
+			old:    map[int64]int64{2: 1, 3: 1},
+			new:    map[int64]int64{2: 1, 4: 1},
+			joined: []int64{4},
+			left:   []int64{3},
+			moved:  []TopologyLink{},
+		},
+		{
+			name:   "moved",
+			old:    map[int64]int64{2: 1, 3: 1, 4: 1},
+			new:    map[int64]int64{2: 1, 3: 2, 4: 3},
+			joined: []int64{},
+			left:   []int64{},
+			moved:  []TopologyLink{{NodeID: 3, Parent: 2}, {NodeID: 4, Parent: 3}},
+		},
+		{
+			name:   "mesh emptied",
+			old:    map[int64]int64{2: 1, 3: 2},
+			new:    map[int64]int64{},
+			joined: []int64{},
+			left:   []int64{2, 3},
+			moved:  []TopologyLink{},
+		},
+	}
+
+	updated := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, test := range tests {
+		old := Topology{Root: 1, Parents: test.old, Source: TopologySourceMesh}
+		new := Topology{Root: 1, Parents: test.new, Source: TopologySourceMesh, Updated: updated}
+		change := diffTopology(old, new)
+
+		if !reflect.DeepEqual(change.Joined, test.joined) || !reflect.DeepEqual(change.Left, test.left) || !reflect.DeepEqual(change.Moved, test.moved) {
+			t.Errorf("%v: expected joined %v, left %v and moved %v, got %v", test.name, test.joined, test.left, test.moved, change.String())
+		}
+		if empty := len(test.joined)+len(test.left)+len(test.moved) == 0; change.Empty() != empty {
+			t.Errorf("%v: expected empty %v, got %v", test.name, empty, change.Empty())
+		}
+		if change.Source != TopologySourceMesh || !change.Time.Equal(updated) {
+			t.Errorf("%v: expected the source and time of the new topology, got %v at %v", test.name, change.Source, change.Time)
+		}
+	}
+}
+
+// A test that checks that a topology derived from the nodelist or from a handshake does not replace
+// a topology read from the mesh, and that only the changes of the topology are kept in the history.
+func TestTopologyTrackerSources(t *testing.T) {
+	updated := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := NewTopologyTracker(DefaultTopologyHistory)
+
+	// The nodelist sets the topology until it is read from the mesh
+	if _, changed := tracker.Update(NewNodelistTopology(1, []int64{2, 3}, updated)); !changed {
+		t.Errorf("expected the nodelist topology to change the empty topology")
+	}
+	if _, changed := tracker.Update(NewNodelistTopology(1, []int64{2, 3}, updated)); changed {
+		t.Errorf("expected the same nodelist topology to leave the topology unchanged")
+	}
+	if change, changed := tracker.Join(1, 4, updated); !changed || !reflect.DeepEqual(change.Joined, []int64{4}) {
+		t.Errorf("expected the handshake of node 4 to join it, got %v", change.String())
+	}
+	if _, changed := tracker.Join(1, 4, updated); changed {
+		t.Errorf("expected a second handshake of node 4 to leave the topology unchanged")
+	}
+
+	// The topology read from the mesh replaces the derived topology and is kept
+	meshtopology, err := ParseTopology(`{"nodeId": 1, "subs": [{"nodeId": 2, "subs": [{"nodeId": 3}]}]}`, updated)
+	if err != nil {
+		t.Fatalf("could not parse topology - %v", err)
+	}
+	if change, changed := tracker.Update(meshtopology); !changed || !reflect.DeepEqual(change.Left, []int64{4}) || len(change.Moved) != 1 {
+		t.Errorf("expected the mesh topology to move node 3 and remove node 4, got %v", change.String())
+	}
+	if _, changed := tracker.Update(NewNodelistTopology(1, []int64{2, 3, 5}, updated)); changed {
+		t.Errorf("expected the nodelist topology to be ignored once the topology is read from the mesh")
+	}
+	if _, changed := tracker.Join(1, 5, updated); changed {
+		t.Errorf("expected a handshake to be ignored once the topology is read from the mesh")
+	}
+
+	current := tracker.Current()
+	if current.Source != TopologySourceMesh || !reflect.DeepEqual(current.Parents, map[int64]int64{2: 1, 3: 2}) {
+		t.Errorf("expected the mesh topology, got %+v", current)
+	}
+	if history := tracker.History(0); len(history) != 3 {
+		t.Errorf("expected 3 changes in the history, got %v", len(history))
+	}
+	if history := tracker.History(1); len(history) != 1 || history[0].Source != TopologySourceMesh {
+		t.Errorf("expected the latest change from the mesh, got %+v", history)
+	}
+}
@@ -0,0 +1,321 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// The exporters of the spans of a FyrMesh service.
+const (
+	// Exports the spans to an OpenTelemetry collector over OTLP/gRPC
+	TraceExporterOTLP = "otlp"
+	// Exports the spans as JSON lines to a file of the service
+	TraceExporterFile = "file"
+)
+
+// The reserved key of a command map that carries the W3C trace context of the span that issued
+// the command. The key is removed from the command before it is written to the LINK server.
+const TraceKey = "trace"
+
+// The name of the tracer of the FyrMesh services.
+const TracerName = "github.com/fyrwatch/fyrmesh"
+
+// The address of the OpenTelemetry collector when the endpoint is not set in the config file.
+const DefaultTraceEndpoint = "localhost:4317"
+
+// The duration for which the span that wrote a ping is kept to link the responses of the mesh to it.
+const DefaultPingTraceTTL = time.Minute * 5
+
+// A struct that defines the configuration of the tracing of the FyrMesh services.
+// The tracing is disabled if the exporter is not set.
+type TracingConfig struct {
+	// The exporter of the spans, either 'otlp' or 'file'
+	Exporter string `json:"exporter,omitempty"`
+
+	// The address of the OpenTelemetry collector and whether it is reached without TLS
+	Endpoint string `json:"endpoint,omitempty"`
+	Insecure bool   `json:"insecure,omitempty"`
+
+	// The directory in which the file exporter writes a 'traces-<service>.json' file for every service.
+	// A relative path is resolved against the config directory, which is used if it is not set.
+	Directory string `json:"directory,omitempty"`
+
+	// The ratio of the traces that are sampled, all traces are sampled if it is not set
+	SampleRatio float64 `json:"sampleratio,omitempty"`
+}
+
+// A method of TracingConfig that returns the address of the OpenTelemetry collector.
+// Falls back to the DefaultTraceEndpoint if it is not set.
+func (config TracingConfig) GetEndpoint() string {
+	if config.Endpoint == "" {
+		return DefaultTraceEndpoint
+	}
+	return config.Endpoint
+}
+
+// A method of TracingConfig that returns the path of the trace file of a service for the file exporter.
+func (config TracingConfig) GetTraceFile(service string) string {
+	directory := os.Getenv("FYRMESHCONFIG")
+	if config.Directory != "" {
+		directory = resolveConfigPath(config.Directory)
+	}
+	return filepath.Join(directory, fmt.Sprintf("traces-%v.json", service))
+}
+
+// A function that starts the tracing of a FyrMesh service from the TracingConfig. The W3C trace context
+// propagator is always installed, so that services without an exporter still pass the trace context along.
+// Returns a function that flushes the remaining spans and stops the exporter before the service exits.
+func StartTracing(config TracingConfig, service string) (func(context.Context) error, error) {
+	// Install the propagator of the trace context
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// Construct the exporter of the spans
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	switch config.Exporter {
+	case "":
+		return func(context.Context) error { return nil }, nil
+
+	case TraceExporterOTLP:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.GetEndpoint())}
+		if config.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		var err error
+		if exporter, err = otlptracegrpc.New(context.Background(), options...); err != nil {
+			return nil, fmt.Errorf("could not create the OTLP exporter - %v", err)
+		}
+
+	case TraceExporterFile:
+		var err error
+		if file, err = os.OpenFile(config.GetTraceFile(service), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600); err != nil {
+			return nil, fmt.Errorf("could not open the trace file - %v", err)
+		}
+		if exporter, err = stdouttrace.New(stdouttrace.WithWriter(file)); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("could not create the file exporter - %v", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid trace exporter '%v' - must be '%v' or '%v'", config.Exporter, TraceExporterOTLP, TraceExporterFile)
+	}
+
+	// Sample a ratio of the traces, following the decision of the parent span
+	ratio := config.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	// Create the tracer provider of the service and install it
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			file.Close()
+		}
+		return err
+	}, nil
+}
+
+// A function that returns the tracer of the FyrMesh services.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// A function that returns the gRPC server option that traces the unary calls to a server.
+func TraceUnaryServerOption() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor())
+}
+
+// A function that returns the gRPC server options that trace the unary and stream calls to a server.
+func TraceServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		TraceUnaryServerOption(),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	}
+}
+
+// A function that returns the gRPC dial option that traces the unary calls of a client.
+// Streams are not traced since they remain open for the lifetime of the client.
+func TraceUnaryDialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor())
+}
+
+// A function that returns the gRPC dial options that trace the unary and stream calls of a client.
+func TraceDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		TraceUnaryDialOption(),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+}
+
+// A type that defines a carrier of the W3C trace context on a command map.
+// Only the 'traceparent' header is carried, with the TraceKey of the command.
+type commandCarrier map[string]string
+
+// A method of commandCarrier that returns the value of a header of the trace context.
+func (carrier commandCarrier) Get(key string) string {
+	if key != "traceparent" {
+		return ""
+	}
+	return carrier[TraceKey]
+}
+
+// A method of commandCarrier that sets the value of a header of the trace context.
+func (carrier commandCarrier) Set(key string, value string) {
+	if key == "traceparent" {
+		carrier[TraceKey] = value
+	}
+}
+
+// A method of commandCarrier that returns the headers of the trace context that are carried.
+func (carrier commandCarrier) Keys() []string {
+	return []string{"traceparent"}
+}
+
+// A function that sets the trace context of the span in a context on a command map with the TraceKey.
+// Does nothing if the context does not carry a valid span.
+func InjectTrace(ctx context.Context, command map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, commandCarrier(command))
+}
+
+// A function that returns a copy of a context with the trace context carried by a command map, if any.
+func ExtractTrace(ctx context.Context, command map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, commandCarrier(command))
+}
+
+// A struct that defines a registry of the span contexts of the spans that wrote pings to the LINK server.
+// The responses of the mesh to a ping are traced as children of the span that wrote the ping.
+type PingTraces struct {
+	// A mutex that guards the span contexts
+	mutex sync.Mutex
+
+	// A mapping of ping IDs to the span context that wrote the ping and the time it expires
+	spans   map[string]trace.SpanContext
+	expires map[string]time.Time
+
+	// The duration for which a span context is kept
+	ttl time.Duration
+}
+
+// A constructor function that generates and returns an empty PingTraces that keeps span contexts for a duration.
+func NewPingTraces(ttl time.Duration) *PingTraces {
+	return &PingTraces{spans: make(map[string]trace.SpanContext), expires: make(map[string]time.Time), ttl: ttl}
+}
+
+// A method of PingTraces that registers the span context that wrote a ping. Span contexts
+// that are not sampled are ignored and the expired span contexts are removed.
+func (traces *PingTraces) Register(pingid string, spancontext trace.SpanContext) {
+	if pingid == "" || !spancontext.IsSampled() {
+		return
+	}
+
+	traces.mutex.Lock()
+	defer traces.mutex.Unlock()
+
+	// Remove the expired span contexts
+	now := time.Now()
+	for id, expires := range traces.expires {
+		if now.After(expires) {
+			delete(traces.spans, id)
+			delete(traces.expires, id)
+		}
+	}
+
+	traces.spans[pingid] = spancontext
+	traces.expires[pingid] = now.Add(traces.ttl)
+}
+
+// A method of PingTraces that returns a context with the span context that wrote a ping as its remote parent.
+// Returns false if the ping was not registered or has expired.
+func (traces *PingTraces) Context(pingid string) (context.Context, bool) {
+	traces.mutex.Lock()
+	defer traces.mutex.Unlock()
+
+	spancontext, ok := traces.spans[pingid]
+	if !ok || time.Now().After(traces.expires[pingid]) {
+		return nil, false
+	}
+	return trace.ContextWithRemoteSpanContext(context.Background(), spancontext), true
+}
+
+// A method of PingTraces that traces a response of the mesh to a ping as a child of the span that wrote the ping.
+// Does nothing if the log does not respond to a registered ping.
+func (traces *PingTraces) TraceResponse(log Log) {
+	metadata := log.GetLogmetadata()
+	ctx, ok := traces.Context(metadata["ping"])
+	if !ok {
+		return
+	}
+
+	_, span := Tracer().Start(ctx, "mesh."+log.GetLogtype(), trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(
+		attribute.String("fyrmesh.ping", metadata["ping"]),
+		attribute.String("fyrmesh.node", metadata["node"]),
+	))
+	span.End()
+}
+
+// A method of PingTraces that traces the accumulation of a mesh ping from the time it started
+// until it completed as a child of the span that wrote the ping. Does nothing if the ping is not registered.
+func (traces *PingTraces) TraceAccumulation(pingid string, started time.Time, responses int) {
+	ctx, ok := traces.Context(pingid)
+	if !ok {
+		return
+	}
+
+	_, span := Tracer().Start(ctx, "mesh.accumulation", trace.WithTimestamp(started), trace.WithAttributes(
+		attribute.String("fyrmesh.ping", pingid),
+		attribute.Int("fyrmesh.responses", responses),
+	))
+	span.End()
+}
@@ -0,0 +1,348 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// The metadata key that carries the ID of a command through the orchestrator.
+// The key is reserved and is removed before the command is written to the LINK.
+const CommandIDKey = "cmdid"
+
+// The default number of command records kept by the CommandTracker.
+const DefaultCommandHistory = 512
+
+// A type that represents the state of a command as it moves through the orchestrator.
+type CommandState int
+
+// The states of a command in the order they are reached.
+const (
+	// The command has been accepted and is waiting in the command queue
+	CommandQueued CommandState = iota + 1
+	// The command has been taken from the command queue and is being written to the LINK
+	CommandSent
+	// The LINK has acknowledged that the command was written to the control node
+	CommandAcked
+	// The command could not be written to the control node by the LINK
+	CommandFailed
+	// A response to the command has been observed from the mesh
+	CommandObserved
+	// The command could not be written to the LINK and is waiting in the outbox for another attempt
+	CommandRetrying
+	// The command expired in the outbox before it could be written to the LINK
+	CommandExpired
+)
+
+// A method of CommandState that returns the name of the state.
+func (state CommandState) String() string {
+	switch state {
+	case CommandQueued:
+		return "queued"
+	case CommandSent:
+		return "sent"
+	case CommandAcked:
+		return "acked"
+	case CommandFailed:
+		return "failed"
+	case CommandObserved:
+		return "observed"
+	case CommandRetrying:
+		return "retrying"
+	case CommandExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// A mapping of the states of a command to the states that it can move to with an update of the CommandTracker.
+// The failed, expired and observed states are terminal, so that a late acknowledgement or write of a command
+// cannot move it back to an earlier state. A command is moved to the observed state by the responses to it.
+var commandTransitions = map[CommandState][]CommandState{
+	CommandQueued:   {CommandSent, CommandFailed, CommandRetrying, CommandExpired},
+	CommandSent:     {CommandAcked, CommandFailed, CommandRetrying},
+	CommandAcked:    {},
+	CommandRetrying: {CommandSent, CommandFailed, CommandRetrying, CommandExpired},
+	CommandFailed:   {},
+	CommandObserved: {},
+	CommandExpired:  {},
+}
+
+// A method of CommandState that returns whether a command can move from the state to another state.
+func (state CommandState) CanMoveTo(next CommandState) bool {
+	for _, allowed := range commandTransitions[state] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// A method of CommandState that returns whether the state is terminal.
+func (state CommandState) Terminal() bool {
+	return state == CommandFailed || state == CommandExpired || state == CommandObserved
+}
+
+// A function that parses the name of a CommandState and returns the state and whether it is valid.
+func ParseCommandState(name string) (CommandState, bool) {
+	for state := CommandQueued; state <= CommandExpired; state++ {
+		if state.String() == name {
+			return state, true
+		}
+	}
+	return 0, false
+}
+
+// A struct that defines the record of a command tracked by the CommandTracker.
+type CommandRecord struct {
+	// The unique ID of the command
+	CommandID string
+	// The command message
+	Command string
+	// The metadata of the command without the command ID
+	Metadata map[string]string
+	// The current state of the command
+	State CommandState
+	// The error reported by the LINK if the command failed
+	Error string
+	// The ISO time at which the command was accepted
+	Created string
+	// The ISO time at which the state of the command last changed
+	Updated string
+	// The number of responses to the command observed from the mesh
+	Responses int
+}
+
+// A struct that defines a tracker of the commands sent to the control node.
+// The tracker keeps a bounded history of CommandRecords and evicts the oldest.
+type CommandTracker struct {
+	// A mutex that guards the records
+	mutex sync.Mutex
+
+	// The maximum number of records to keep
+	capacity int
+
+	// A mapping of command IDs to their records
+	records map[string]*CommandRecord
+
+	// A slice of command IDs in the order they were tracked
+	order []string
+
+	// A mapping of ping IDs to the command IDs that carry them
+	pings map[string]string
+}
+
+// A constructor function that generates and returns a CommandTracker
+// that keeps at most the given number of command records.
+func NewCommandTracker(capacity int) *CommandTracker {
+	return &CommandTracker{
+		capacity: capacity,
+		records:  make(map[string]*CommandRecord),
+		order:    make([]string, 0, capacity),
+		pings:    make(map[string]string),
+	}
+}
+
+// A function that generates and returns a new random command ID.
+func NewCommandID() string {
+	// Read 8 random bytes and hex encode them
+	randbytes := make([]byte, 8)
+	if _, err := rand.Read(randbytes); err != nil {
+		// Fall back to a time based ID if the random source fails
+		return "cmd-" + CurrentISOtime()
+	}
+	return "cmd-" + hex.EncodeToString(randbytes)
+}
+
+// A method of CommandTracker that starts tracking a command in the queued state.
+// A new command ID is generated and set on the command map with the CommandIDKey,
+// unless the command already carries one. Returns the ID of the command.
+func (tracker *CommandTracker) Track(command map[string]string) string {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	// Use the command ID of the command or generate a new one
+	cmdid := command[CommandIDKey]
+	if cmdid == "" {
+		cmdid = NewCommandID()
+		command[CommandIDKey] = cmdid
+	}
+
+	// Copy the metadata of the command without the command, its ID and its trace context
+	metadata := make(map[string]string)
+	for key, value := range command {
+		if key != "command" && key != CommandIDKey && key != TraceKey {
+			metadata[key] = value
+		}
+	}
+
+	// Evict the oldest record if the tracker is full
+	if len(tracker.order) >= tracker.capacity {
+		tracker.evict(tracker.order[0])
+	}
+
+	// Create the record in the queued state
+	now := CurrentISOtime()
+	tracker.records[cmdid] = &CommandRecord{CommandID: cmdid, Command: command["command"], Metadata: metadata, State: CommandQueued, Created: now, Updated: now}
+	tracker.order = append(tracker.order, cmdid)
+
+	// Index the command by its ping ID so that responses can be matched
+	if pingid := metadata["ping"]; pingid != "" {
+		tracker.pings[pingid] = cmdid
+	}
+
+	return cmdid
+}
+
+// A method of CommandTracker that removes a record from the tracker. Requires the lock to be held.
+func (tracker *CommandTracker) evict(cmdid string) {
+	// Remove the ping index of the record
+	if record, ok := tracker.records[cmdid]; ok {
+		if pingid := record.Metadata["ping"]; pingid != "" && tracker.pings[pingid] == cmdid {
+			delete(tracker.pings, pingid)
+		}
+	}
+
+	// Remove the record and its position in the order
+	delete(tracker.records, cmdid)
+	for index, id := range tracker.order {
+		if id == cmdid {
+			tracker.order = append(tracker.order[:index], tracker.order[index+1:]...)
+			break
+		}
+	}
+}
+
+// A method of CommandTracker that sets the state of a command and the error if any. Updates for
+// unknown command IDs and updates that are not allowed by the commandTransitions are ignored, such
+// as the acknowledgement of a command that has already been observed, since responses can arrive
+// before the LINK acknowledges, or the write of a command that has already failed or expired.
+func (tracker *CommandTracker) Update(cmdid string, state CommandState, err error) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	// Retrieve the record and check the transition
+	record, ok := tracker.records[cmdid]
+	if !ok || !record.State.CanMoveTo(state) {
+		return
+	}
+
+	// Set the state and the error
+	record.State = state
+	record.Updated = CurrentISOtime()
+	if err != nil {
+		record.Error = err.Error()
+	}
+}
+
+// A method of CommandTracker that matches a Log from the mesh to the command it responds to and
+// marks the command as observed. Sensor and config data are matched by their ping ID, while the control
+// node config, the node list and the topology are matched to the latest command that requested them.
+func (tracker *CommandTracker) Observe(log Log) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	// Declare the ID of the command being responded to
+	var cmdid string
+
+	// Check the type of the log and find the command
+	switch log.GetLogtype() {
+	case "sensordata", "configdata":
+		cmdid = tracker.pings[log.GetLogmetadata()["ping"]]
+
+	case "ctrldata":
+		cmdid = tracker.latest("readconfig-control")
+
+	case "nodelist":
+		cmdid = tracker.latest("readnodelist-control")
+
+	case "topology":
+		cmdid = tracker.latest("readtopology-control")
+	}
+
+	// Retrieve the record and mark it as observed, unless it has already failed or expired
+	record, ok := tracker.records[cmdid]
+	if !ok || (record.State.Terminal() && record.State != CommandObserved) {
+		return
+	}
+	record.State = CommandObserved
+	record.Updated = CurrentISOtime()
+	record.Responses++
+}
+
+// A method of CommandTracker that returns the ID of the latest command with the given command
+// message that is still awaiting a response. Requires the lock to be held.
+func (tracker *CommandTracker) latest(command string) string {
+	for index := len(tracker.order) - 1; index >= 0; index-- {
+		record := tracker.records[tracker.order[index]]
+		if record.Command == command && !record.State.Terminal() {
+			return record.CommandID
+		}
+	}
+	return ""
+}
+
+// A method of CommandTracker that returns a copy of the record of a command and whether it exists.
+func (tracker *CommandTracker) Get(cmdid string) (CommandRecord, bool) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	record, ok := tracker.records[cmdid]
+	if !ok {
+		return CommandRecord{}, false
+	}
+	return *record, true
+}
+
+// A method of CommandTracker that returns copies of the most recent records, newest first.
+// Only records in the given state are returned if the state is non-zero. A limit of zero or
+// less returns every matching record.
+func (tracker *CommandTracker) List(state CommandState, limit int) []CommandRecord {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	records := make([]CommandRecord, 0)
+	for index := len(tracker.order) - 1; index >= 0; index-- {
+		// Stop once the limit has been reached
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+
+		// Skip records that are not in the state
+		record := tracker.records[tracker.order[index]]
+		if state != 0 && record.State != state {
+			continue
+		}
+		records = append(records, *record)
+	}
+
+	return records
+}
@@ -0,0 +1,246 @@
+/*
+===========================================================================
+MIT License
+
+Copyright (c) 2021 Manish Meganathan, Mariyam A.Ghani
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+===========================================================================
+FyrMesh gopkg tools
+===========================================================================
+*/
+package tools_test
+
+import (
+	"fmt"
+	"testing"
+
+	tools "github.com/fyrwatch/fyrmesh/tools"
+)
+
+// A test that checks the transitions that are allowed between the states of a command.
+func TestCommandStateTransitions(t *testing.T) {
+	tests := []struct {
+		from, to tools.CommandState
+		allowed  bool
+	}{
+		{tools.CommandQueued, tools.CommandSent, true},
+		{tools.CommandQueued, tools.CommandFailed, true},
+		{tools.CommandQueued, tools.CommandRetrying, true},
+		{tools.CommandQueued, tools.CommandExpired, true},
+		{tools.CommandQueued, tools.CommandAcked, false},
+		{tools.CommandQueued, tools.CommandObserved, false},
+		{tools.CommandSent, tools.CommandAcked, true},
+		{tools.CommandSent, tools.CommandFailed, true},
+		{tools.CommandSent, tools.CommandRetrying, true},
+		{tools.CommandSent, tools.CommandQueued, false},
+		{tools.CommandSent, tools.CommandExpired, false},
+		{tools.CommandRetrying, tools.CommandSent, true},
+		{tools.CommandRetrying, tools.CommandRetrying, true},
+		{tools.CommandRetrying, tools.CommandExpired, true},
+		{tools.CommandRetrying, tools.CommandFailed, true},
+		{tools.CommandRetrying, tools.CommandAcked, false},
+		{tools.CommandAcked, tools.CommandSent, false},
+		{tools.CommandAcked, tools.CommandFailed, false},
+		{tools.CommandFailed, tools.CommandSent, false},
+		{tools.CommandFailed, tools.CommandAcked, false},
+		{tools.CommandExpired, tools.CommandSent, false},
+		{tools.CommandObserved, tools.CommandAcked, false},
+		{tools.CommandObserved, tools.CommandFailed, false},
+	}
+
+	for _, test := range tests {
+		if allowed := test.from.CanMoveTo(test.to); allowed != test.allowed {
+			t.Errorf("%v -> %v: expected allowed %v, got %v", test.from, test.to, test.allowed, allowed)
+		}
+	}
+}
+
+// A test that checks which states of a command are terminal and that every state can be parsed by its name.
+func TestCommandStateTerminal(t *testing.T) {
+	tests := []struct {
+		state    tools.CommandState
+		terminal bool
+	}{
+		{tools.CommandQueued, false},
+		{tools.CommandSent, false},
+		{tools.CommandAcked, false},
+		{tools.CommandFailed, true},
+		{tools.CommandObserved, true},
+		{tools.CommandRetrying, false},
+		{tools.CommandExpired, true},
+	}
+
+	for _, test := range tests {
+		if terminal := test.state.Terminal(); terminal != test.terminal {
+			t.Errorf("%v: expected terminal %v, got %v", test.state, test.terminal, terminal)
+		}
+		if state, ok := tools.ParseCommandState(test.state.String()); !ok || state != test.state {
+			t.Errorf("%v: could not be parsed by its name, got %v", test.state, state)
+		}
+	}
+	if _, ok := tools.ParseCommandState("unknown"); ok {
+		t.Errorf("expected an unknown state name to be invalid")
+	}
+}
+
+// A test that moves a tracked command through a sequence of updates and checks the state it ends in.
+// Updates that are not allowed from the current state are ignored by the CommandTracker.
+func TestCommandTrackerUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		updates []tools.CommandState
+		final   tools.CommandState
+	}{
+		{"written", []tools.CommandState{tools.CommandSent, tools.CommandAcked}, tools.CommandAcked},
+		{"write failed", []tools.CommandState{tools.CommandSent, tools.CommandFailed}, tools.CommandFailed},
+		{"retried", []tools.CommandState{tools.CommandSent, tools.CommandRetrying, tools.CommandRetrying, tools.CommandSent, tools.CommandAcked}, tools.CommandAcked},
+		{"expired in outbox", []tools.CommandState{tools.CommandRetrying, tools.CommandExpired}, tools.CommandExpired},
+		{"late ack after failure", []tools.CommandState{tools.CommandSent, tools.CommandFailed, tools.CommandAcked}, tools.CommandFailed},
+		{"late write after expiry", []tools.CommandState{tools.CommandRetrying, tools.CommandExpired, tools.CommandSent}, tools.CommandExpired},
+		{"ack before write", []tools.CommandState{tools.CommandAcked}, tools.CommandQueued},
+		{"requeued after write", []tools.CommandState{tools.CommandSent, tools.CommandQueued}, tools.CommandSent},
+	}
+
+	for _, test := range tests {
+		tracker := tools.NewCommandTracker(tools.DefaultCommandHistory)
+		cmdid := tracker.Track(map[string]string{"command": "readconfig-control"})
+		for _, state := range test.updates {
+			tracker.Update(cmdid, state, nil)
+		}
+
+		record, ok := tracker.Get(cmdid)
+		if !ok {
+			t.Fatalf("%v: command is not tracked", test.name)
+		}
+		if record.State != test.final {
+			t.Errorf("%v: expected state %v, got %v", test.name, test.final, record.State)
+		}
+	}
+}
+
+// A test that checks that the responses from the mesh mark the commands that requested them as
+// observed, unless the commands have already failed or expired.
+func TestCommandTrackerObserve(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  map[string]string
+		updates  []tools.CommandState
+		response tools.Log
+		final    tools.CommandState
+	}{
+		{
+			name:     "sensor data by ping",
+			command:  map[string]string{"command": "readsensors-mesh", "ping": "userping-test-mesh"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandAcked},
+			response: newMeshLog("sensordata", map[string]string{"ping": "userping-test-mesh", "node": "1"}),
+			final:    tools.CommandObserved,
+		},
+		{
+			name:     "response before ack",
+			command:  map[string]string{"command": "readconfig-mesh", "ping": "userping-test-mesh"},
+			updates:  []tools.CommandState{tools.CommandSent},
+			response: newMeshLog("configdata", map[string]string{"ping": "userping-test-mesh", "node": "1"}),
+			final:    tools.CommandObserved,
+		},
+		{
+			name:     "other ping",
+			command:  map[string]string{"command": "readsensors-mesh", "ping": "userping-test-mesh"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandAcked},
+			response: newMeshLog("sensordata", map[string]string{"ping": "userping-other-mesh", "node": "1"}),
+			final:    tools.CommandAcked,
+		},
+		{
+			name:     "control node config",
+			command:  map[string]string{"command": "readconfig-control"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandAcked},
+			response: newMeshLog("ctrldata", map[string]string{}),
+			final:    tools.CommandObserved,
+		},
+		{
+			name:     "nodelist",
+			command:  map[string]string{"command": "readnodelist-control"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandAcked},
+			response: newMeshLog("nodelist", map[string]string{"nodelist": "1-"}),
+			final:    tools.CommandObserved,
+		},
+		{
+			name:     "topology",
+			command:  map[string]string{"command": "readtopology-control"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandAcked},
+			response: newMeshLog("topology", map[string]string{}),
+			final:    tools.CommandObserved,
+		},
+		{
+			name:     "failed command",
+			command:  map[string]string{"command": "readsensors-mesh", "ping": "userping-test-mesh"},
+			updates:  []tools.CommandState{tools.CommandSent, tools.CommandFailed},
+			response: newMeshLog("sensordata", map[string]string{"ping": "userping-test-mesh", "node": "1"}),
+			final:    tools.CommandFailed,
+		},
+		{
+			name:     "expired command",
+			command:  map[string]string{"command": "readsensors-mesh", "ping": "userping-test-mesh"},
+			updates:  []tools.CommandState{tools.CommandRetrying, tools.CommandExpired},
+			response: newMeshLog("sensordata", map[string]string{"ping": "userping-test-mesh", "node": "1"}),
+			final:    tools.CommandExpired,
+		},
+	}
+
+	for _, test := range tests {
+		tracker := tools.NewCommandTracker(tools.DefaultCommandHistory)
+		cmdid := tracker.Track(test.command)
+		for _, state := range test.updates {
+			tracker.Update(cmdid, state, nil)
+		}
+		tracker.Observe(test.response)
+
+		record, _ := tracker.Get(cmdid)
+		if record.State != test.final {
+			t.Errorf("%v: expected state %v, got %v", test.name, test.final, record.State)
+		}
+	}
+}
+
+// A test that checks that the CommandTracker keeps a bounded history and evicts the oldest commands.
+func TestCommandTrackerEviction(t *testing.T) {
+	tracker := tools.NewCommandTracker(3)
+
+	cmdids := make([]string, 0)
+	for index := 0; index < 5; index++ {
+		cmdids = append(cmdids, tracker.Track(map[string]string{"command": "readsensors-mesh", "ping": fmt.Sprintf("userping-%v-mesh", index)}))
+	}
+
+	// The two oldest commands are evicted
+	for index, cmdid := range cmdids {
+		if _, ok := tracker.Get(cmdid); ok != (index >= 2) {
+			t.Errorf("command %v: expected tracked %v, got %v", index, index >= 2, ok)
+		}
+	}
+
+	// The remaining commands are listed newest first and the responses to an evicted command are ignored
+	records := tracker.List(0, 0)
+	if len(records) != 3 || records[0].CommandID != cmdids[4] {
+		t.Errorf("expected the 3 newest commands, newest first, got %+v", records)
+	}
+	tracker.Observe(newMeshLog("sensordata", map[string]string{"ping": "userping-0-mesh", "node": "1"}))
+	if observed := tracker.List(tools.CommandObserved, 0); len(observed) != 0 {
+		t.Errorf("expected no observed commands, got %+v", observed)
+	}
+}